@@ -1,5 +1,5 @@
 #!/usr/bin/env bash
 
 mkdir -p ./data/artifact/artifacts/gloo-system
-mkdir -p ./data/config/{authconfigs,gateways,proxies,upstreams,upstreamgroups,ratelimitconfigs,routeoptions,routetables,virtualhostoptions,virtualservices}/gloo-system
+mkdir -p ./data/config/{authconfigs,gateways,proxies,upstreams,upstreamgroups,ratelimitconfigs,routeoptions,routetables,tcproutes,virtualhostoptions,virtualservices}/gloo-system
 mkdir -p ./data/secret/secrets/{default,gloo-system}
@@ -467,6 +467,13 @@ type Ingress struct {
 	Deployment          *IngressDeployment `json:"deployment,omitempty"`
 	RequireIngressClass *bool              `json:"requireIngressClass,omitempty" desc:"only serve traffic for Ingress objects with the Ingress Class annotation 'kubernetes.io/ingress.class'. By default the annotation value must be set to 'gloo', however this can be overriden via customIngressClass."`
 	CustomIngress       *bool              `json:"customIngressClass,omitempty" desc:"Only relevant when requireIngressClass is set to true. Setting this value will cause the Gloo Edge Ingress Controller to process only those Ingress objects which have their ingress class set to this value (e.g. 'kubernetes.io/ingress.class=SOMEVALUE')."`
+	ForceSslRedirect    *bool              `json:"forceSslRedirect,omitempty" desc:"redirect HTTP requests for hosts with TLS to HTTPS. Ingresses can opt out with the annotation 'gloo.solo.io/force-ssl-redirect: false'."`
+	GatewayApi          *IngressGatewayApi `json:"gatewayApi,omitempty"`
+}
+
+type IngressGatewayApi struct {
+	Enabled        *bool   `json:"enabled,omitempty" desc:"translate the Gateways of the Gateway API (gateway.networking.k8s.io) to proxies. Requires the Gateway API CRDs to be installed."`
+	ControllerName *string `json:"controllerName,omitempty" desc:"only translate the Gateways of GatewayClasses with this controllerName. Default is solo.io/gloo-gateway"`
 }
 
 type IngressDeployment struct {
@@ -490,6 +497,7 @@ type IngressProxyDeployment struct {
 	Image                   *Image            `json:"image,omitempty"`
 	HttpPort                *int              `json:"httpPort,omitempty" desc:"HTTP port for the ingress container"`
 	HttpsPort               *int              `json:"httpsPort,omitempty" desc:"HTTPS port for the ingress container"`
+	TlsPassthroughPort      *int              `json:"tlsPassthroughPort,omitempty" desc:"port for the ingress container passing TLS connections through to the backends of Ingresses with the annotation 'gloo.solo.io/tls-passthrough: true'. TLS passthrough is disabled if not set."`
 	ExtraPorts              []interface{}     `json:"extraPorts,omitempty"`
 	ExtraAnnotations        map[string]string `json:"extraAnnotations,omitempty"`
 	FloatingUserId          *bool             `json:"floatingUserId,omitempty" desc:"set to true to allow the cluster to dynamically assign a user ID"`
@@ -503,11 +511,12 @@ type ServiceSpec struct {
 }
 
 type Service struct {
-	Type             *string           `json:"type,omitempty" desc:"K8s service type"`
-	ExtraAnnotations map[string]string `json:"extraAnnotations,omitempty" desc:"extra annotations to add to the service"`
-	LoadBalancerIP   *string           `json:"loadBalancerIP,omitempty" desc:"IP address of the load balancer"`
-	HttpPort         *int              `json:"httpPort,omitempty" desc:"HTTP port for the knative/ingress proxy service"`
-	HttpsPort        *int              `json:"httpsPort,omitempty" desc:"HTTPS port for the knative/ingress proxy service"`
+	Type               *string           `json:"type,omitempty" desc:"K8s service type"`
+	ExtraAnnotations   map[string]string `json:"extraAnnotations,omitempty" desc:"extra annotations to add to the service"`
+	LoadBalancerIP     *string           `json:"loadBalancerIP,omitempty" desc:"IP address of the load balancer"`
+	HttpPort           *int              `json:"httpPort,omitempty" desc:"HTTP port for the knative/ingress proxy service"`
+	HttpsPort          *int              `json:"httpsPort,omitempty" desc:"HTTPS port for the knative/ingress proxy service"`
+	TlsPassthroughPort *int              `json:"tlsPassthroughPort,omitempty" desc:"TLS passthrough port for the ingress proxy service. Defaults to ingressProxy.deployment.tlsPassthroughPort"`
 	*KubeResourceOverride
 }
 
@@ -85,6 +85,11 @@ var _ = Describe("RBAC Test", func() {
 								Resources: []string{"pods", "services", "secrets", "endpoints", "configmaps", "namespaces"},
 								Verbs:     []string{"get", "list", "watch"},
 							},
+							{
+								APIGroups: []string{"discovery.k8s.io"},
+								Resources: []string{"endpointslices"},
+								Verbs:     []string{"get", "list", "watch"},
+							},
 						},
 						RoleRef: rbacv1.RoleRef{
 							APIGroup: "rbac.authorization.k8s.io",
@@ -404,7 +409,7 @@ var _ = Describe("RBAC Test", func() {
 						Rules: []rbacv1.PolicyRule{
 							{
 								APIGroups: []string{"gateway.solo.io"},
-								Resources: []string{"virtualservices", "routetables", "virtualhostoptions", "routeoptions"},
+								Resources: []string{"virtualservices", "routetables", "virtualhostoptions", "routeoptions", "tcproutes"},
 								Verbs:     []string{"get", "list", "watch", "update"},
 							}, {
 								APIGroups: []string{"gateway.solo.io"},
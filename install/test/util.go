@@ -28,7 +28,7 @@ func GetServiceAccountPermissions(namespace string) *manifesttestutils.ServiceAc
 		"gloo-system.gateway",
 		namespace,
 		[]string{"gateway.solo.io"},
-		[]string{"virtualservices", "routetables", "virtualhostoptions", "routeoptions"},
+		[]string{"virtualservices", "routetables", "virtualhostoptions", "routeoptions", "tcproutes"},
 		[]string{"get", "list", "watch", "update"})
 
 	// Gloo
@@ -38,6 +38,12 @@ func GetServiceAccountPermissions(namespace string) *manifesttestutils.ServiceAc
 		[]string{""},
 		[]string{"pods", "services", "configmaps", "namespaces", "secrets", "endpoints"},
 		[]string{"get", "list", "watch"})
+	permissions.AddExpectedPermission(
+		"gloo-system.gloo",
+		namespace,
+		[]string{"discovery.k8s.io"},
+		[]string{"endpointslices"},
+		[]string{"get", "list", "watch"})
 	permissions.AddExpectedPermission(
 		"gloo-system.gloo",
 		namespace,
@@ -71,6 +77,12 @@ func GetServiceAccountPermissions(namespace string) *manifesttestutils.ServiceAc
 		[]string{""},
 		[]string{"pods", "services", "configmaps", "namespaces", "secrets", "endpoints"},
 		[]string{"get", "list", "watch"})
+	permissions.AddExpectedPermission(
+		"gloo-system.discovery",
+		namespace,
+		[]string{"discovery.k8s.io"},
+		[]string{"endpointslices"},
+		[]string{"get", "list", "watch"})
 	permissions.AddExpectedPermission(
 		"gloo-system.discovery",
 		namespace,
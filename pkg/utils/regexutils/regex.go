@@ -6,6 +6,7 @@ import (
 	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/solo-io/gloo/pkg/utils/settingsutil"
+	v32 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/matcher/v3"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 )
 
@@ -40,3 +41,23 @@ func NewRegexWithProgramSize(regex string, programsize *uint32) *envoy_type_matc
 		Regex: regex,
 	}
 }
+
+func ConvertRegexMatchAndSubstitute(ctx context.Context, in *v32.RegexMatchAndSubstitute) *envoy_type_matcher_v3.RegexMatchAndSubstitute {
+	if in == nil {
+		return nil
+	}
+
+	out := &envoy_type_matcher_v3.RegexMatchAndSubstitute{
+		Pattern:      NewRegex(ctx, in.Pattern.Regex),
+		Substitution: in.Substitution,
+	}
+	switch inET := in.Pattern.EngineType.(type) {
+	case *v32.RegexMatcher_GoogleRe2:
+		outET := out.Pattern.EngineType.(*envoy_type_matcher_v3.RegexMatcher_GoogleRe2)
+		if inET.GoogleRe2.MaxProgramSize != nil && (outET.GoogleRe2.MaxProgramSize == nil || inET.GoogleRe2.MaxProgramSize.Value < outET.GoogleRe2.MaxProgramSize.Value) {
+			out.Pattern = NewRegexWithProgramSize(in.Pattern.Regex, &inET.GoogleRe2.MaxProgramSize.Value)
+		}
+	}
+
+	return out
+}
@@ -6,11 +6,13 @@ import (
 	"net/url"
 	"os"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
 	errors "github.com/rotisserie/eris"
 	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
@@ -63,11 +65,6 @@ func (f *AWSLambdaFunctionDiscovery) DetectFunctions(ctx context.Context, url *u
 				return err
 			}
 
-			// sort for idempotency
-			sort.Slice(newfunctions, func(i, j int) bool {
-				return newfunctions[i].LogicalName < newfunctions[j].LogicalName
-			})
-
 			// TODO(yuval-k): only update functions if newfunctions != oldfunctions
 			// no need to constantly write to storage
 
@@ -143,32 +140,132 @@ func (f *AWSLambdaFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, se
 		svc = lambda.New(sess)
 	}
 
-	var newfunctions []*glooaws.LambdaFunctionSpec
+	return ListLambdaFunctions(ctx, svc, lambdaSpec.GetDiscoveryOptions())
+}
 
-	options := &lambda.ListFunctionsInput{FunctionVersion: aws.String("ALL")}
-	err = svc.ListFunctionsPagesWithContext(ctx, options, func(results *lambda.ListFunctionsOutput, _ bool) bool {
+// ListLambdaFunctions lists the functions matching the discovery options, sorted by logical name.
+func ListLambdaFunctions(ctx context.Context, svc lambdaiface.LambdaAPI, discoveryOptions *glooaws.LambdaDiscoveryOptions) ([]*glooaws.LambdaFunctionSpec, error) {
+	var (
+		newfunctions []*glooaws.LambdaFunctionSpec
+		// function names that passed the filters, as ListFunctions returns one entry per version
+		selected = map[string]bool{}
+		errs     []error
+	)
+
+	options := &lambda.ListFunctionsInput{}
+	if discoveryOptions.GetDiscoverVersions() == nil || discoveryOptions.GetDiscoverVersions().GetValue() {
+		options.FunctionVersion = aws.String("ALL")
+	}
+	err := svc.ListFunctionsPagesWithContext(ctx, options, func(results *lambda.ListFunctionsOutput, _ bool) bool {
 
 		for _, f := range results.Functions {
 			version := aws.StringValue(f.Version)
 			name := aws.StringValue(f.FunctionName)
 
-			logicalname := fmt.Sprintf("%s:%s", name, version)
-			if version == "$LATEST" {
-				logicalname = name
+			include, checked := selected[name]
+			if !checked {
+				var err error
+				include, err = includeFunction(ctx, svc, f, discoveryOptions)
+				if err != nil {
+					errs = append(errs, err)
+					return false
+				}
+				selected[name] = include
+			}
+			if !include {
+				continue
 			}
 
 			newfunctions = append(newfunctions, &glooaws.LambdaFunctionSpec{
 				LambdaFunctionName: name,
 				Qualifier:          version,
-				LogicalName:        logicalname,
+				LogicalName:        logicalName(name, version),
 			})
 		}
 
 		return true
 	})
+	if err == nil && len(errs) > 0 {
+		err = errs[0]
+	}
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to get list of functions from AWS")
 	}
 
+	if discoveryOptions.GetDiscoverAliases() {
+		var names []string
+		for name, include := range selected {
+			if include {
+				names = append(names, name)
+			}
+		}
+		for _, name := range names {
+			err := svc.ListAliasesPagesWithContext(ctx, &lambda.ListAliasesInput{FunctionName: aws.String(name)}, func(results *lambda.ListAliasesOutput, _ bool) bool {
+				for _, alias := range results.Aliases {
+					aliasName := aws.StringValue(alias.Name)
+					newfunctions = append(newfunctions, &glooaws.LambdaFunctionSpec{
+						LambdaFunctionName: name,
+						Qualifier:          aliasName,
+						LogicalName:        logicalName(name, aliasName),
+					})
+				}
+				return true
+			})
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to get list of aliases for function %s from AWS", name)
+			}
+		}
+	}
+
+	// sort for idempotency
+	sort.Slice(newfunctions, func(i, j int) bool {
+		return newfunctions[i].LogicalName < newfunctions[j].LogicalName
+	})
+
+	if maxFunctions := int(discoveryOptions.GetMaxFunctions()); maxFunctions > 0 && len(newfunctions) > maxFunctions {
+		contextutils.LoggerFrom(ctx).Warnf("discovered %d lambda functions, only keeping the first %d", len(newfunctions), maxFunctions)
+		newfunctions = newfunctions[:maxFunctions]
+	}
+
 	return newfunctions, nil
 }
+
+func logicalName(name, qualifier string) string {
+	if qualifier == "$LATEST" {
+		return name
+	}
+	return fmt.Sprintf("%s:%s", name, qualifier)
+}
+
+// includeFunction applies the name prefix and tag filters to a function.
+func includeFunction(ctx context.Context, svc lambdaiface.LambdaAPI, f *lambda.FunctionConfiguration, discoveryOptions *glooaws.LambdaDiscoveryOptions) (bool, error) {
+	name := aws.StringValue(f.FunctionName)
+	if prefixes := discoveryOptions.GetFunctionNamePrefixes(); len(prefixes) > 0 {
+		matched := false
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(name, prefix) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false, nil
+		}
+	}
+
+	if len(discoveryOptions.GetTags()) == 0 {
+		return true, nil
+	}
+	// tags can only be looked up with the unqualified function arn
+	arn := strings.TrimSuffix(aws.StringValue(f.FunctionArn), ":"+aws.StringValue(f.Version))
+	tags, err := svc.ListTagsWithContext(ctx, &lambda.ListTagsInput{Resource: aws.String(arn)})
+	if err != nil {
+		return false, errors.Wrapf(err, "unable to get tags for function %s from AWS", name)
+	}
+	for key, value := range discoveryOptions.GetTags() {
+		if aws.StringValue(tags.Tags[key]) != value {
+			return false, nil
+		}
+	}
+	return true, nil
+}
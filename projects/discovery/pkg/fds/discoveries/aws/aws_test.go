@@ -0,0 +1,137 @@
+package aws_test
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/solo-io/gloo/projects/discovery/pkg/fds/discoveries/aws"
+	glooaws "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws"
+)
+
+const arnPrefix = "arn:aws:lambda:us-east-1:123456789012:function:"
+
+// fakeLambda serves a fixed set of functions, versions, aliases and tags
+type fakeLambda struct {
+	lambdaiface.LambdaAPI
+
+	versions map[string][]string
+	aliases  map[string][]string
+	tags     map[string]map[string]string
+}
+
+func (f *fakeLambda) ListFunctionsPagesWithContext(_ aws.Context, in *lambda.ListFunctionsInput, fn func(*lambda.ListFunctionsOutput, bool) bool, _ ...request.Option) error {
+	for name, versions := range f.versions {
+		out := &lambda.ListFunctionsOutput{}
+		for _, version := range versions {
+			if aws.StringValue(in.FunctionVersion) != "ALL" && version != "$LATEST" {
+				continue
+			}
+			out.Functions = append(out.Functions, &lambda.FunctionConfiguration{
+				FunctionName: aws.String(name),
+				FunctionArn:  aws.String(arnPrefix + name + ":" + version),
+				Version:      aws.String(version),
+			})
+		}
+		if !fn(out, false) {
+			break
+		}
+	}
+	return nil
+}
+
+func (f *fakeLambda) ListAliasesPagesWithContext(_ aws.Context, in *lambda.ListAliasesInput, fn func(*lambda.ListAliasesOutput, bool) bool, _ ...request.Option) error {
+	out := &lambda.ListAliasesOutput{}
+	for _, alias := range f.aliases[aws.StringValue(in.FunctionName)] {
+		out.Aliases = append(out.Aliases, &lambda.AliasConfiguration{Name: aws.String(alias)})
+	}
+	fn(out, true)
+	return nil
+}
+
+func (f *fakeLambda) ListTagsWithContext(_ aws.Context, in *lambda.ListTagsInput, _ ...request.Option) (*lambda.ListTagsOutput, error) {
+	name := strings.TrimPrefix(aws.StringValue(in.Resource), arnPrefix)
+	Expect(name).NotTo(ContainSubstring(":"), "tags must be listed with the unqualified arn")
+	return &lambda.ListTagsOutput{Tags: aws.StringMap(f.tags[name])}, nil
+}
+
+var _ = Describe("Lambda function discovery", func() {
+
+	var (
+		ctx  = context.Background()
+		svc  *fakeLambda
+		opts *glooaws.LambdaDiscoveryOptions
+	)
+
+	BeforeEach(func() {
+		svc = &fakeLambda{
+			versions: map[string][]string{
+				"orders-create": {"$LATEST", "1", "2"},
+				"orders-list":   {"$LATEST"},
+				"billing":       {"$LATEST", "1"},
+			},
+			aliases: map[string][]string{
+				"orders-create": {"prod"},
+				"billing":       {"live"},
+			},
+			tags: map[string]map[string]string{
+				"orders-create": {"team": "orders", "expose": "true"},
+				"orders-list":   {"team": "orders"},
+				"billing":       {"team": "billing", "expose": "true"},
+			},
+		}
+		opts = nil
+	})
+
+	logicalNames := func(functions []*glooaws.LambdaFunctionSpec) []string {
+		var names []string
+		for _, fn := range functions {
+			names = append(names, fn.LogicalName)
+		}
+		return names
+	}
+
+	It("discovers all functions and versions by default, sorted", func() {
+		functions, err := ListLambdaFunctions(ctx, svc, opts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(logicalNames(functions)).To(Equal([]string{"billing", "billing:1", "orders-create", "orders-create:1", "orders-create:2", "orders-list"}))
+	})
+
+	It("filters by name prefix and tags", func() {
+		opts = &glooaws.LambdaDiscoveryOptions{
+			FunctionNamePrefixes: []string{"orders-"},
+			Tags:                 map[string]string{"expose": "true"},
+			DiscoverVersions:     &wrappers.BoolValue{Value: false},
+		}
+		functions, err := ListLambdaFunctions(ctx, svc, opts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(logicalNames(functions)).To(Equal([]string{"orders-create"}))
+	})
+
+	It("discovers aliases as qualifiers", func() {
+		opts = &glooaws.LambdaDiscoveryOptions{
+			FunctionNamePrefixes: []string{"orders-create"},
+			DiscoverVersions:     &wrappers.BoolValue{Value: false},
+			DiscoverAliases:      true,
+		}
+		functions, err := ListLambdaFunctions(ctx, svc, opts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(functions).To(HaveLen(2))
+		Expect(functions[1].LambdaFunctionName).To(Equal("orders-create"))
+		Expect(functions[1].Qualifier).To(Equal("prod"))
+		Expect(functions[1].LogicalName).To(Equal("orders-create:prod"))
+	})
+
+	It("caps the number of discovered functions", func() {
+		opts = &glooaws.LambdaDiscoveryOptions{MaxFunctions: 2}
+		functions, err := ListLambdaFunctions(ctx, svc, opts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(logicalNames(functions)).To(Equal([]string{"billing", "billing:1"}))
+	})
+})
@@ -0,0 +1,197 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	errors "github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
+	glooazure "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/azure"
+	"github.com/solo-io/go-utils/contextutils"
+)
+
+const (
+	masterKeyName = "_master"
+	// header used to authenticate against the function app admin api
+	functionsKeyHeader = "x-functions-key"
+	httpTriggerType    = "httpTrigger"
+
+	// DefaultFunctionAppUrlTemplate is formatted with the function app name to get the function app url
+	DefaultFunctionAppUrlTemplate = "https://%s.azurewebsites.net"
+)
+
+type AzureFunctionDiscoveryFactory struct {
+	PollingTime time.Duration
+	// Template formatted with the function app name to get the url of the function app.
+	// Defaults to DefaultFunctionAppUrlTemplate, overridden in tests.
+	FunctionAppUrlTemplate string
+	HttpClient             *http.Client
+}
+
+func (f *AzureFunctionDiscoveryFactory) NewFunctionDiscovery(u *v1.Upstream) fds.UpstreamFunctionDiscovery {
+	urlTemplate := f.FunctionAppUrlTemplate
+	if urlTemplate == "" {
+		urlTemplate = DefaultFunctionAppUrlTemplate
+	}
+	client := f.HttpClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &AzureFunctionDiscovery{
+		timetowait:  f.PollingTime,
+		upstream:    u,
+		urlTemplate: urlTemplate,
+		client:      client,
+	}
+}
+
+type AzureFunctionDiscovery struct {
+	timetowait  time.Duration
+	upstream    *v1.Upstream
+	urlTemplate string
+	client      *http.Client
+}
+
+func (f *AzureFunctionDiscovery) IsFunctional() bool {
+	_, ok := f.upstream.UpstreamType.(*v1.Upstream_Azure)
+	return ok
+}
+
+func (f *AzureFunctionDiscovery) DetectType(ctx context.Context, url *url.URL) (*plugins.ServiceSpec, error) {
+	return nil, nil
+}
+
+func (f *AzureFunctionDiscovery) DetectFunctions(ctx context.Context, url *url.URL, dependencies func() fds.Dependencies, updatecb func(fds.UpstreamMutator) error) error {
+	for {
+		err := contextutils.NewExponentioalBackoff(contextutils.ExponentioalBackoff{}).Backoff(ctx, func(ctx context.Context) error {
+			newfunctions, err := f.DetectFunctionsOnce(ctx, dependencies().Secrets)
+			if err != nil {
+				return err
+			}
+
+			return updatecb(func(out *v1.Upstream) error {
+				if out == nil {
+					return errors.New("nil upstream")
+				}
+				azurespec, ok := out.UpstreamType.(*v1.Upstream_Azure)
+				if !ok {
+					return errors.New("not azure upstream")
+				}
+				azurespec.Azure.Functions = newfunctions
+				return nil
+			})
+		})
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			// ignore other errors as we would like to continue forever.
+		}
+
+		// sleep so we are not hogging
+		if err := contextutils.Sleep(ctx, f.timetowait); err != nil {
+			return err
+		}
+	}
+}
+
+// the subset of the function metadata returned by the function app admin api that we care about
+type functionMetadata struct {
+	Name       string `json:"name"`
+	IsDisabled bool   `json:"isDisabled"`
+	Config     struct {
+		Bindings []struct {
+			Type      string `json:"type"`
+			AuthLevel string `json:"authLevel"`
+		} `json:"bindings"`
+	} `json:"config"`
+}
+
+// DetectFunctionsOnce lists the http triggered functions of the function app using the admin api,
+// which is authenticated with the master key from the upstream's secret.
+func (f *AzureFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, secrets v1.SecretList) ([]*glooazure.UpstreamSpec_FunctionSpec, error) {
+	azurespec, ok := f.upstream.UpstreamType.(*v1.Upstream_Azure)
+	if !ok {
+		return nil, errors.New("not an azure upstream spec")
+	}
+	spec := azurespec.Azure
+	if spec.GetSecretRef() == nil {
+		return nil, errors.New("azure function discovery requires a secret ref")
+	}
+	secret, err := secrets.Find(spec.GetSecretRef().Strings())
+	if err != nil {
+		return nil, errors.Wrapf(err, "azure secret for ref %v not found", spec.GetSecretRef())
+	}
+	masterKey := secret.GetAzure().GetApiKeys()[masterKeyName]
+	if masterKey == "" {
+		return nil, errors.Errorf("azure secret %v must contain the %s key for function discovery", spec.GetSecretRef(), masterKeyName)
+	}
+
+	appUrl := fmt.Sprintf(f.urlTemplate, spec.GetFunctionAppName())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, appUrl+"/admin/functions", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(functionsKeyHeader, masterKey)
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get list of functions from Azure")
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unable to get list of functions from Azure: %s: %s", resp.Status, body)
+	}
+
+	var functions []functionMetadata
+	if err := json.Unmarshal(body, &functions); err != nil {
+		return nil, errors.Wrap(err, "unable to parse list of functions from Azure")
+	}
+
+	var newfunctions []*glooazure.UpstreamSpec_FunctionSpec
+	for _, function := range functions {
+		if function.IsDisabled {
+			continue
+		}
+		for _, binding := range function.Config.Bindings {
+			if binding.Type != httpTriggerType {
+				continue
+			}
+			newfunctions = append(newfunctions, &glooazure.UpstreamSpec_FunctionSpec{
+				FunctionName: function.Name,
+				AuthLevel:    convertAuthLevel(binding.AuthLevel),
+			})
+			break
+		}
+	}
+
+	// sort for idempotency
+	sort.Slice(newfunctions, func(i, j int) bool {
+		return newfunctions[i].FunctionName < newfunctions[j].FunctionName
+	})
+	return newfunctions, nil
+}
+
+func convertAuthLevel(authLevel string) glooazure.UpstreamSpec_FunctionSpec_AuthLevel {
+	switch strings.ToLower(authLevel) {
+	case "anonymous":
+		return glooazure.UpstreamSpec_FunctionSpec_Anonymous
+	case "admin", "system":
+		return glooazure.UpstreamSpec_FunctionSpec_Admin
+	default:
+		// azure defaults to function level auth
+		return glooazure.UpstreamSpec_FunctionSpec_Function
+	}
+}
@@ -0,0 +1,97 @@
+package azure_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/solo-io/gloo/projects/discovery/pkg/fds/discoveries/azure"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	glooazure "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/azure"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+const functionsResponse = `[
+  {"name": "hello", "isDisabled": false, "config": {"bindings": [{"type": "httpTrigger", "direction": "in", "authLevel": "anonymous"}, {"type": "http", "direction": "out"}]}},
+  {"name": "admin-only", "config": {"bindings": [{"type": "httpTrigger", "authLevel": "admin"}]}},
+  {"name": "default-auth", "config": {"bindings": [{"type": "httpTrigger"}]}},
+  {"name": "disabled", "isDisabled": true, "config": {"bindings": [{"type": "httpTrigger", "authLevel": "anonymous"}]}},
+  {"name": "timer", "config": {"bindings": [{"type": "timerTrigger"}]}}
+]`
+
+var _ = Describe("Azure Function Discovery", func() {
+
+	var (
+		server    *httptest.Server
+		secrets   v1.SecretList
+		upstream  *v1.Upstream
+		secretRef = &core.ResourceRef{Name: "azure", Namespace: "gloo-system"}
+	)
+
+	BeforeEach(func() {
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/my-app/admin/functions" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			if r.Header.Get("x-functions-key") != "master-key" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			fmt.Fprint(w, functionsResponse)
+		}))
+		secrets = v1.SecretList{{
+			Metadata: &core.Metadata{Name: secretRef.Name, Namespace: secretRef.Namespace},
+			Kind: &v1.Secret_Azure{
+				Azure: &v1.AzureSecret{ApiKeys: map[string]string{"_master": "master-key"}},
+			},
+		}}
+		upstream = &v1.Upstream{
+			Metadata: &core.Metadata{Name: "azure", Namespace: "gloo-system"},
+			UpstreamType: &v1.Upstream_Azure{
+				Azure: &glooazure.UpstreamSpec{
+					FunctionAppName: "my-app",
+					SecretRef:       secretRef,
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	discovery := func() *AzureFunctionDiscovery {
+		factory := &AzureFunctionDiscoveryFactory{FunctionAppUrlTemplate: server.URL + "/%s"}
+		return factory.NewFunctionDiscovery(upstream).(*AzureFunctionDiscovery)
+	}
+
+	It("is functional for azure upstreams", func() {
+		Expect(discovery().IsFunctional()).To(BeTrue())
+	})
+
+	It("discovers http triggered functions and their auth levels", func() {
+		functions, err := discovery().DetectFunctionsOnce(context.Background(), secrets)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(functions).To(Equal([]*glooazure.UpstreamSpec_FunctionSpec{
+			{FunctionName: "admin-only", AuthLevel: glooazure.UpstreamSpec_FunctionSpec_Admin},
+			{FunctionName: "default-auth", AuthLevel: glooazure.UpstreamSpec_FunctionSpec_Function},
+			{FunctionName: "hello", AuthLevel: glooazure.UpstreamSpec_FunctionSpec_Anonymous},
+		}))
+	})
+
+	It("errors when the secret has no master key", func() {
+		secrets[0].GetAzure().ApiKeys = map[string]string{"hello": "function-key"}
+		_, err := discovery().DetectFunctionsOnce(context.Background(), secrets)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("errors when the admin api rejects the request", func() {
+		secrets[0].GetAzure().ApiKeys["_master"] = "wrong-key"
+		_, err := discovery().DetectFunctionsOnce(context.Background(), secrets)
+		Expect(err).To(HaveOccurred())
+	})
+})
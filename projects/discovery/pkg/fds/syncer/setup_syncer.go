@@ -7,6 +7,7 @@ import (
 
 	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
 	"github.com/solo-io/gloo/projects/discovery/pkg/fds/discoveries/aws"
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds/discoveries/azure"
 	"github.com/solo-io/gloo/projects/discovery/pkg/fds/discoveries/grpc"
 	"github.com/solo-io/gloo/projects/discovery/pkg/fds/discoveries/swagger"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
@@ -66,6 +67,9 @@ func RunFDS(opts bootstrap.Opts) error {
 		&aws.AWSLambdaFunctionDiscoveryFactory{
 			PollingTime: time.Second,
 		},
+		&azure.AzureFunctionDiscoveryFactory{
+			PollingTime: time.Second * 15,
+		},
 		&swagger.SwaggerFunctionDiscoveryFactory{
 			DetectionTimeout: time.Minute,
 			FunctionPollTime: time.Second * 15,
@@ -19,6 +19,7 @@ type ApiSnapshot struct {
 	Gateways           GatewayList
 	VirtualHostOptions VirtualHostOptionList
 	RouteOptions       RouteOptionList
+	TcpRoutes          TcpRouteList
 }
 
 func (s ApiSnapshot) Clone() ApiSnapshot {
@@ -28,6 +29,7 @@ func (s ApiSnapshot) Clone() ApiSnapshot {
 		Gateways:           s.Gateways.Clone(),
 		VirtualHostOptions: s.VirtualHostOptions.Clone(),
 		RouteOptions:       s.RouteOptions.Clone(),
+		TcpRoutes:          s.TcpRoutes.Clone(),
 	}
 }
 
@@ -50,6 +52,9 @@ func (s ApiSnapshot) Hash(hasher hash.Hash64) (uint64, error) {
 	if _, err := s.hashRouteOptions(hasher); err != nil {
 		return 0, err
 	}
+	if _, err := s.hashTcpRoutes(hasher); err != nil {
+		return 0, err
+	}
 	return hasher.Sum64(), nil
 }
 
@@ -73,6 +78,10 @@ func (s ApiSnapshot) hashRouteOptions(hasher hash.Hash64) (uint64, error) {
 	return hashutils.HashAllSafe(hasher, s.RouteOptions.AsInterfaces()...)
 }
 
+func (s ApiSnapshot) hashTcpRoutes(hasher hash.Hash64) (uint64, error) {
+	return hashutils.HashAllSafe(hasher, s.TcpRoutes.AsInterfaces()...)
+}
+
 func (s ApiSnapshot) HashFields() []zap.Field {
 	var fields []zap.Field
 	hasher := fnv.New64()
@@ -101,6 +110,11 @@ func (s ApiSnapshot) HashFields() []zap.Field {
 		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
 	}
 	fields = append(fields, zap.Uint64("routeOptions", RouteOptionsHash))
+	TcpRoutesHash, err := s.hashTcpRoutes(hasher)
+	if err != nil {
+		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
+	}
+	fields = append(fields, zap.Uint64("tcpRoutes", TcpRoutesHash))
 	snapshotHash, err := s.Hash(hasher)
 	if err != nil {
 		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
@@ -115,6 +129,7 @@ type ApiSnapshotStringer struct {
 	Gateways           []string
 	VirtualHostOptions []string
 	RouteOptions       []string
+	TcpRoutes          []string
 }
 
 func (ss ApiSnapshotStringer) String() string {
@@ -145,6 +160,11 @@ func (ss ApiSnapshotStringer) String() string {
 		s += fmt.Sprintf("    %v\n", name)
 	}
 
+	s += fmt.Sprintf("  TcpRoutes %v\n", len(ss.TcpRoutes))
+	for _, name := range ss.TcpRoutes {
+		s += fmt.Sprintf("    %v\n", name)
+	}
+
 	return s
 }
 
@@ -160,5 +180,6 @@ func (s ApiSnapshot) Stringer() ApiSnapshotStringer {
 		Gateways:           s.Gateways.NamespacesDotNames(),
 		VirtualHostOptions: s.VirtualHostOptions.NamespacesDotNames(),
 		RouteOptions:       s.RouteOptions.NamespacesDotNames(),
+		TcpRoutes:          s.TcpRoutes.NamespacesDotNames(),
 	}
 }
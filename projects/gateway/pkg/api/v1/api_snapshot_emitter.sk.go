@@ -85,19 +85,21 @@ type ApiEmitter interface {
 	Gateway() GatewayClient
 	VirtualHostOption() VirtualHostOptionClient
 	RouteOption() RouteOptionClient
+	TcpRoute() TcpRouteClient
 }
 
-func NewApiEmitter(virtualServiceClient VirtualServiceClient, routeTableClient RouteTableClient, gatewayClient GatewayClient, virtualHostOptionClient VirtualHostOptionClient, routeOptionClient RouteOptionClient) ApiEmitter {
-	return NewApiEmitterWithEmit(virtualServiceClient, routeTableClient, gatewayClient, virtualHostOptionClient, routeOptionClient, make(chan struct{}))
+func NewApiEmitter(virtualServiceClient VirtualServiceClient, routeTableClient RouteTableClient, gatewayClient GatewayClient, virtualHostOptionClient VirtualHostOptionClient, routeOptionClient RouteOptionClient, tcpRouteClient TcpRouteClient) ApiEmitter {
+	return NewApiEmitterWithEmit(virtualServiceClient, routeTableClient, gatewayClient, virtualHostOptionClient, routeOptionClient, tcpRouteClient, make(chan struct{}))
 }
 
-func NewApiEmitterWithEmit(virtualServiceClient VirtualServiceClient, routeTableClient RouteTableClient, gatewayClient GatewayClient, virtualHostOptionClient VirtualHostOptionClient, routeOptionClient RouteOptionClient, emit <-chan struct{}) ApiEmitter {
+func NewApiEmitterWithEmit(virtualServiceClient VirtualServiceClient, routeTableClient RouteTableClient, gatewayClient GatewayClient, virtualHostOptionClient VirtualHostOptionClient, routeOptionClient RouteOptionClient, tcpRouteClient TcpRouteClient, emit <-chan struct{}) ApiEmitter {
 	return &apiEmitter{
 		virtualService:    virtualServiceClient,
 		routeTable:        routeTableClient,
 		gateway:           gatewayClient,
 		virtualHostOption: virtualHostOptionClient,
 		routeOption:       routeOptionClient,
+		tcpRoute:          tcpRouteClient,
 		forceEmit:         emit,
 	}
 }
@@ -109,6 +111,7 @@ type apiEmitter struct {
 	gateway           GatewayClient
 	virtualHostOption VirtualHostOptionClient
 	routeOption       RouteOptionClient
+	tcpRoute          TcpRouteClient
 }
 
 func (c *apiEmitter) Register() error {
@@ -127,6 +130,9 @@ func (c *apiEmitter) Register() error {
 	if err := c.routeOption.Register(); err != nil {
 		return err
 	}
+	if err := c.tcpRoute.Register(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -150,6 +156,10 @@ func (c *apiEmitter) RouteOption() RouteOptionClient {
 	return c.routeOption
 }
 
+func (c *apiEmitter) TcpRoute() TcpRouteClient {
+	return c.tcpRoute
+}
+
 func (c *apiEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts) (<-chan *ApiSnapshot, <-chan error, error) {
 
 	if len(watchNamespaces) == 0 {
@@ -206,6 +216,14 @@ func (c *apiEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts)
 	routeOptionChan := make(chan routeOptionListWithNamespace)
 
 	var initialRouteOptionList RouteOptionList
+	/* Create channel for TcpRoute */
+	type tcpRouteListWithNamespace struct {
+		list      TcpRouteList
+		namespace string
+	}
+	tcpRouteChan := make(chan tcpRouteListWithNamespace)
+
+	var initialTcpRouteList TcpRouteList
 
 	currentSnapshot := ApiSnapshot{}
 
@@ -300,6 +318,24 @@ func (c *apiEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts)
 			defer done.Done()
 			errutils.AggregateErrs(ctx, errs, routeOptionErrs, namespace+"-routeOptions")
 		}(namespace)
+		/* Setup namespaced watch for TcpRoute */
+		{
+			tcpRoutes, err := c.tcpRoute.List(namespace, clients.ListOpts{Ctx: opts.Ctx, Selector: opts.Selector})
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "initial TcpRoute list")
+			}
+			initialTcpRouteList = append(initialTcpRouteList, tcpRoutes...)
+		}
+		tcpRouteNamespacesChan, tcpRouteErrs, err := c.tcpRoute.Watch(namespace, opts)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "starting TcpRoute watch")
+		}
+
+		done.Add(1)
+		go func(namespace string) {
+			defer done.Done()
+			errutils.AggregateErrs(ctx, errs, tcpRouteErrs, namespace+"-tcpRoutes")
+		}(namespace)
 
 		/* Watch for changes and update snapshot */
 		go func(namespace string) {
@@ -352,6 +388,15 @@ func (c *apiEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts)
 						return
 					case routeOptionChan <- routeOptionListWithNamespace{list: routeOptionList, namespace: namespace}:
 					}
+				case tcpRouteList, ok := <-tcpRouteNamespacesChan:
+					if !ok {
+						return
+					}
+					select {
+					case <-ctx.Done():
+						return
+					case tcpRouteChan <- tcpRouteListWithNamespace{list: tcpRouteList, namespace: namespace}:
+					}
 				}
 			}
 		}(namespace)
@@ -366,6 +411,8 @@ func (c *apiEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts)
 	currentSnapshot.VirtualHostOptions = initialVirtualHostOptionList.Sort()
 	/* Initialize snapshot for RouteOptions */
 	currentSnapshot.RouteOptions = initialRouteOptionList.Sort()
+	/* Initialize snapshot for TcpRoutes */
+	currentSnapshot.TcpRoutes = initialTcpRouteList.Sort()
 
 	snapshots := make(chan *ApiSnapshot)
 	go func() {
@@ -402,6 +449,7 @@ func (c *apiEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts)
 		gatewaysByNamespace := make(map[string]GatewayList)
 		virtualHostOptionsByNamespace := make(map[string]VirtualHostOptionList)
 		routeOptionsByNamespace := make(map[string]RouteOptionList)
+		tcpRoutesByNamespace := make(map[string]TcpRouteList)
 		defer func() {
 			close(snapshots)
 			// we must wait for done before closing the error chan,
@@ -530,6 +578,28 @@ func (c *apiEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts)
 					routeOptionList = append(routeOptionList, routeOptions...)
 				}
 				currentSnapshot.RouteOptions = routeOptionList.Sort()
+			case tcpRouteNamespacedList, ok := <-tcpRouteChan:
+				if !ok {
+					return
+				}
+				record()
+
+				namespace := tcpRouteNamespacedList.namespace
+
+				skstats.IncrementResourceCount(
+					ctx,
+					namespace,
+					"tcp_route",
+					mApiResourcesIn,
+				)
+
+				// merge lists by namespace
+				tcpRoutesByNamespace[namespace] = tcpRouteNamespacedList.list
+				var tcpRouteList TcpRouteList
+				for _, tcpRoutes := range tcpRoutesByNamespace {
+					tcpRouteList = append(tcpRouteList, tcpRoutes...)
+				}
+				currentSnapshot.TcpRoutes = tcpRouteList.Sort()
 			}
 		}
 	}()
@@ -151,6 +151,36 @@ func (m *Gateway) Equal(that interface{}) bool {
 			}
 		}
 
+	case *Gateway_UdpGateway:
+		if _, ok := target.GatewayType.(*Gateway_UdpGateway); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetUdpGateway()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetUdpGateway()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetUdpGateway(), target.GetUdpGateway()) {
+				return false
+			}
+		}
+
+	case *Gateway_HybridGateway:
+		if _, ok := target.GatewayType.(*Gateway_HybridGateway); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetHybridGateway()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetHybridGateway()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetHybridGateway(), target.GetHybridGateway()) {
+				return false
+			}
+		}
+
 	default:
 		// m is nil but target is not nil
 		if m.GatewayType != target.GatewayType {
@@ -272,6 +302,72 @@ func (m *TcpGateway) Equal(that interface{}) bool {
 
 	}
 
+	if len(m.GetTcpRouteSelector()) != len(target.GetTcpRouteSelector()) {
+		return false
+	}
+	for k, v := range m.GetTcpRouteSelector() {
+
+		if strings.Compare(v, target.GetTcpRouteSelector()[k]) != 0 {
+			return false
+		}
+
+	}
+
+	if len(m.GetTcpRouteNamespaces()) != len(target.GetTcpRouteNamespaces()) {
+		return false
+	}
+	for idx, v := range m.GetTcpRouteNamespaces() {
+
+		if strings.Compare(v, target.GetTcpRouteNamespaces()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if h, ok := interface{}(m.GetOptions()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetOptions()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetOptions(), target.GetOptions()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *UdpGateway) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*UdpGateway)
+	if !ok {
+		that2, ok := that.(UdpGateway)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetDestination()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetDestination()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetDestination(), target.GetDestination()) {
+			return false
+		}
+	}
+
 	if h, ok := interface{}(m.GetOptions()).(equality.Equalizer); ok {
 		if !h.Equal(target.GetOptions()) {
 			return false
@@ -284,3 +380,117 @@ func (m *TcpGateway) Equal(that interface{}) bool {
 
 	return true
 }
+
+// Equal function
+func (m *HybridGateway) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*HybridGateway)
+	if !ok {
+		that2, ok := that.(HybridGateway)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetMatchedGateways()) != len(target.GetMatchedGateways()) {
+		return false
+	}
+	for idx, v := range m.GetMatchedGateways() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetMatchedGateways()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetMatchedGateways()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	return true
+}
+
+// Equal function
+func (m *MatchedGateway) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*MatchedGateway)
+	if !ok {
+		that2, ok := that.(MatchedGateway)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetMatcher()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMatcher()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMatcher(), target.GetMatcher()) {
+			return false
+		}
+	}
+
+	switch m.GatewayType.(type) {
+
+	case *MatchedGateway_HttpGateway:
+		if _, ok := target.GatewayType.(*MatchedGateway_HttpGateway); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetHttpGateway()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetHttpGateway()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetHttpGateway(), target.GetHttpGateway()) {
+				return false
+			}
+		}
+
+	case *MatchedGateway_TcpGateway:
+		if _, ok := target.GatewayType.(*MatchedGateway_TcpGateway); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetTcpGateway()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetTcpGateway()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetTcpGateway(), target.GetTcpGateway()) {
+				return false
+			}
+		}
+
+	default:
+		// m is nil but target is not nil
+		if m.GatewayType != target.GatewayType {
+			return false
+		}
+	}
+
+	return true
+}
@@ -33,6 +33,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&RouteOptionList{},
 		&RouteTable{},
 		&RouteTableList{},
+		&TcpRoute{},
+		&TcpRouteList{},
 		&VirtualHostOption{},
 		&VirtualHostOptionList{},
 		&VirtualService{},
@@ -206,6 +206,69 @@ type RouteTableList struct {
 	Items       []RouteTable `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
 
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +resourceName=tcproutes
+// +genclient
+type TcpRoute struct {
+	v1.TypeMeta `json:",inline"`
+	// +optional
+	v1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec defines the implementation of this definition.
+	// +optional
+	Spec   api.TcpRoute `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	Status core.Status  `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+func (o *TcpRoute) MarshalJSON() ([]byte, error) {
+	spec, err := protoutils.MarshalMap(&o.Spec)
+	if err != nil {
+		return nil, err
+	}
+	delete(spec, "metadata")
+	delete(spec, "status")
+	asMap := map[string]interface{}{
+		"metadata":   o.ObjectMeta,
+		"apiVersion": o.TypeMeta.APIVersion,
+		"kind":       o.TypeMeta.Kind,
+		"status":     o.Status,
+		"spec":       spec,
+	}
+	return json.Marshal(asMap)
+}
+
+func (o *TcpRoute) UnmarshalJSON(data []byte) error {
+	var metaOnly metaOnly
+	if err := json.Unmarshal(data, &metaOnly); err != nil {
+		return err
+	}
+	var spec api.TcpRoute
+	if err := protoutils.UnmarshalResource(data, &spec); err != nil {
+		return err
+	}
+	spec.Metadata = nil
+	*o = TcpRoute{
+		ObjectMeta: metaOnly.ObjectMeta,
+		TypeMeta:   metaOnly.TypeMeta,
+		Spec:       spec,
+	}
+	if spec.Status != nil {
+		o.Status = *spec.Status
+		o.Spec.Status = nil
+	}
+
+	return nil
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// TcpRouteList is a collection of TcpRoutes.
+type TcpRouteList struct {
+	v1.TypeMeta `json:",inline"`
+	// +optional
+	v1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Items       []TcpRoute `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +resourceName=virtualhostoptions
 // +genclient
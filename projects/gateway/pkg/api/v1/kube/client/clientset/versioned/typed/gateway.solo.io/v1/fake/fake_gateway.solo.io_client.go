@@ -40,6 +40,10 @@ func (c *FakeGatewayV1) RouteTables(namespace string) v1.RouteTableInterface {
 	return &FakeRouteTables{c, namespace}
 }
 
+func (c *FakeGatewayV1) TcpRoutes(namespace string) v1.TcpRouteInterface {
+	return &FakeTcpRoutes{c, namespace}
+}
+
 func (c *FakeGatewayV1) VirtualHostOptions(namespace string) v1.VirtualHostOptionInterface {
 	return &FakeVirtualHostOptions{c, namespace}
 }
@@ -29,6 +29,7 @@ type GatewayV1Interface interface {
 	GatewaysGetter
 	RouteOptionsGetter
 	RouteTablesGetter
+	TcpRoutesGetter
 	VirtualHostOptionsGetter
 	VirtualServicesGetter
 }
@@ -50,6 +51,10 @@ func (c *GatewayV1Client) RouteTables(namespace string) RouteTableInterface {
 	return newRouteTables(c, namespace)
 }
 
+func (c *GatewayV1Client) TcpRoutes(namespace string) TcpRouteInterface {
+	return newTcpRoutes(c, namespace)
+}
+
 func (c *GatewayV1Client) VirtualHostOptions(namespace string) VirtualHostOptionInterface {
 	return newVirtualHostOptions(c, namespace)
 }
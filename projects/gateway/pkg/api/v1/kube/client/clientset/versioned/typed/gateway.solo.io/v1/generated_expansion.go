@@ -24,6 +24,8 @@ type RouteOptionExpansion interface{}
 
 type RouteTableExpansion interface{}
 
+type TcpRouteExpansion interface{}
+
 type VirtualHostOptionExpansion interface{}
 
 type VirtualServiceExpansion interface{}
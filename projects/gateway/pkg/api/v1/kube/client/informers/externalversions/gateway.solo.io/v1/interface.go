@@ -30,6 +30,8 @@ type Interface interface {
 	RouteOptions() RouteOptionInformer
 	// RouteTables returns a RouteTableInformer.
 	RouteTables() RouteTableInformer
+	// TcpRoutes returns a TcpRouteInformer.
+	TcpRoutes() TcpRouteInformer
 	// VirtualHostOptions returns a VirtualHostOptionInformer.
 	VirtualHostOptions() VirtualHostOptionInformer
 	// VirtualServices returns a VirtualServiceInformer.
@@ -62,6 +64,11 @@ func (v *version) RouteTables() RouteTableInformer {
 	return &routeTableInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// TcpRoutes returns a TcpRouteInformer.
+func (v *version) TcpRoutes() TcpRouteInformer {
+	return &tcpRouteInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // VirtualHostOptions returns a VirtualHostOptionInformer.
 func (v *version) VirtualHostOptions() VirtualHostOptionInformer {
 	return &virtualHostOptionInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
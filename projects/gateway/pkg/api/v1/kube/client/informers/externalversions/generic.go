@@ -59,6 +59,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Gateway().V1().RouteOptions().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("routetables"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Gateway().V1().RouteTables().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("tcproutes"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Gateway().V1().TcpRoutes().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("virtualhostoptions"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Gateway().V1().VirtualHostOptions().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("virtualservices"):
@@ -42,6 +42,14 @@ type RouteTableListerExpansion interface{}
 // RouteTableNamespaceLister.
 type RouteTableNamespaceListerExpansion interface{}
 
+// TcpRouteListerExpansion allows custom methods to be added to
+// TcpRouteLister.
+type TcpRouteListerExpansion interface{}
+
+// TcpRouteNamespaceListerExpansion allows custom methods to be added to
+// TcpRouteNamespaceLister.
+type TcpRouteNamespaceListerExpansion interface{}
+
 // VirtualHostOptionListerExpansion allows custom methods to be added to
 // VirtualHostOptionLister.
 type VirtualHostOptionListerExpansion interface{}
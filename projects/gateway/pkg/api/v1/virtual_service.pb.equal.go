@@ -251,6 +251,17 @@ func (m *Route) Equal(that interface{}) bool {
 		return false
 	}
 
+	if len(m.GetOptionsInheritance()) != len(target.GetOptionsInheritance()) {
+		return false
+	}
+	for k, v := range m.GetOptionsInheritance() {
+
+		if v != target.GetOptionsInheritance()[k] {
+			return false
+		}
+
+	}
+
 	switch m.Action.(type) {
 
 	case *Route_RouteAction:
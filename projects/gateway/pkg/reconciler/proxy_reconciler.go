@@ -74,17 +74,31 @@ func forEachListener(proxy *gloov1.Proxy, reports reporter.ResourceReports, fn f
 	return nil
 }
 
-func forEachVhost(lis *gloov1.Listener, reports reporter.ResourceReports, fn func(*gloov1.VirtualHost, bool)) error {
-	if httpListenerType, ok := lis.ListenerType.(*gloov1.Listener_HttpListener); ok {
+func forEachVhost(httpListener *gloov1.HttpListener, reports reporter.ResourceReports, fn func(*gloov1.VirtualHost, bool)) error {
+	for _, vhost := range httpListener.GetVirtualHosts() {
+		accepted, err := reporting.AllSourcesAccepted(reports, vhost)
+		if err != nil {
+			return err
+		}
 
-		for _, vhost := range httpListenerType.HttpListener.GetVirtualHosts() {
-			accepted, err := reporting.AllSourcesAccepted(reports, vhost)
-			if err != nil {
-				return err
-			}
+		fn(vhost, accepted)
+	}
+	return nil
+}
 
-			fn(vhost, accepted)
+// the http listener of the listener, or the http listeners matched by a hybrid listener
+func getHttpListeners(lis *gloov1.Listener) []*gloov1.HttpListener {
+	switch listenerType := lis.GetListenerType().(type) {
+	case *gloov1.Listener_HttpListener:
+		return []*gloov1.HttpListener{listenerType.HttpListener}
+	case *gloov1.Listener_HybridListener:
+		var httpListeners []*gloov1.HttpListener
+		for _, matchedListener := range listenerType.HybridListener.GetMatchedListeners() {
+			if httpListener := matchedListener.GetHttpListener(); httpListener != nil {
+				httpListeners = append(httpListeners, httpListener)
+			}
 		}
+		return httpListeners
 	}
 	return nil
 }
@@ -150,10 +164,10 @@ func stripInvalidListenersAndVirtualHosts(ctx context.Context, proxiesToWrite Ge
 
 		for _, lis := range proxy.Listeners {
 
-			if httpListenerType, ok := lis.ListenerType.(*gloov1.Listener_HttpListener); ok {
+			for _, httpListener := range getHttpListeners(lis) {
 				var validVhosts []*gloov1.VirtualHost
 
-				if err := forEachVhost(lis, reports, func(vhost *gloov1.VirtualHost, accepted bool) {
+				if err := forEachVhost(httpListener, reports, func(vhost *gloov1.VirtualHost, accepted bool) {
 					if accepted {
 						validVhosts = append(validVhosts, vhost)
 					} else {
@@ -167,7 +181,7 @@ func stripInvalidListenersAndVirtualHosts(ctx context.Context, proxiesToWrite Ge
 					return validVhosts[i].Name < validVhosts[j].Name
 				})
 
-				httpListenerType.HttpListener.VirtualHosts = validVhosts
+				httpListener.VirtualHosts = validVhosts
 			}
 		}
 
@@ -206,8 +220,8 @@ func transitionFunc(proxiesToWrite GeneratedProxies) gloov1.TransitionProxyFunc
 		// preserve previous vhosts if new vservice was errored
 		for _, desiredListener := range desired.Listeners {
 
-			desiredHttpListener := desiredListener.GetHttpListener()
-			if desiredHttpListener == nil {
+			desiredHttpListeners := getHttpListeners(desiredListener)
+			if len(desiredHttpListeners) == 0 {
 				continue
 			}
 
@@ -224,21 +238,28 @@ func transitionFunc(proxiesToWrite GeneratedProxies) gloov1.TransitionProxyFunc
 				continue
 			}
 
-			// find any rejected vhosts on the original listener and copy them over
-			if err := forEachVhost(originalListener, proxiesToWrite[desired], func(vhost *gloov1.VirtualHost, accepted bool) {
-				// old vhost was rejected, preserve it on the desired proxy
-				if !accepted {
-					desiredHttpListener.VirtualHosts = append(desiredHttpListener.VirtualHosts, vhost)
+			// the http listeners of a hybrid listener are paired by their position
+			originalHttpListeners := getHttpListeners(originalListener)
+			for i, desiredHttpListener := range desiredHttpListeners {
+				if i >= len(originalHttpListeners) {
+					break
 				}
-			}); err != nil {
-				// should never happen
-				return false, err
-			}
 
-			sort.SliceStable(desiredHttpListener.VirtualHosts, func(i, j int) bool {
-				return desiredHttpListener.VirtualHosts[i].Name < desiredHttpListener.VirtualHosts[j].Name
-			})
+				// find any rejected vhosts on the original listener and copy them over
+				if err := forEachVhost(originalHttpListeners[i], proxiesToWrite[desired], func(vhost *gloov1.VirtualHost, accepted bool) {
+					// old vhost was rejected, preserve it on the desired proxy
+					if !accepted {
+						desiredHttpListener.VirtualHosts = append(desiredHttpListener.VirtualHosts, vhost)
+					}
+				}); err != nil {
+					// should never happen
+					return false, err
+				}
 
+				sort.SliceStable(desiredHttpListener.VirtualHosts, func(i, j int) bool {
+					return desiredHttpListener.VirtualHosts[i].Name < desiredHttpListener.VirtualHosts[j].Name
+				})
+			}
 		}
 
 		sort.SliceStable(desired.Listeners, func(i, j int) bool {
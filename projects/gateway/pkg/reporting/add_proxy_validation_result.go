@@ -11,9 +11,10 @@ import (
 )
 
 var (
-	invalidReportsListenersErr    = errors.Errorf("internal err: reports did not match number of listeners")
-	invalidReportsVirtualHostsErr = errors.Errorf("internal err: reports did not match number of virtual hosts")
-	missingReportForSourceErr     = errors.Errorf("internal err: missing resource report for source resource")
+	invalidReportsListenersErr        = errors.Errorf("internal err: reports did not match number of listeners")
+	invalidReportsVirtualHostsErr     = errors.Errorf("internal err: reports did not match number of virtual hosts")
+	invalidReportsMatchedListenersErr = errors.Errorf("internal err: reports did not match number of matched listeners")
+	missingReportForSourceErr         = errors.Errorf("internal err: missing resource report for source resource")
 )
 
 // Update a set of ResourceReports with the results of a proxy validation
@@ -36,17 +37,26 @@ func AddProxyValidationResult(resourceReports reporter.ResourceReports, proxy *g
 		}
 
 		if httpListenerReport := listenerReport.GetHttpListenerReport(); httpListenerReport != nil {
-			vhReports := httpListenerReport.GetVirtualHostReports()
-			virtualHosts := listener.GetHttpListener().GetVirtualHosts()
+			if err := addHttpListenerResult(resourceReports, listener.GetHttpListener(), httpListenerReport); err != nil {
+				return err
+			}
+		}
+
+		if hybridListenerReport := listenerReport.GetHybridListenerReport(); hybridListenerReport != nil {
+			matchedListenerReports := hybridListenerReport.GetMatchedListenerReports()
+			matchedListeners := listener.GetHybridListener().GetMatchedListeners()
 
-			if len(vhReports) != len(virtualHosts) {
-				return invalidReportsVirtualHostsErr
+			if len(matchedListenerReports) != len(matchedListeners) {
+				return invalidReportsMatchedListenersErr
 			}
 
-			for j, vhReport := range vhReports {
-				virtualHost := virtualHosts[j]
+			for j, matchedListenerReport := range matchedListenerReports {
+				httpListenerReport := matchedListenerReport.GetHttpListenerReport()
+				if httpListenerReport == nil {
+					continue
+				}
 
-				if err := addVirtualHostResult(resourceReports, virtualHost, vhReport); err != nil {
+				if err := addHttpListenerResult(resourceReports, matchedListeners[j].GetHttpListener(), httpListenerReport); err != nil {
 					return err
 				}
 			}
@@ -56,6 +66,25 @@ func AddProxyValidationResult(resourceReports reporter.ResourceReports, proxy *g
 	return nil
 }
 
+func addHttpListenerResult(resourceReports reporter.ResourceReports, httpListener *gloov1.HttpListener, httpListenerReport *validation.HttpListenerReport) error {
+	vhReports := httpListenerReport.GetVirtualHostReports()
+	virtualHosts := httpListener.GetVirtualHosts()
+
+	if len(vhReports) != len(virtualHosts) {
+		return invalidReportsVirtualHostsErr
+	}
+
+	for j, vhReport := range vhReports {
+		virtualHost := virtualHosts[j]
+
+		if err := addVirtualHostResult(resourceReports, virtualHost, vhReport); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func addListenerResult(resourceReports reporter.ResourceReports, listener *gloov1.Listener, listenerReport *validation.ListenerReport) error {
 	listenerErrs := getListenerLevelErrors(listenerReport)
 
@@ -99,6 +128,23 @@ func getListenerLevelErrors(listenerReport *validation.ListenerReport) []error {
 		for _, hostReport := range tcpListener.GetTcpHostReports() {
 			listenerErrs = append(listenerErrs, validationutils.GetTcpHostErr(hostReport)...)
 		}
+
+	case *validation.ListenerReport_HybridListenerReport:
+		for _, matchedListenerReport := range listenerType.HybridListenerReport.GetMatchedListenerReports() {
+			switch matchedListenerType := matchedListenerReport.ListenerTypeReport.(type) {
+			case *validation.MatchedListenerReport_HttpListenerReport:
+				httpListener := matchedListenerType.HttpListenerReport
+				listenerErrs = append(listenerErrs, validationutils.GetHttpListenerErr(httpListener)...)
+
+			case *validation.MatchedListenerReport_TcpListenerReport:
+				tcpListener := matchedListenerType.TcpListenerReport
+				listenerErrs = append(listenerErrs, validationutils.GetTcpListenerErr(tcpListener)...)
+
+				for _, hostReport := range tcpListener.GetTcpHostReports() {
+					listenerErrs = append(listenerErrs, validationutils.GetTcpHostErr(hostReport)...)
+				}
+			}
+		}
 	}
 
 	return listenerErrs
@@ -415,6 +415,12 @@ func (wh *gatewayValidationWebhook) validate(
 		} else {
 			return wh.validateRouteTable(ctx, rawJson, dryRun)
 		}
+	case gwv1.TcpRouteGVK:
+		if isDelete {
+			// tcp routes are only selected by gateways, so their deletion is not validated
+			break
+		}
+		return wh.validateTcpRoute(ctx, rawJson, dryRun)
 	}
 	return validation.ProxyReports{}, nil
 
@@ -489,3 +495,21 @@ func (wh *gatewayValidationWebhook) validateRouteTable(ctx context.Context, rawJ
 	}
 	return proxyReports, nil
 }
+
+func (wh *gatewayValidationWebhook) validateTcpRoute(ctx context.Context, rawJson []byte, dryRun bool) (validation.ProxyReports, *multierror.Error) {
+	var (
+		tr           gwv1.TcpRoute
+		proxyReports validation.ProxyReports
+		err          error
+	)
+	if err := protoutils.UnmarshalResource(rawJson, &tr); err != nil {
+		return nil, &multierror.Error{Errors: []error{WrappedUnmarshalErr(err)}}
+	}
+	if skipValidationCheck(tr.Metadata.Annotations) {
+		return nil, nil
+	}
+	if proxyReports, err = wh.validator.ValidateTcpRoute(ctx, &tr, dryRun); err != nil {
+		return proxyReports, &multierror.Error{Errors: []error{errors.Wrapf(err, "Validating %T failed", tr)}}
+	}
+	return proxyReports, nil
+}
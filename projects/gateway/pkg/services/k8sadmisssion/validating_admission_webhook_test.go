@@ -59,6 +59,8 @@ var _ = Describe("ValidatingAdmissionWebhook", func() {
 
 	routeTable := &v1.RouteTable{Metadata: &core.Metadata{Namespace: "namespace", Name: "rt"}}
 
+	tcpRoute := &v1.TcpRoute{Metadata: &core.Metadata{Namespace: "namespace", Name: "tr"}}
+
 	errMsg := "didn't say the magic word"
 
 	DescribeTable("accepts valid admission requests, rejects bad ones", func(valid bool, crd crd.Crd, gvk schema.GroupVersionKind, resource interface{}) {
@@ -78,6 +80,9 @@ var _ = Describe("ValidatingAdmissionWebhook", func() {
 			mv.fValidateRouteTable = func(ctx context.Context, rt *v1.RouteTable, dryRun bool) (validation.ProxyReports, error) {
 				return proxyReports(), fmt.Errorf(errMsg)
 			}
+			mv.fValidateTcpRoute = func(ctx context.Context, tr *v1.TcpRoute, dryRun bool) (validation.ProxyReports, error) {
+				return proxyReports(), fmt.Errorf(errMsg)
+			}
 		}
 		req, err := makeReviewRequest(srv.URL, crd, gvk, v1beta1.Create, resource)
 
@@ -104,6 +109,8 @@ var _ = Describe("ValidatingAdmissionWebhook", func() {
 		Entry("invalid virtual service", false, v1.VirtualServiceCrd, v1.VirtualServiceCrd.GroupVersionKind(), vs),
 		Entry("valid route table", true, v1.RouteTableCrd, v1.RouteTableCrd.GroupVersionKind(), routeTable),
 		Entry("invalid route table", false, v1.RouteTableCrd, v1.RouteTableCrd.GroupVersionKind(), routeTable),
+		Entry("valid tcp route", true, v1.TcpRouteCrd, v1.TcpRouteCrd.GroupVersionKind(), tcpRoute),
+		Entry("invalid tcp route", false, v1.TcpRouteCrd, v1.TcpRouteCrd.GroupVersionKind(), tcpRoute),
 		Entry("valid unstructured list", true, nil, ListGVK, unstructuredList),
 		Entry("invalid unstructured list", false, nil, ListGVK, unstructuredList),
 	)
@@ -306,6 +313,7 @@ type mockValidator struct {
 	fValidateDeleteVirtualService func(ctx context.Context, vs *core.ResourceRef, dryRun bool) error
 	fValidateRouteTable           func(ctx context.Context, rt *v1.RouteTable, dryRun bool) (validation.ProxyReports, error)
 	fValidateDeleteRouteTable     func(ctx context.Context, rt *core.ResourceRef, dryRun bool) error
+	fValidateTcpRoute             func(ctx context.Context, tr *v1.TcpRoute, dryRun bool) (validation.ProxyReports, error)
 }
 
 func (v *mockValidator) Sync(ctx context.Context, snap *v1.ApiSnapshot) error {
@@ -357,6 +365,13 @@ func (v *mockValidator) ValidateDeleteRouteTable(ctx context.Context, rt *core.R
 	return v.fValidateDeleteRouteTable(ctx, rt, dryRun)
 }
 
+func (v *mockValidator) ValidateTcpRoute(ctx context.Context, tr *v1.TcpRoute, dryRun bool) (validation.ProxyReports, error) {
+	if v.fValidateTcpRoute == nil {
+		return proxyReports(), nil
+	}
+	return v.fValidateTcpRoute(ctx, tr, dryRun)
+}
+
 func proxyReports() validation.ProxyReports {
 	return validation.ProxyReports{
 		{
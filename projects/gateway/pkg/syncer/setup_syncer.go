@@ -82,6 +82,11 @@ func Setup(ctx context.Context, kubeCache kube.SharedCache, inMemoryCache memory
 		return err
 	}
 
+	tcpRouteFactory, err := bootstrap.ConfigFactoryForSettings(params, v1.TcpRouteCrd)
+	if err != nil {
+		return err
+	}
+
 	gatewayFactory, err := bootstrap.ConfigFactoryForSettings(params, v1.GatewayCrd)
 	if err != nil {
 		return err
@@ -149,6 +154,7 @@ func Setup(ctx context.Context, kubeCache kube.SharedCache, inMemoryCache memory
 		Proxies:            proxyFactory,
 		VirtualHostOptions: virtualHostOptionFactory,
 		RouteOptions:       routeOptionFactory,
+		TcpRoutes:          tcpRouteFactory,
 		WatchOpts: clients.WatchOpts{
 			Ctx:         ctx,
 			RefreshRate: refreshRate,
@@ -215,12 +221,21 @@ func RunGateway(opts translator.Opts) error {
 		return err
 	}
 
+	tcpRouteClient, err := v1.NewTcpRouteClient(ctx, opts.TcpRoutes)
+	if err != nil {
+		return err
+	}
+	if err := tcpRouteClient.Register(); err != nil {
+		return err
+	}
+
 	rpt := reporter.NewReporter("gateway",
 		gatewayClient.BaseClient(),
 		virtualServiceClient.BaseClient(),
 		routeTableClient.BaseClient(),
 		virtualHostOptionClient.BaseClient(),
 		routeOptionClient.BaseClient(),
+		tcpRouteClient.BaseClient(),
 	)
 	writeErrs := make(chan error)
 
@@ -255,7 +270,7 @@ func RunGateway(opts translator.Opts) error {
 		allowWarnings = opts.Validation.AllowWarnings
 	}
 
-	emitter := v1.NewApiEmitterWithEmit(virtualServiceClient, routeTableClient, gatewayClient, virtualHostOptionClient, routeOptionClient, notifications)
+	emitter := v1.NewApiEmitterWithEmit(virtualServiceClient, routeTableClient, gatewayClient, virtualHostOptionClient, routeOptionClient, tcpRouteClient, notifications)
 
 	validationSyncer := gatewayvalidation.NewValidator(gatewayvalidation.NewValidatorConfig(
 		txlator,
@@ -35,6 +35,9 @@ var (
 	InvalidHeaderErr     = errors.New("invalid route: route table matchers must have all headers that were specified on their parent route's matcher")
 	InvalidQueryParamErr = errors.New("invalid route: route table matchers must have all query params that were specified on their parent route's matcher")
 	InvalidMethodErr     = errors.New("invalid route: route table matchers must have all methods that were specified on their parent route's matcher")
+	InvalidOptionErr     = errors.New("invalid route: route table routes must not override the options their parent route forbids to override")
+
+	OptionsInheritanceWithoutDelegateActionErr = errors.New("options inheritance is ignored: it only applies to the routes of delegate actions")
 
 	DelegationCycleErr = func(cycleInfo string) error {
 		return errors.Errorf("invalid route: delegation cycle detected: %s", cycleInfo)
@@ -51,6 +54,12 @@ var (
 	InvalidRouteTableForDelegateMethodsErr = func(delegateMethods, childMethods []string) error {
 		return errors.Wrapf(InvalidMethodErr, "required methods: %v, methods: %v", delegateMethods, childMethods)
 	}
+	InvalidRouteTableForDelegateOptionErr = func(option string) error {
+		return errors.Wrapf(InvalidOptionErr, "option: %v", option)
+	}
+	UnknownOptionsInheritanceOptionErr = func(option string) error {
+		return errors.Errorf("invalid route: options inheritance refers to unknown route option %v", option)
+	}
 	TopLevelVirtualResourceErr = func(rtRef *core.Metadata, err error) error {
 		return errors.Wrapf(err, "on sub route table %s", rtRef.Ref().Key())
 	}
@@ -121,6 +130,8 @@ type routeInfo struct {
 	inheritableMatchers bool
 	// Whether any child route objects should inherit path matchers from the parent.
 	inheritablePathMatchers bool
+	// How the options of the route apply to the child route objects, by option name.
+	optionsInheritance map[string]gatewayv1.Route_OptionInheritancePolicy
 }
 
 // Helper object for reporting errors and warnings
@@ -228,6 +239,12 @@ func (rv *routeVisitor) visit(
 				continue
 			}
 
+			// Validate the options inheritance of the delegate route
+			if err := validateOptionsInheritance(routeClone.GetOptionsInheritance()); err != nil {
+				reporterHelper.addError(resource.InputResource(), err)
+				continue
+			}
+
 			// Determine the route tables to delegate to
 			routeTables, err := rv.routeTableSelector.SelectRouteTables(action.DelegateAction, resource.InputResource().GetMetadata().GetNamespace())
 			if err != nil {
@@ -267,6 +284,7 @@ func (rv *routeVisitor) visit(
 						hasName:                 routeHasName,
 						inheritableMatchers:     routeClone.InheritableMatchers.GetValue(),
 						inheritablePathMatchers: routeClone.InheritablePathMatchers.GetValue(),
+						optionsInheritance:      routeClone.GetOptionsInheritance(),
 					}
 
 					// Make a copy of the existing set of visited route tables. We need to pass this information into
@@ -298,6 +316,11 @@ func (rv *routeVisitor) visit(
 
 		default:
 
+			// The options inheritance of the route itself (as opposed to the one merged from its parents) has no children to apply to
+			if len(gatewayRoute.GetOptionsInheritance()) > 0 {
+				reporterHelper.addWarning(resource.InputResource(), OptionsInheritanceWithoutDelegateActionErr)
+			}
+
 			// If there are no named routes on this branch of the route tree, then wipe the name.
 			if !routeHasName {
 				routeClone.Name = ""
@@ -488,9 +511,16 @@ func validateAndMergeParentRoute(child *gatewayv1.Route, parent *routeInfo) (*ga
 		return nil, err
 	}
 
+	// Apply the options inheritance of the parent route, and pass it down to the routes the child delegates to
+	childOptions, err := applyOptionsInheritance(child.GetOptions(), parent.options, parent.optionsInheritance)
+	if err != nil {
+		return nil, err
+	}
+	child.OptionsInheritance = mergeOptionsInheritance(child.GetOptionsInheritance(), parent.optionsInheritance)
+
 	// Merge options from parent routes
-	// If an option is defined on a parent route, it will override the child route's option
-	merged, err := mergeRouteOptions(child.GetOptions(), parent.options)
+	// If an option is defined on a parent route, it will be used unless the child route defines the option itself
+	merged, err := mergeRouteOptions(childOptions, parent.options)
 	if err != nil {
 		// Should never happen
 		return nil, errors.Wrapf(err, "internal error: merging route options from parent to delegated route")
@@ -110,6 +110,29 @@ var _ = Describe("Route converter", func() {
 			},
 			translator.MatcherCountErr,
 		),
+
+		Entry("route has an options inheritance policy for an unknown option",
+			&v1.Route{
+				Matchers: []*matchers.Matcher{{
+					PathSpecifier: &matchers.Matcher_Prefix{
+						Prefix: "/foo",
+					},
+				}},
+				Action: &v1.Route_DelegateAction{
+					DelegateAction: &v1.DelegateAction{
+						DelegationType: &v1.DelegateAction_Ref{
+							Ref: &core.ResourceRef{
+								Name: "foo",
+							},
+						},
+					},
+				},
+				OptionsInheritance: map[string]v1.Route_OptionInheritancePolicy{
+					"unknown": v1.Route_Override,
+				},
+			},
+			translator.UnknownOptionsInheritanceOptionErr("unknown"),
+		),
 	)
 
 	When("valid config", func() {
@@ -153,6 +176,39 @@ var _ = Describe("Route converter", func() {
 			Expect(converted[0].Matchers[0]).To(Equal(defaults.DefaultMatcher()))
 		})
 
+		It("warns about options inheritance on routes without a delegate action", func() {
+			route := &v1.Route{
+				Action: &v1.Route_DirectResponseAction{},
+				OptionsInheritance: map[string]v1.Route_OptionInheritancePolicy{
+					"prefixRewrite": v1.Route_Override,
+				},
+			}
+
+			rpt := reporter.ResourceReports{}
+			vs := &v1.VirtualService{
+				VirtualHost: &v1.VirtualHost{
+					Routes: []*v1.Route{route},
+				},
+				Metadata: &core.Metadata{
+					Name:      "vs",
+					Namespace: "vs-ns",
+				},
+			}
+			rpt.Accept(vs)
+
+			rv := translator.NewRouteConverter(
+				translator.NewRouteTableSelector(v1.RouteTableList{}),
+				translator.NewRouteTableIndexer(),
+			)
+			converted, err := rv.ConvertVirtualService(vs, snapshot, rpt)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(converted).To(HaveLen(1))
+
+			_, vsReport := rpt.Find("*v1.VirtualService", vs.Metadata.Ref())
+			Expect(vsReport.Errors).To(BeNil())
+			Expect(vsReport.Warnings).To(ConsistOf(translator.OptionsInheritanceWithoutDelegateActionErr.Error()))
+		})
+
 		It("uses parent resource's namespace as default if namespace is omitted on routeAction with single upstream destination", func() {
 			route := &v1.Route{
 				Matchers: []*matchers.Matcher{{}}, // empty struct in list of size one should default to '/'
@@ -808,6 +864,192 @@ var _ = Describe("Route converter", func() {
 				}))
 			})
 		})
+
+		Context("options inheritance", func() {
+
+			var (
+				vs  *v1.VirtualService
+				rt1 *v1.RouteTable
+				rt2 *v1.RouteTable
+				rv  translator.RouteConverter
+			)
+
+			BeforeEach(func() {
+				rt2 = &v1.RouteTable{
+					Metadata: &core.Metadata{
+						Name:      "rt2",
+						Namespace: "default",
+					},
+					Routes: []*v1.Route{{
+						Matchers: []*matchers.Matcher{{
+							PathSpecifier: &matchers.Matcher_Prefix{
+								Prefix: "/foo/1/2",
+							},
+						}},
+						Options: &gloov1.RouteOptions{
+							PrefixRewrite: &wrappers.StringValue{Value: "/rt2"},
+						},
+						Action: &v1.Route_DirectResponseAction{
+							DirectResponseAction: &gloov1.DirectResponseAction{
+								Status: 200,
+							},
+						},
+					}},
+				}
+
+				rt1 = &v1.RouteTable{
+					Metadata: &core.Metadata{
+						Name:      "rt1",
+						Namespace: "default",
+					},
+					Routes: []*v1.Route{
+						{
+							Matchers: []*matchers.Matcher{{
+								PathSpecifier: &matchers.Matcher_Prefix{
+									Prefix: "/foo/1/2",
+								},
+							}},
+							Action: &v1.Route_DelegateAction{
+								DelegateAction: &v1.DelegateAction{
+									DelegationType: &v1.DelegateAction_Ref{
+										Ref: &core.ResourceRef{
+											Name:      "rt2",
+											Namespace: "default",
+										},
+									},
+								},
+							},
+						},
+						{
+							Matchers: []*matchers.Matcher{{
+								PathSpecifier: &matchers.Matcher_Prefix{
+									Prefix: "/foo/1",
+								},
+							}},
+							Options: &gloov1.RouteOptions{
+								PrefixRewrite: &wrappers.StringValue{Value: "/rt1"},
+								HostRewriteType: &gloov1.RouteOptions_AutoHostRewrite{
+									AutoHostRewrite: &wrappers.BoolValue{Value: true},
+								},
+							},
+							Action: &v1.Route_DirectResponseAction{
+								DirectResponseAction: &gloov1.DirectResponseAction{
+									Status: 200,
+								},
+							},
+						},
+					},
+				}
+
+				vs = &v1.VirtualService{
+					Metadata: &core.Metadata{
+						Name:      "vs",
+						Namespace: "default",
+					},
+					VirtualHost: &v1.VirtualHost{
+						Routes: []*v1.Route{{
+							Matchers: []*matchers.Matcher{{
+								PathSpecifier: &matchers.Matcher_Prefix{
+									Prefix: "/foo",
+								},
+							}},
+							Options: &gloov1.RouteOptions{
+								PrefixRewrite: &wrappers.StringValue{Value: "/vs"},
+								HostRewriteType: &gloov1.RouteOptions_HostRewrite{
+									HostRewrite: "vs.example.com",
+								},
+							},
+							Action: &v1.Route_DelegateAction{
+								DelegateAction: &v1.DelegateAction{
+									DelegationType: &v1.DelegateAction_Ref{
+										Ref: &core.ResourceRef{
+											Name:      "rt1",
+											Namespace: "default",
+										},
+									},
+								},
+							},
+						}},
+					},
+				}
+
+				rv = translator.NewRouteConverter(
+					translator.NewRouteTableSelector(v1.RouteTableList{rt1, rt2}),
+					translator.NewRouteTableIndexer(),
+				)
+			})
+
+			It("lets delegated routes replace the options of their parent by default", func() {
+				rpt := reporter.ResourceReports{}
+				converted, err := rv.ConvertVirtualService(vs, snapshot, rpt)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(rpt).To(HaveLen(0))
+				Expect(converted).To(HaveLen(2))
+
+				Expect(converted[0].GetOptions().GetPrefixRewrite().GetValue()).To(Equal("/rt2"))
+				Expect(converted[0].GetOptions().GetHostRewrite()).To(Equal("vs.example.com"))
+				Expect(converted[1].GetOptions().GetPrefixRewrite().GetValue()).To(Equal("/rt1"))
+				Expect(converted[1].GetOptions().GetAutoHostRewrite().GetValue()).To(BeTrue())
+			})
+
+			It("replaces the options of delegated routes with the ones the parent overrides", func() {
+				vs.VirtualHost.Routes[0].OptionsInheritance = map[string]v1.Route_OptionInheritancePolicy{
+					"prefixRewrite": v1.Route_Override,
+					"hostRewrite":   v1.Route_Override,
+				}
+
+				rpt := reporter.ResourceReports{}
+				converted, err := rv.ConvertVirtualService(vs, snapshot, rpt)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(rpt).To(HaveLen(0))
+				Expect(converted).To(HaveLen(2))
+
+				By("passing the policies down to nested route tables")
+				Expect(converted[0].GetOptions().GetPrefixRewrite().GetValue()).To(Equal("/vs"))
+
+				By("overriding the other fields of the oneof of an option")
+				Expect(converted[1].GetOptions().GetPrefixRewrite().GetValue()).To(Equal("/vs"))
+				Expect(converted[1].GetOptions().GetHostRewrite()).To(Equal("vs.example.com"))
+				Expect(converted[1].GetOptions().GetAutoHostRewrite()).To(BeNil())
+
+				By("not modifying the route tables")
+				Expect(rt1.Routes[1].GetOptions().GetPrefixRewrite().GetValue()).To(Equal("/rt1"))
+			})
+
+			It("accepts delegated routes that set an option the parent forbids to override to the same value", func() {
+				vs.VirtualHost.Routes[0].OptionsInheritance = map[string]v1.Route_OptionInheritancePolicy{
+					"prefixRewrite": v1.Route_ForbidChildOverride,
+				}
+				rt1.Routes[1].Options.PrefixRewrite = &wrappers.StringValue{Value: "/vs"}
+				rt2.Routes[0].Options.PrefixRewrite = &wrappers.StringValue{Value: "/vs"}
+
+				rpt := reporter.ResourceReports{}
+				converted, err := rv.ConvertVirtualService(vs, snapshot, rpt)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(rpt).To(HaveLen(0))
+				Expect(converted).To(HaveLen(2))
+			})
+
+			It("does not let nested route tables loosen the policies of their parents", func() {
+				vs.VirtualHost.Routes[0].OptionsInheritance = map[string]v1.Route_OptionInheritancePolicy{
+					"prefixRewrite": v1.Route_ForbidChildOverride,
+				}
+				rt1.Routes[0].OptionsInheritance = map[string]v1.Route_OptionInheritancePolicy{
+					"prefixRewrite": v1.Route_Inherit,
+				}
+				rt1.Routes[1].Options.PrefixRewrite = &wrappers.StringValue{Value: "/vs"}
+
+				rpt := reporter.ResourceReports{}
+				converted, err := rv.ConvertVirtualService(vs, snapshot, rpt)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(converted).To(HaveLen(1))
+				Expect(converted[0].GetOptions().GetPrefixRewrite().GetValue()).To(Equal("/vs"))
+
+				expectedErr := translator.InvalidRouteTableForDelegateOptionErr("prefixRewrite").Error()
+				_, rtReport := rpt.Find("*v1.RouteTable", rt2.Metadata.Ref())
+				Expect(rtReport.Errors).To(MatchError(ContainSubstring(expectedErr)))
+			})
+		})
 	})
 
 	When("bad route table config", func() {
@@ -1303,6 +1545,39 @@ var _ = Describe("Route converter", func() {
 
 		})
 
+		When("route table route overrides an option its parent route forbids to override", func() {
+			It("reports error on the route table and on the virtual service", func() {
+				vs.VirtualHost.Routes[0].Options = &gloov1.RouteOptions{
+					PrefixRewrite: &wrappers.StringValue{Value: "/vs"},
+				}
+				vs.VirtualHost.Routes[0].OptionsInheritance = map[string]v1.Route_OptionInheritancePolicy{
+					"prefixRewrite": v1.Route_ForbidChildOverride,
+				}
+				rt.Routes[0].Matchers = []*matchers.Matcher{{
+					PathSpecifier: &matchers.Matcher_Prefix{
+						Prefix: "/foo/bar",
+					},
+				}}
+				rt.Routes[0].Options = &gloov1.RouteOptions{
+					PrefixRewrite: &wrappers.StringValue{Value: "/rt"},
+				}
+
+				rpt := reporter.ResourceReports{}
+				converted, err := rv.ConvertVirtualService(vs, snapshot, rpt)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(converted).To(BeNil())
+				Expect(rpt).To(HaveLen(2))
+
+				expectedErr := translator.InvalidRouteTableForDelegateOptionErr("prefixRewrite").Error()
+
+				_, vsReport := rpt.Find("*v1.VirtualService", vs.Metadata.Ref())
+				Expect(vsReport.Errors).To(MatchError(ContainSubstring(expectedErr)))
+
+				_, rtReport := rpt.Find("*v1.RouteTable", rt.Metadata.Ref())
+				Expect(rtReport.Errors).To(MatchError(ContainSubstring(expectedErr)))
+			})
+		})
+
 		When("route table has no matchers and the parent route matcher is not the default one", func() {
 			It("reports error on the route table and on the virtual service", func() {
 				rpt := reporter.ResourceReports{}
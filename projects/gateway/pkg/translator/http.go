@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
@@ -134,10 +135,14 @@ func validateVirtualServiceDomains(gateway *v1.Gateway, virtualServices v1.Virtu
 }
 
 func getVirtualServicesForGateway(gateway *v1.Gateway, virtualServices v1.VirtualServiceList) v1.VirtualServiceList {
+	return getVirtualServicesForHttpGateway(gateway.GetHttpGateway(), gateway.Ssl, virtualServices)
+}
+
+func getVirtualServicesForHttpGateway(httpGateway *v1.HttpGateway, ssl bool, virtualServices v1.VirtualServiceList) v1.VirtualServiceList {
 
 	var virtualServicesForGateway v1.VirtualServiceList
 	for _, vs := range virtualServices {
-		if GatewayContainsVirtualService(gateway, vs) {
+		if httpGatewayContainsVirtualService(httpGateway, ssl, vs) {
 			virtualServicesForGateway = append(virtualServicesForGateway, vs)
 		}
 	}
@@ -146,12 +151,26 @@ func getVirtualServicesForGateway(gateway *v1.Gateway, virtualServices v1.Virtua
 }
 
 func GatewayContainsVirtualService(gateway *v1.Gateway, virtualService *v1.VirtualService) bool {
-	httpGateway := gateway.GetHttpGateway()
+	if httpGateway := gateway.GetHttpGateway(); httpGateway != nil {
+		return httpGatewayContainsVirtualService(httpGateway, gateway.Ssl, virtualService)
+	}
+
+	for _, matchedGateway := range gateway.GetHybridGateway().GetMatchedGateways() {
+		ssl := matchedGateway.GetMatcher().GetSslConfig() != nil
+		if httpGatewayContainsVirtualService(matchedGateway.GetHttpGateway(), ssl, virtualService) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func httpGatewayContainsVirtualService(httpGateway *v1.HttpGateway, ssl bool, virtualService *v1.VirtualService) bool {
 	if httpGateway == nil {
 		return false
 	}
 
-	if gateway.Ssl != hasSsl(virtualService) {
+	if ssl != hasSsl(virtualService) {
 		return false
 	}
 
@@ -161,13 +180,13 @@ func GatewayContainsVirtualService(gateway *v1.Gateway, virtualService *v1.Virtu
 
 		vsLabels := labels.Set(virtualService.Metadata.Labels)
 
-		return virtualServiceNamespaceValidForGateway(gateway, virtualService) && selector.Matches(vsLabels)
+		return virtualServiceNamespaceValidForGateway(httpGateway, virtualService) && selector.Matches(vsLabels)
 	}
 	// use individual refs to collect virtual services
 	virtualServiceRefs := httpGateway.VirtualServices
 
 	if len(virtualServiceRefs) == 0 {
-		return virtualServiceNamespaceValidForGateway(gateway, virtualService)
+		return virtualServiceNamespaceValidForGateway(httpGateway, virtualService)
 	}
 
 	vsRef := virtualService.Metadata.Ref()
@@ -181,12 +200,7 @@ func GatewayContainsVirtualService(gateway *v1.Gateway, virtualService *v1.Virtu
 	return false
 }
 
-func virtualServiceNamespaceValidForGateway(gateway *v1.Gateway, virtualService *v1.VirtualService) bool {
-	httpGateway := gateway.GetHttpGateway()
-	if httpGateway == nil {
-		return false
-	}
-
+func virtualServiceNamespaceValidForGateway(httpGateway *v1.HttpGateway, virtualService *v1.VirtualService) bool {
 	if len(httpGateway.VirtualServiceNamespaces) > 0 {
 		for _, ns := range httpGateway.VirtualServiceNamespaces {
 			if ns == "*" || virtualService.Metadata.Namespace == ns {
@@ -205,6 +219,24 @@ func hasSsl(vs *v1.VirtualService) bool {
 }
 
 func (t *HttpTranslator) desiredListenerForHttp(gateway *v1.Gateway, virtualServicesForGateway v1.VirtualServiceList, snapshot *v1.ApiSnapshot, reports reporter.ResourceReports) *gloov1.Listener {
+	httpListener, sslConfigs := t.desiredHttpListener(gateway.GetHttpGateway(), virtualServicesForGateway, snapshot, reports)
+
+	listener := makeListener(gateway)
+	listener.ListenerType = &gloov1.Listener_HttpListener{
+		HttpListener: httpListener,
+	}
+	listener.SslConfigurations = sslConfigs
+
+	if err := appendSource(listener, gateway); err != nil {
+		// should never happen
+		reports.AddError(gateway, err)
+	}
+
+	return listener
+}
+
+// returns the http listener for the virtual services along with their ssl configs
+func (t *HttpTranslator) desiredHttpListener(httpGateway *v1.HttpGateway, virtualServicesForGateway v1.VirtualServiceList, snapshot *v1.ApiSnapshot, reports reporter.ResourceReports) (*gloov1.HttpListener, []*gloov1.SslConfig) {
 	var (
 		virtualHosts []*gloov1.VirtualHost
 		sslConfigs   []*gloov1.SslConfig
@@ -225,25 +257,10 @@ func (t *HttpTranslator) desiredListenerForHttp(gateway *v1.Gateway, virtualServ
 		}
 	}
 
-	var httpPlugins *gloov1.HttpListenerOptions
-	if httpGateway := gateway.GetHttpGateway(); httpGateway != nil {
-		httpPlugins = httpGateway.Options
-	}
-	listener := makeListener(gateway)
-	listener.ListenerType = &gloov1.Listener_HttpListener{
-		HttpListener: &gloov1.HttpListener{
-			VirtualHosts: virtualHosts,
-			Options:      httpPlugins,
-		},
-	}
-	listener.SslConfigurations = sslConfigs
-
-	if err := appendSource(listener, gateway); err != nil {
-		// should never happen
-		reports.AddError(gateway, err)
-	}
-
-	return listener
+	return &gloov1.HttpListener{
+		VirtualHosts: virtualHosts,
+		Options:      httpGateway.GetOptions(),
+	}, sslConfigs
 }
 
 func (t *HttpTranslator) virtualServiceToVirtualHost(vs *v1.VirtualService, snapshot *v1.ApiSnapshot, reports reporter.ResourceReports) (*gloov1.VirtualHost, error) {
@@ -277,6 +294,18 @@ func (t *HttpTranslator) virtualServiceToVirtualHost(vs *v1.VirtualService, snap
 	return vh, nil
 }
 
+// Converts the virtual service to the virtual host it is translated to, i.e. with the routes of its delegated route
+// tables merged in their final order. Route short-circuiting is always validated, so that any hijacked routes are
+// added as warnings to the reports. The given virtual service is not modified.
+func FlattenVirtualService(vs *v1.VirtualService, snapshot *v1.ApiSnapshot, reports reporter.ResourceReports) (*gloov1.VirtualHost, error) {
+	vs = proto.Clone(vs).(*v1.VirtualService)
+	if vs.GetVirtualHost() == nil {
+		vs.VirtualHost = &v1.VirtualHost{}
+	}
+	t := &HttpTranslator{WarnOnRouteShortCircuiting: true}
+	return t.virtualServiceToVirtualHost(vs, snapshot, reports)
+}
+
 // finds delegated VirtualHostOption Objects and merges the options into the virtual service
 func (t *HttpTranslator) mergeDelegatedVirtualHostOptions(vs *v1.VirtualService, options v1.VirtualHostOptionList, reports reporter.ResourceReports) {
 	optionRefs := vs.GetVirtualHost().GetOptionsConfigRefs().GetDelegateOptions()
@@ -0,0 +1,70 @@
+package translator
+
+import (
+	"context"
+
+	errors "github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+)
+
+var (
+	NoMatchedGatewayTypeErr = errors.New("matched gateway does not specify an http or tcp gateway")
+)
+
+type HybridTranslator struct {
+	HttpTranslator *HttpTranslator
+	TcpTranslator  *TcpTranslator
+}
+
+func (t *HybridTranslator) GenerateListeners(ctx context.Context, snap *v1.ApiSnapshot, filteredGateways []*v1.Gateway, reports reporter.ResourceReports) []*gloov1.Listener {
+	var result []*gloov1.Listener
+	for _, gateway := range filteredGateways {
+		hybridGateway := gateway.GetHybridGateway()
+		if hybridGateway == nil {
+			continue
+		}
+		listener := makeListener(gateway)
+
+		if err := appendSource(listener, gateway); err != nil {
+			// should never happen
+			reports.AddError(gateway, err)
+		}
+
+		hybridListener := &gloov1.HybridListener{}
+		for _, matchedGateway := range hybridGateway.GetMatchedGateways() {
+			matchedListener := &gloov1.MatchedListener{
+				Matcher: matchedGateway.GetMatcher(),
+			}
+
+			switch gatewayType := matchedGateway.GetGatewayType().(type) {
+			case *v1.MatchedGateway_HttpGateway:
+				// the virtual services are selected by the ssl config of the matcher rather than the ssl flag of the gateway
+				ssl := matchedGateway.GetMatcher().GetSslConfig() != nil
+				virtualServices := getVirtualServicesForHttpGateway(gatewayType.HttpGateway, ssl, snap.VirtualServices)
+				validateVirtualServiceDomains(gateway, virtualServices, reports)
+				// the ssl config of the matcher is used to terminate tls, so the ones of the virtual services are ignored
+				httpListener, _ := t.HttpTranslator.desiredHttpListener(gatewayType.HttpGateway, virtualServices, snap, reports)
+				matchedListener.ListenerType = &gloov1.MatchedListener_HttpListener{
+					HttpListener: httpListener,
+				}
+			case *v1.MatchedGateway_TcpGateway:
+				matchedListener.ListenerType = &gloov1.MatchedListener_TcpListener{
+					TcpListener: t.TcpTranslator.desiredTcpListener(gatewayType.TcpGateway, listener, snap, reports),
+				}
+			default:
+				reports.AddError(gateway, NoMatchedGatewayTypeErr)
+				continue
+			}
+
+			hybridListener.MatchedListeners = append(hybridListener.MatchedListeners, matchedListener)
+		}
+
+		listener.ListenerType = &gloov1.Listener_HybridListener{
+			HybridListener: hybridListener,
+		}
+		result = append(result, listener)
+	}
+	return result
+}
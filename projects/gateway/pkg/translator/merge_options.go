@@ -2,9 +2,12 @@ package translator
 
 import (
 	"reflect"
+	"sort"
 
 	"github.com/golang/protobuf/proto"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 // Merges the fields of src into dst.
@@ -49,6 +52,104 @@ func mergeVirtualHostOptions(dst, src *v1.VirtualHostOptions) (*v1.VirtualHostOp
 	return dst, nil
 }
 
+// Returns an error if the options inheritance refers to options that don't exist.
+func validateOptionsInheritance(optionsInheritance map[string]gatewayv1.Route_OptionInheritancePolicy) error {
+	for _, option := range sortedOptionNames(optionsInheritance) {
+		if routeOptionField(option) == nil {
+			return UnknownOptionsInheritanceOptionErr(option)
+		}
+	}
+	return nil
+}
+
+// Applies the options inheritance of the parent route to the options of a child route, before the options of the
+// parent are merged into them: the options the parent overrides are removed from the child options, and an error is
+// returned if the child sets an option the parent forbids to override to a different value.
+// Policies for options that are not set on the parent have no effect. The given child options are not modified.
+func applyOptionsInheritance(child, parent *v1.RouteOptions, optionsInheritance map[string]gatewayv1.Route_OptionInheritancePolicy) (*v1.RouteOptions, error) {
+	if child == nil || parent == nil || len(optionsInheritance) == 0 {
+		return child, nil
+	}
+
+	child = proto.Clone(child).(*v1.RouteOptions)
+	childMsg, parentMsg := child.ProtoReflect(), parent.ProtoReflect()
+
+	for _, option := range sortedOptionNames(optionsInheritance) {
+		field := routeOptionField(option)
+		if field == nil {
+			// validated when visiting the parent route
+			continue
+		}
+		parentField, childField := setOptionField(parentMsg, field), setOptionField(childMsg, field)
+		if parentField == nil || childField == nil {
+			continue
+		}
+
+		switch optionsInheritance[option] {
+		case gatewayv1.Route_Override:
+			childMsg.Clear(childField)
+		case gatewayv1.Route_ForbidChildOverride:
+			if childField != parentField || !optionValuesEqual(childMsg, parentMsg, childField) {
+				return nil, InvalidRouteTableForDelegateOptionErr(option)
+			}
+		}
+	}
+
+	return child, nil
+}
+
+// Returns the options inheritance of a child route, made at least as strict as the one of its parent route.
+// Policies are ordered from the least to the most strict one.
+func mergeOptionsInheritance(child, parent map[string]gatewayv1.Route_OptionInheritancePolicy) map[string]gatewayv1.Route_OptionInheritancePolicy {
+	if len(parent) == 0 {
+		return child
+	}
+
+	merged := make(map[string]gatewayv1.Route_OptionInheritancePolicy, len(child)+len(parent))
+	for option, policy := range child {
+		merged[option] = policy
+	}
+	for option, policy := range parent {
+		if policy > merged[option] {
+			merged[option] = policy
+		}
+	}
+	return merged
+}
+
+// Returns the route options field with the given JSON name, or nil if there is none.
+func routeOptionField(option string) protoreflect.FieldDescriptor {
+	return (&v1.RouteOptions{}).ProtoReflect().Descriptor().Fields().ByJSONName(option)
+}
+
+// Returns the given field if it is set. For fields of a oneof, the field of the oneof that is set is returned instead,
+// so that the policy for an option applies to all the alternatives for it.
+func setOptionField(options protoreflect.Message, field protoreflect.FieldDescriptor) protoreflect.FieldDescriptor {
+	if oneof := field.ContainingOneof(); oneof != nil {
+		return options.WhichOneof(oneof)
+	}
+	if options.Has(field) {
+		return field
+	}
+	return nil
+}
+
+func optionValuesEqual(a, b protoreflect.Message, field protoreflect.FieldDescriptor) bool {
+	aField, bField := a.New(), b.New()
+	aField.Set(field, a.Get(field))
+	bField.Set(field, b.Get(field))
+	return proto.Equal(proto.MessageV1(aField.Interface()), proto.MessageV1(bField.Interface()))
+}
+
+func sortedOptionNames(optionsInheritance map[string]gatewayv1.Route_OptionInheritancePolicy) []string {
+	options := make([]string, 0, len(optionsInheritance))
+	for option := range optionsInheritance {
+		options = append(options, option)
+	}
+	sort.Strings(options)
+	return options
+}
+
 // Sets dst to the value of src, if src is non-zero and dest is zero-valued or overwrite=true.
 func shallowMerge(dst, src reflect.Value, overwrite bool) {
 	if !src.IsValid() {
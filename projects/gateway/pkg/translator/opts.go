@@ -15,6 +15,7 @@ type Opts struct {
 	Proxies                       factory.ResourceClientFactory
 	RouteOptions                  factory.ResourceClientFactory
 	VirtualHostOptions            factory.ResourceClientFactory
+	TcpRoutes                     factory.ResourceClientFactory
 	WatchOpts                     clients.WatchOpts
 	ValidationServerAddress       string
 	DevMode                       bool
@@ -3,9 +3,21 @@ package translator
 import (
 	"context"
 
+	errors "github.com/rotisserie/eris"
 	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+var (
+	NoTcpHostDestinationErr = func(tcpHost string) error {
+		return errors.Errorf("tcp host [%s] does not specify a destination", tcpHost)
+	}
+	SniDomainInOtherTcpRoutesErr = func(domain string, conflictingRouteRefs []string) error {
+		return errors.Errorf("sni domain conflict: the [%s] domain is present in other tcp routes "+
+			"that belong to the same Gateway as this one: %v", domain, conflictingRouteRefs)
+	}
 )
 
 type TcpTranslator struct{}
@@ -25,12 +37,123 @@ func (t *TcpTranslator) GenerateListeners(ctx context.Context, snap *v1.ApiSnaps
 		}
 
 		listener.ListenerType = &gloov1.Listener_TcpListener{
-			TcpListener: &gloov1.TcpListener{
-				Options:  tcpGateway.GetOptions(),
-				TcpHosts: tcpGateway.GetTcpHosts(),
-			},
+			TcpListener: t.desiredTcpListener(tcpGateway, listener, snap, reports),
 		}
 		result = append(result, listener)
 	}
 	return result
 }
+
+// the tcp routes selected by the tcp gateway are added as sources of the listener
+func (t *TcpTranslator) desiredTcpListener(tcpGateway *v1.TcpGateway, listener *gloov1.Listener, snap *v1.ApiSnapshot, reports reporter.ResourceReports) *gloov1.TcpListener {
+	tcpRoutes := getTcpRoutesForGateway(tcpGateway, snap.TcpRoutes)
+	validateTcpRouteSniDomains(tcpRoutes, reports)
+
+	tcpHosts := append([]*gloov1.TcpHost{}, tcpGateway.GetTcpHosts()...)
+	for _, tcpRoute := range tcpRoutes {
+		if err := appendSource(listener, tcpRoute); err != nil {
+			// should never happen
+			reports.AddError(tcpRoute, err)
+		}
+		for _, tcpHost := range tcpRoute.GetTcpHosts() {
+			if tcpHost.GetDestination() == nil {
+				reports.AddError(tcpRoute, NoTcpHostDestinationErr(tcpHost.GetName()))
+				continue
+			}
+			tcpHosts = append(tcpHosts, tcpHost)
+		}
+	}
+
+	return &gloov1.TcpListener{
+		Options:  tcpGateway.GetOptions(),
+		TcpHosts: tcpHosts,
+	}
+}
+
+// Errors will be added to the report object.
+func validateTcpRouteSniDomains(tcpRoutes v1.TcpRouteList, reports reporter.ResourceReports) {
+
+	// Index the tcp routes for this gateway by the sni domains of their hosts
+	routesByDomain := map[string]v1.TcpRouteList{}
+	for _, tcpRoute := range tcpRoutes {
+		domains := map[string]bool{}
+		for _, tcpHost := range tcpRoute.GetTcpHosts() {
+			for _, domain := range tcpHost.GetSslConfig().GetSniDomains() {
+				domains[domain] = true
+			}
+		}
+		for domain := range domains {
+			routesByDomain[domain] = append(routesByDomain[domain], tcpRoute)
+		}
+	}
+
+	for domain, routesWithThisDomain := range routesByDomain {
+		if len(routesWithThisDomain) < 2 {
+			continue
+		}
+		for i, tcpRoute := range routesWithThisDomain {
+			var conflictingRouteNames []string
+			for j, otherRoute := range routesWithThisDomain {
+				if i != j {
+					conflictingRouteNames = append(conflictingRouteNames, otherRoute.Metadata.Ref().Key())
+				}
+			}
+			reports.AddError(tcpRoute, SniDomainInOtherTcpRoutesErr(domain, conflictingRouteNames))
+		}
+	}
+}
+
+func getTcpRoutesForGateway(tcpGateway *v1.TcpGateway, tcpRoutes v1.TcpRouteList) v1.TcpRouteList {
+	var tcpRoutesForGateway v1.TcpRouteList
+	for _, tcpRoute := range tcpRoutes {
+		if tcpGatewayContainsTcpRoute(tcpGateway, tcpRoute) {
+			tcpRoutesForGateway = append(tcpRoutesForGateway, tcpRoute)
+		}
+	}
+
+	return tcpRoutesForGateway
+}
+
+func GatewayContainsTcpRoute(gateway *v1.Gateway, tcpRoute *v1.TcpRoute) bool {
+	if tcpGateway := gateway.GetTcpGateway(); tcpGateway != nil {
+		return tcpGatewayContainsTcpRoute(tcpGateway, tcpRoute)
+	}
+
+	for _, matchedGateway := range gateway.GetHybridGateway().GetMatchedGateways() {
+		if tcpGatewayContainsTcpRoute(matchedGateway.GetTcpGateway(), tcpRoute) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func tcpGatewayContainsTcpRoute(tcpGateway *v1.TcpGateway, tcpRoute *v1.TcpRoute) bool {
+	if tcpGateway == nil {
+		return false
+	}
+
+	// unlike virtual services, tcp routes are only selected by an explicit selector
+	if len(tcpGateway.GetTcpRouteSelector()) == 0 {
+		return false
+	}
+
+	selector := labels.SelectorFromSet(tcpGateway.GetTcpRouteSelector())
+	routeLabels := labels.Set(tcpRoute.GetMetadata().GetLabels())
+
+	return tcpRouteNamespaceValidForGateway(tcpGateway, tcpRoute) && selector.Matches(routeLabels)
+}
+
+func tcpRouteNamespaceValidForGateway(tcpGateway *v1.TcpGateway, tcpRoute *v1.TcpRoute) bool {
+	if len(tcpGateway.GetTcpRouteNamespaces()) > 0 {
+		for _, ns := range tcpGateway.GetTcpRouteNamespaces() {
+			if ns == "*" || tcpRoute.GetMetadata().GetNamespace() == ns {
+				return true
+			}
+		}
+		return false
+	}
+
+	// by default, tcp routes will be discovered in all namespaces
+	return true
+}
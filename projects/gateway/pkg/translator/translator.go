@@ -46,7 +46,11 @@ func NewDefaultTranslator(opts Opts) *translator {
 		warnOnRouteShortCircuiting = opts.Validation.WarnOnRouteShortCircuiting
 	}
 
-	return NewTranslator([]ListenerFactory{&HttpTranslator{WarnOnRouteShortCircuiting: warnOnRouteShortCircuiting}, &TcpTranslator{}}, opts)
+	httpTranslator := &HttpTranslator{WarnOnRouteShortCircuiting: warnOnRouteShortCircuiting}
+	tcpTranslator := &TcpTranslator{}
+	hybridTranslator := &HybridTranslator{HttpTranslator: httpTranslator, TcpTranslator: tcpTranslator}
+
+	return NewTranslator([]ListenerFactory{httpTranslator, tcpTranslator, &UdpTranslator{}, hybridTranslator}, opts)
 }
 
 func (t *translator) Translate(ctx context.Context, proxyName, namespace string, snap *v1.ApiSnapshot, gatewaysByProxy v1.GatewayList) (*gloov1.Proxy, reporter.ResourceReports) {
@@ -58,6 +62,7 @@ func (t *translator) Translate(ctx context.Context, proxyName, namespace string,
 	reports.Accept(snap.Gateways.AsInputResources()...)
 	reports.Accept(snap.VirtualServices.AsInputResources()...)
 	reports.Accept(snap.RouteTables.AsInputResources()...)
+	reports.Accept(snap.TcpRoutes.AsInputResources()...)
 	if len(filteredGateways) == 0 {
 		snapHash := hashutils.MustHash(snap)
 		logger.Infof("%v had no gateways", snapHash)
@@ -103,8 +108,12 @@ func validateGateways(gateways v1.GatewayList, virtualServices v1.VirtualService
 		bindAddress := fmt.Sprintf("%s:%d", gw.BindAddress, gw.BindPort)
 		bindAddresses[bindAddress] = append(bindAddresses[bindAddress], gw)
 
-		if httpGw := gw.GetHttpGateway(); httpGw != nil {
-			for _, vs := range httpGw.VirtualServices {
+		httpGateways := []*v1.HttpGateway{gw.GetHttpGateway()}
+		for _, matchedGateway := range gw.GetHybridGateway().GetMatchedGateways() {
+			httpGateways = append(httpGateways, matchedGateway.GetHttpGateway())
+		}
+		for _, httpGw := range httpGateways {
+			for _, vs := range httpGw.GetVirtualServices() {
 				if _, err := virtualServices.Find(vs.Strings()); err != nil {
 					reports.AddError(gw, fmt.Errorf("invalid virtual service ref %v", vs))
 				}
@@ -14,14 +14,17 @@ import (
 	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
 	. "github.com/solo-io/gloo/projects/gateway/pkg/translator"
+	envoycore "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/waf"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/als"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/tcp"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/udp"
 	"github.com/solo-io/gloo/test/samples"
 	"github.com/solo-io/go-utils/testutils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 	"github.com/solo-io/solo-kit/pkg/utils/prototime"
 )
 
@@ -1139,6 +1142,55 @@ var _ = Describe("Translator", func() {
 					}))
 				})
 
+				It("flattens the routes of a single virtual service", func() {
+					vs := snap.VirtualServices[0]
+					reports := reporter.ResourceReports{}
+					vh, err := FlattenVirtualService(vs, snap, reports)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(reports.ValidateStrict()).NotTo(HaveOccurred())
+
+					var names []string
+					for j, route := range vh.GetRoutes() {
+						routeMeta, err := SourceMetaFromStruct(route.GetMetadata())
+						Expect(err).NotTo(HaveOccurred())
+						Expect(routeMeta).To(Equal(expectedRouteMetadata(0, j)))
+						names = append(names, route.GetName())
+					}
+					Expect(names).To(Equal([]string{
+						"vs:name1_route:testRouteName_rt:delegate-1_route:<unnamed>",
+						"vs:name1_route:testRouteName_rt:delegate-1_route:delegate1Route2_rt:delegate-3_route:<unnamed>",
+						"vs:name1_route:testRouteName_rt:delegate-1_route:delegate1Route2_rt:delegate-3_route:delegate3Route2",
+					}))
+
+					// the virtual service itself is left untouched
+					Expect(vs.GetVirtualHost().GetRoutes()).To(HaveLen(1))
+					Expect(vs.GetVirtualHost().GetRoutes()[0].GetDelegateAction()).NotTo(BeNil())
+				})
+
+				It("warns on short-circuited routes when flattening", func() {
+					vs := snap.VirtualServices[0]
+					vs.VirtualHost.Routes = append([]*v1.Route{{
+						Matchers: []*matchers.Matcher{{
+							PathSpecifier: &matchers.Matcher_Prefix{
+								Prefix: "/a/3",
+							},
+						}},
+						Action: &v1.Route_DirectResponseAction{
+							DirectResponseAction: &gloov1.DirectResponseAction{
+								Status: 404,
+							},
+						},
+					}}, vs.VirtualHost.Routes...)
+
+					reports := reporter.ResourceReports{}
+					_, err := FlattenVirtualService(vs, snap, reports)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(reports.Validate()).NotTo(HaveOccurred())
+					Expect(reports.ValidateStrict()).To(MatchError(ContainSubstring(
+						"earlier prefix [/a/3] short-circuited later route",
+					)))
+				})
+
 			})
 
 			Context("delegation cycle", func() {
@@ -1288,6 +1340,333 @@ var _ = Describe("Translator", func() {
 			Expect(listener.TcpHosts[0]).To(Equal(tcpHost))
 		})
 
+		Context("tcp routes", func() {
+			var (
+				routeHost *gloov1.TcpHost
+			)
+			BeforeEach(func() {
+				routeHost = &gloov1.TcpHost{
+					Name:      "host-two",
+					SslConfig: &gloov1.SslConfig{SniDomains: []string{"two.example.com"}},
+					Destination: &gloov1.TcpHost_TcpAction{
+						Destination: &gloov1.TcpHost_TcpAction_Multi{
+							Multi: &gloov1.MultiDestination{
+								Destinations: []*gloov1.WeightedDestination{
+									{
+										Weight: 9,
+										Destination: &gloov1.Destination{
+											DestinationType: &gloov1.Destination_Kube{
+												Kube: &gloov1.KubernetesServiceDestination{
+													Ref:  &core.ResourceRef{Namespace: "team1", Name: "svc"},
+													Port: 5432,
+												},
+											},
+										},
+									},
+									{
+										Weight: 1,
+										Destination: &gloov1.Destination{
+											DestinationType: &gloov1.Destination_Upstream{
+												Upstream: &core.ResourceRef{Namespace: ns, Name: "canary"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+				snap.Gateways[0].GetTcpGateway().TcpRouteSelector = map[string]string{"gateway": "tcp"}
+				snap.TcpRoutes = v1.TcpRouteList{
+					{
+						Metadata: &core.Metadata{Namespace: "team1", Name: "route", Labels: map[string]string{"gateway": "tcp"}},
+						TcpHosts: []*gloov1.TcpHost{routeHost},
+					},
+					{
+						Metadata: &core.Metadata{Namespace: "team1", Name: "unselected"},
+						TcpHosts: []*gloov1.TcpHost{{Name: "host-three"}},
+					},
+				}
+			})
+
+			It("appends the hosts of selected tcp routes", func() {
+				proxy, errs := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+				Expect(errs.ValidateStrict()).NotTo(HaveOccurred())
+
+				listener := proxy.Listeners[0].ListenerType.(*gloov1.Listener_TcpListener).TcpListener
+				Expect(listener.TcpHosts).To(Equal([]*gloov1.TcpHost{tcpHost, routeHost}))
+			})
+
+			It("does not select tcp routes without a selector", func() {
+				snap.Gateways[0].GetTcpGateway().TcpRouteSelector = nil
+				proxy, _ := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+
+				listener := proxy.Listeners[0].ListenerType.(*gloov1.Listener_TcpListener).TcpListener
+				Expect(listener.TcpHosts).To(Equal([]*gloov1.TcpHost{tcpHost}))
+			})
+
+			It("only selects tcp routes in the allowed namespaces", func() {
+				snap.Gateways[0].GetTcpGateway().TcpRouteNamespaces = []string{ns}
+				proxy, _ := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+
+				listener := proxy.Listeners[0].ListenerType.(*gloov1.Listener_TcpListener).TcpListener
+				Expect(listener.TcpHosts).To(Equal([]*gloov1.TcpHost{tcpHost}))
+			})
+
+			It("reports tcp routes with conflicting sni domains", func() {
+				conflicting := &v1.TcpRoute{
+					Metadata: &core.Metadata{Namespace: "team2", Name: "route", Labels: map[string]string{"gateway": "tcp"}},
+					TcpHosts: []*gloov1.TcpHost{routeHost},
+				}
+				snap.TcpRoutes = append(snap.TcpRoutes, conflicting)
+				_, errs := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+
+				err := errs.ValidateStrict()
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(SniDomainInOtherTcpRoutesErr("two.example.com", []string{"team1.route"}).Error()))
+			})
+
+			It("reports tcp hosts without a destination", func() {
+				routeHost.Destination = nil
+				_, errs := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+
+				err := errs.ValidateStrict()
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(NoTcpHostDestinationErr("host-two").Error()))
+			})
+		})
+	})
+
+	Context("udp", func() {
+		var (
+			udpListenerOptions *gloov1.UdpListenerOptions
+			destination        *gloov1.Destination
+		)
+		BeforeEach(func() {
+			translator = NewTranslator([]ListenerFactory{&TcpTranslator{}, &UdpTranslator{}}, Opts{})
+
+			udpListenerOptions = &gloov1.UdpListenerOptions{
+				UdpProxySettings: &udp.UdpProxySettings{
+					IdleTimeout: prototime.DurationToProto(30 * time.Second),
+					HashPolicies: []*udp.UdpProxySettings_HashPolicy{{
+						PolicySpecifier: &udp.UdpProxySettings_HashPolicy_SourceIp{SourceIp: true},
+					}},
+				},
+			}
+			destination = &gloov1.Destination{
+				DestinationType: &gloov1.Destination_Upstream{
+					Upstream: &core.ResourceRef{Namespace: ns, Name: "dns"},
+				},
+			}
+
+			snap = &v1.ApiSnapshot{
+				Gateways: v1.GatewayList{
+					{
+						Metadata: &core.Metadata{Namespace: ns, Name: "name"},
+						GatewayType: &v1.Gateway_UdpGateway{
+							UdpGateway: &v1.UdpGateway{
+								Destination: destination,
+								Options:     udpListenerOptions,
+							},
+						},
+						BindPort: 53,
+					},
+				},
+			}
+		})
+
+		It("can properly translate a udp proxy", func() {
+			proxy, errs := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+
+			Expect(errs.ValidateStrict()).NotTo(HaveOccurred())
+			Expect(proxy.Listeners).To(HaveLen(1))
+			Expect(proxy.Listeners[0].BindPort).To(Equal(uint32(53)))
+			listener := proxy.Listeners[0].ListenerType.(*gloov1.Listener_UdpListener).UdpListener
+			Expect(listener.Destination).To(Equal(destination))
+			Expect(listener.Options).To(Equal(udpListenerOptions))
+		})
+
+		It("reports udp gateways without a destination", func() {
+			snap.Gateways[0].GetUdpGateway().Destination = nil
+			proxy, errs := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+
+			Expect(proxy.GetListeners()).To(BeEmpty())
+			err := errs.ValidateStrict()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(NoUdpGatewayDestinationErr.Error()))
+		})
+
+		It("reports port conflicts with tcp gateways", func() {
+			snap.Gateways = append(snap.Gateways, &v1.Gateway{
+				Metadata: &core.Metadata{Namespace: ns, Name: "tcp"},
+				GatewayType: &v1.Gateway_TcpGateway{
+					TcpGateway: &v1.TcpGateway{},
+				},
+				BindPort: 53,
+			})
+			_, errs := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+
+			err := errs.ValidateStrict()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("bind-address :53 is not unique in a proxy"))
+		})
+	})
+
+	Context("hybrid", func() {
+		var (
+			sslConfig *gloov1.SslConfig
+			matcher   *gloov1.Matcher
+			tcpHost   *gloov1.TcpHost
+		)
+		BeforeEach(func() {
+			httpTranslator := &HttpTranslator{}
+			tcpTranslator := &TcpTranslator{}
+			translator = NewTranslator([]ListenerFactory{&HybridTranslator{HttpTranslator: httpTranslator, TcpTranslator: tcpTranslator}}, Opts{})
+
+			sslConfig = &gloov1.SslConfig{
+				SslSecrets: &gloov1.SslConfig_SecretRef{
+					SecretRef: &core.ResourceRef{Namespace: ns, Name: "tls"},
+				},
+				SniDomains: []string{"secure.example.com"},
+			}
+			matcher = &gloov1.Matcher{
+				SourcePrefixRanges: []*envoycore.CidrRange{{
+					AddressPrefix: "10.0.0.0",
+					PrefixLen:     &wrappers.UInt32Value{Value: 8},
+				}},
+			}
+			tcpHost = &gloov1.TcpHost{
+				Name: "passthrough",
+				Destination: &gloov1.TcpHost_TcpAction{
+					Destination: &gloov1.TcpHost_TcpAction_Single{
+						Single: &gloov1.Destination{
+							DestinationType: &gloov1.Destination_Upstream{
+								Upstream: &core.ResourceRef{Namespace: ns, Name: "backend"},
+							},
+						},
+					},
+				},
+			}
+
+			snap = &v1.ApiSnapshot{
+				Gateways: v1.GatewayList{
+					{
+						Metadata: &core.Metadata{Namespace: ns, Name: "name"},
+						GatewayType: &v1.Gateway_HybridGateway{
+							HybridGateway: &v1.HybridGateway{
+								MatchedGateways: []*v1.MatchedGateway{
+									{
+										Matcher: &gloov1.Matcher{SslConfig: sslConfig},
+										GatewayType: &v1.MatchedGateway_HttpGateway{
+											HttpGateway: &v1.HttpGateway{},
+										},
+									},
+									{
+										Matcher: matcher,
+										GatewayType: &v1.MatchedGateway_HttpGateway{
+											HttpGateway: &v1.HttpGateway{},
+										},
+									},
+									{
+										Matcher: &gloov1.Matcher{
+											SslConfig: &gloov1.SslConfig{SniDomains: []string{"passthrough.example.com"}},
+										},
+										GatewayType: &v1.MatchedGateway_TcpGateway{
+											TcpGateway: &v1.TcpGateway{
+												TcpHosts: []*gloov1.TcpHost{tcpHost},
+											},
+										},
+									},
+								},
+							},
+						},
+						BindPort: 8443,
+					},
+				},
+				VirtualServices: v1.VirtualServiceList{
+					{
+						Metadata: &core.Metadata{Namespace: ns, Name: "secure"},
+						VirtualHost: &v1.VirtualHost{
+							Domains: []string{"secure.example.com"},
+						},
+						SslConfig: &gloov1.SslConfig{
+							SniDomains: []string{"ignored.example.com"},
+						},
+					},
+					{
+						Metadata: &core.Metadata{Namespace: ns, Name: "internal"},
+						VirtualHost: &v1.VirtualHost{
+							Domains: []string{"internal.example.com"},
+						},
+					},
+				},
+			}
+		})
+
+		It("can properly translate a hybrid gateway", func() {
+			proxy, errs := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+
+			Expect(errs.ValidateStrict()).NotTo(HaveOccurred())
+			Expect(proxy.Listeners).To(HaveLen(1))
+			Expect(proxy.Listeners[0].BindPort).To(Equal(uint32(8443)))
+			Expect(proxy.Listeners[0].SslConfigurations).To(BeEmpty())
+
+			matchedListeners := proxy.Listeners[0].GetHybridListener().GetMatchedListeners()
+			Expect(matchedListeners).To(HaveLen(3))
+
+			// virtual services are selected by the ssl config of the matcher
+			Expect(matchedListeners[0].Matcher.SslConfig).To(Equal(sslConfig))
+			secureHosts := matchedListeners[0].GetHttpListener().GetVirtualHosts()
+			Expect(secureHosts).To(HaveLen(1))
+			Expect(secureHosts[0].Domains).To(Equal([]string{"secure.example.com"}))
+
+			Expect(matchedListeners[1].Matcher).To(Equal(matcher))
+			internalHosts := matchedListeners[1].GetHttpListener().GetVirtualHosts()
+			Expect(internalHosts).To(HaveLen(1))
+			Expect(internalHosts[0].Domains).To(Equal([]string{"internal.example.com"}))
+
+			Expect(matchedListeners[2].GetTcpListener().GetTcpHosts()).To(Equal([]*gloov1.TcpHost{tcpHost}))
+		})
+
+		It("matches virtual services of hybrid gateways", func() {
+			Expect(GatewayContainsVirtualService(snap.Gateways[0], snap.VirtualServices[0])).To(BeTrue())
+			Expect(GatewayContainsVirtualService(snap.Gateways[0], snap.VirtualServices[1])).To(BeTrue())
+
+			snap.Gateways[0].GetHybridGateway().MatchedGateways = snap.Gateways[0].GetHybridGateway().MatchedGateways[1:]
+			Expect(GatewayContainsVirtualService(snap.Gateways[0], snap.VirtualServices[0])).To(BeFalse())
+		})
+
+		It("appends the hosts of tcp routes selected by matched tcp gateways", func() {
+			routeHost := &gloov1.TcpHost{
+				Name:        "route-host",
+				Destination: tcpHost.Destination,
+			}
+			snap.Gateways[0].GetHybridGateway().MatchedGateways[2].GetTcpGateway().TcpRouteSelector = map[string]string{"gateway": "hybrid"}
+			snap.TcpRoutes = v1.TcpRouteList{
+				{
+					Metadata: &core.Metadata{Namespace: ns, Name: "route", Labels: map[string]string{"gateway": "hybrid"}},
+					TcpHosts: []*gloov1.TcpHost{routeHost},
+				},
+			}
+			proxy, errs := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+			Expect(errs.ValidateStrict()).NotTo(HaveOccurred())
+
+			tcpListener := proxy.Listeners[0].GetHybridListener().GetMatchedListeners()[2].GetTcpListener()
+			Expect(tcpListener.GetTcpHosts()).To(Equal([]*gloov1.TcpHost{tcpHost, routeHost}))
+			Expect(GatewayContainsTcpRoute(snap.Gateways[0], snap.TcpRoutes[0])).To(BeTrue())
+		})
+
+		It("reports matched gateways without a gateway type", func() {
+			snap.Gateways[0].GetHybridGateway().MatchedGateways = append(snap.Gateways[0].GetHybridGateway().MatchedGateways, &v1.MatchedGateway{
+				Matcher: &gloov1.Matcher{},
+			})
+			proxy, errs := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+
+			Expect(proxy.Listeners[0].GetHybridListener().GetMatchedListeners()).To(HaveLen(3))
+			err := errs.ValidateStrict()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(NoMatchedGatewayTypeErr.Error()))
+		})
 	})
 
 })
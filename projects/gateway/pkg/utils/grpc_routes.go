@@ -0,0 +1,40 @@
+package utils
+
+import (
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/grpc"
+)
+
+// Returns virtual service routes that publish the functions of a gRPC upstream as a REST API,
+// as described by the google.api.http annotations in the descriptors of the upstream.
+func GrpcRestRoutes(upstream *gloov1.Upstream) ([]*v1.Route, error) {
+	glooRoutes, err := grpc.HttpRuleRoutes(upstream)
+	if err != nil {
+		return nil, err
+	}
+	var routes []*v1.Route
+	for _, route := range glooRoutes {
+		routes = append(routes, &v1.Route{
+			Name:     route.GetName(),
+			Matchers: route.GetMatchers(),
+			Action: &v1.Route_RouteAction{
+				RouteAction: route.GetRouteAction(),
+			},
+		})
+	}
+	return routes, nil
+}
+
+// Appends the REST routes of a gRPC upstream to the virtual service.
+func AddGrpcRestRoutes(vs *v1.VirtualService, upstream *gloov1.Upstream) error {
+	routes, err := GrpcRestRoutes(upstream)
+	if err != nil {
+		return err
+	}
+	if vs.GetVirtualHost() == nil {
+		vs.VirtualHost = &v1.VirtualHost{}
+	}
+	vs.VirtualHost.Routes = append(vs.VirtualHost.Routes, routes...)
+	return nil
+}
@@ -0,0 +1,63 @@
+package utils_test
+
+import (
+	"encoding/base64"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	glooplugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
+	grpcapi "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"google.golang.org/genproto/googleapis/api/annotations"
+
+	. "github.com/solo-io/gloo/projects/gateway/pkg/utils"
+)
+
+var _ = Describe("gRPC REST routes", func() {
+	It("adds a route per annotated function to the virtual service", func() {
+		options := &descriptor.MethodOptions{}
+		Expect(proto.SetExtension(options, annotations.E_Http, &annotations.HttpRule{
+			Pattern: &annotations.HttpRule_Get{Get: "/v1/shelves/{shelf}"},
+		})).NotTo(HaveOccurred())
+		set := &descriptor.FileDescriptorSet{File: []*descriptor.FileDescriptorProto{{
+			Name:    proto.String("bookstore.proto"),
+			Package: proto.String("bookstore"),
+			Service: []*descriptor.ServiceDescriptorProto{{
+				Name: proto.String("Bookstore"),
+				Method: []*descriptor.MethodDescriptorProto{
+					{Name: proto.String("GetShelf"), Options: options},
+					{Name: proto.String("Internal")},
+				},
+			}},
+		}}}
+		descriptors, err := proto.Marshal(set)
+		Expect(err).NotTo(HaveOccurred())
+
+		upstream := &gloov1.Upstream{
+			Metadata: &core.Metadata{Name: "bookstore", Namespace: "gloo-system"},
+			UpstreamType: &gloov1.Upstream_Static{Static: &static.UpstreamSpec{
+				ServiceSpec: &glooplugins.ServiceSpec{PluginType: &glooplugins.ServiceSpec_Grpc{Grpc: &grpcapi.ServiceSpec{
+					Descriptors: []byte(base64.StdEncoding.EncodeToString(descriptors)),
+					GrpcServices: []*grpcapi.ServiceSpec_GrpcService{{
+						PackageName:   "bookstore",
+						ServiceName:   "Bookstore",
+						FunctionNames: []string{"GetShelf", "Internal"},
+					}},
+				}}},
+			}},
+		}
+
+		vs := &v1.VirtualService{Metadata: &core.Metadata{Name: "bookstore", Namespace: "gloo-system"}}
+		Expect(AddGrpcRestRoutes(vs, upstream)).NotTo(HaveOccurred())
+		Expect(vs.GetVirtualHost().GetRoutes()).To(HaveLen(1))
+		route := vs.GetVirtualHost().GetRoutes()[0]
+		Expect(route.GetName()).To(Equal("bookstore.Bookstore.GetShelf"))
+		Expect(route.GetMatchers()[0].GetRegex()).To(Equal("/v1/shelves/[^/]+"))
+		Expect(route.GetRouteAction().GetSingle().GetDestinationSpec().GetGrpc().GetUseHttpAnnotation()).To(BeTrue())
+	})
+})
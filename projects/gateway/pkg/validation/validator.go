@@ -66,6 +66,7 @@ type Validator interface {
 	ValidateDeleteVirtualService(ctx context.Context, vs *core.ResourceRef, dryRun bool) error
 	ValidateRouteTable(ctx context.Context, rt *v1.RouteTable, dryRun bool) (ProxyReports, error)
 	ValidateDeleteRouteTable(ctx context.Context, rt *core.ResourceRef, dryRun bool) error
+	ValidateTcpRoute(ctx context.Context, tr *v1.TcpRoute, dryRun bool) (ProxyReports, error)
 }
 
 type validator struct {
@@ -357,6 +358,14 @@ func (v *validator) processItem(ctx context.Context, item unstructured.Unstructu
 			return ProxyReports{}, WrappedUnmarshalErr(unmarshalErr)
 		}
 		return v.validateRouteTableInternal(ctx, &rt, false, false)
+	case v1.TcpRouteGVK:
+		var (
+			tr v1.TcpRoute
+		)
+		if unmarshalErr := skprotoutils.UnmarshalResource(jsonBytes, &tr); unmarshalErr != nil {
+			return ProxyReports{}, WrappedUnmarshalErr(unmarshalErr)
+		}
+		return v.validateTcpRouteInternal(ctx, &tr, false, false)
 	}
 	// should not happen
 	return ProxyReports{}, errors.Errorf("Unknown group/version/kind, %v", itemGvk)
@@ -559,6 +568,39 @@ func (v *validator) validateGatewayInternal(ctx context.Context, gw *v1.Gateway,
 	}
 }
 
+func (v *validator) ValidateTcpRoute(ctx context.Context, tr *v1.TcpRoute, dryRun bool) (ProxyReports, error) {
+	return v.validateTcpRouteInternal(ctx, tr, dryRun, true)
+}
+
+func (v *validator) validateTcpRouteInternal(ctx context.Context, tr *v1.TcpRoute, dryRun, acquireLock bool) (ProxyReports, error) {
+	apply := func(snap *v1.ApiSnapshot) ([]string, resources.Resource, *core.ResourceRef) {
+		trRef := tr.GetMetadata().Ref()
+
+		// TODO: move this to a function when generics become a thing
+		var isUpdate bool
+		for i, existingTr := range snap.TcpRoutes {
+			if existingTr.GetMetadata().Ref().Equal(trRef) {
+				// replace the existing tcp route in the snapshot
+				snap.TcpRoutes[i] = tr
+				isUpdate = true
+				break
+			}
+		}
+		if !isUpdate {
+			snap.TcpRoutes = append(snap.TcpRoutes, tr)
+			snap.TcpRoutes.Sort()
+		}
+
+		return proxiesForTcpRoute(snap.Gateways, tr), tr, trRef
+	}
+
+	if acquireLock {
+		return v.validateSnapshotThreadSafe(ctx, apply, dryRun)
+	} else {
+		return v.validateSnapshot(ctx, apply, dryRun)
+	}
+}
+
 func proxiesForVirtualService(gwList v1.GatewayList, vs *v1.VirtualService) []string {
 
 	gatewaysByProxy := utils.GatewaysByProxyName(gwList)
@@ -597,6 +639,27 @@ func proxiesForRouteTable(gwList v1.GatewayList, vsList v1.VirtualServiceList, r
 	return proxiesToConsider
 }
 
+func proxiesForTcpRoute(gwList v1.GatewayList, tr *v1.TcpRoute) []string {
+
+	gatewaysByProxy := utils.GatewaysByProxyName(gwList)
+
+	var proxiesToConsider []string
+
+	for proxyName, gatewayList := range gatewaysByProxy {
+		for _, gw := range gatewayList {
+			if translator.GatewayContainsTcpRoute(gw, tr) {
+				// we only care about validating this proxy if it contains this tcp route
+				proxiesToConsider = append(proxiesToConsider, proxyName)
+				break
+			}
+		}
+	}
+
+	sort.Strings(proxiesToConsider)
+
+	return proxiesToConsider
+}
+
 type refSet map[string]*core.ResourceRef
 
 func virtualServicesForRouteTable(rt *v1.RouteTable, allVirtualServices v1.VirtualServiceList, allRoutes v1.RouteTableList) v1.VirtualServiceList {
@@ -14,6 +14,7 @@ import (
 	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
 	"github.com/solo-io/gloo/projects/gateway/pkg/translator"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	validationutils "github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
 	"github.com/solo-io/gloo/test/samples"
 	"github.com/solo-io/go-utils/testutils"
@@ -527,6 +528,73 @@ var _ = Describe("Validator", func() {
 		})
 	})
 
+	Context("validating a tcp route", func() {
+
+		var (
+			snap *gatewayv1.ApiSnapshot
+		)
+
+		tcpRoute := func(name, sniDomain string) *gatewayv1.TcpRoute {
+			return &gatewayv1.TcpRoute{
+				Metadata: &core.Metadata{Namespace: ns, Name: name, Labels: map[string]string{"gateway": "tcp"}},
+				TcpHosts: []*gloov1.TcpHost{{
+					Name:      name,
+					SslConfig: &gloov1.SslConfig{SniDomains: []string{sniDomain}},
+					Destination: &gloov1.TcpHost_TcpAction{
+						Destination: &gloov1.TcpHost_TcpAction_Single{
+							Single: &gloov1.Destination{
+								DestinationType: &gloov1.Destination_Upstream{
+									Upstream: samples.SimpleUpstream().Metadata.Ref(),
+								},
+							},
+						},
+					},
+				}},
+			}
+		}
+
+		BeforeEach(func() {
+			gw := defaults.DefaultTcpGateway(ns)
+			gw.GetTcpGateway().TcpRouteSelector = map[string]string{"gateway": "tcp"}
+			snap = &gatewayv1.ApiSnapshot{
+				Gateways:  gatewayv1.GatewayList{gw},
+				TcpRoutes: gatewayv1.TcpRouteList{tcpRoute("existing", "a.example.com")},
+			}
+		})
+
+		It("accepts the tr", func() {
+			vc.validateProxy = acceptProxy
+			err := v.Sync(context.TODO(), snap)
+			Expect(err).NotTo(HaveOccurred())
+			proxyReports, err := v.ValidateTcpRoute(context.TODO(), tcpRoute("new", "b.example.com"), false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(proxyReports).To(HaveLen(1))
+		})
+
+		It("rejects a tr with an sni domain of another tcp route", func() {
+			// validate proxy should never be called
+			vc.validateProxy = nil
+			err := v.Sync(context.TODO(), snap)
+			Expect(err).NotTo(HaveOccurred())
+			proxyReports, err := v.ValidateTcpRoute(context.TODO(), tcpRoute("new", "a.example.com"), false)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("could not render proxy"))
+			Expect(err.Error()).To(ContainSubstring(translator.SniDomainInOtherTcpRoutesErr("a.example.com", []string{ns + ".existing"}).Error()))
+			Expect(proxyReports).To(HaveLen(0))
+		})
+
+		It("accepts a tr that is not selected by any gateway", func() {
+			vc.validateProxy = failProxy
+			err := v.Sync(context.TODO(), snap)
+			Expect(err).NotTo(HaveOccurred())
+			tr := tcpRoute("new", "a.example.com")
+			tr.Metadata.Labels = nil
+			proxyReports, err := v.ValidateTcpRoute(context.TODO(), tr, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(proxyReports).To(HaveLen(0))
+		})
+	})
+
 	Context("validating a list of virtual services", func() {
 
 		toUnstructuredList := func(vss ...*gatewayv1.VirtualService) *unstructured.UnstructuredList {
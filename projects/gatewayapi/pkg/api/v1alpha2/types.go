@@ -0,0 +1,33 @@
+// Package v1alpha2 mirrors the TLSRoute of the gateway.networking.k8s.io/v1alpha2 Gateway API,
+// which is not served with a later version.
+package v1alpha2
+
+import (
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/api/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var SchemeGroupVersion = schema.GroupVersion{Group: v1beta1.GroupName, Version: "v1alpha2"}
+
+var TLSRoutesResource = SchemeGroupVersion.WithResource("tlsroutes")
+
+// Routes TLS connections by their SNI, without terminating them
+type TLSRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TLSRouteSpec        `json:"spec"`
+	Status v1beta1.RouteStatus `json:"status,omitempty"`
+}
+
+type TLSRouteSpec struct {
+	v1beta1.CommonRouteSpec `json:",inline"`
+	// The SNI hostnames of the route
+	Hostnames []v1beta1.Hostname `json:"hostnames,omitempty"`
+	Rules     []TLSRouteRule     `json:"rules"`
+}
+
+type TLSRouteRule struct {
+	BackendRefs []v1beta1.BackendRef `json:"backendRefs,omitempty"`
+}
@@ -0,0 +1,56 @@
+package v1beta1
+
+// The types and reasons of the conditions of GatewayClasses
+const (
+	GatewayClassConditionAccepted = "Accepted"
+
+	GatewayClassReasonAccepted = "Accepted"
+)
+
+// The types and reasons of the conditions of Gateways
+const (
+	GatewayConditionAccepted   = "Accepted"
+	GatewayConditionProgrammed = "Programmed"
+
+	GatewayReasonAccepted          = "Accepted"
+	GatewayReasonListenersNotValid = "ListenersNotValid"
+	GatewayReasonProgrammed        = "Programmed"
+	GatewayReasonInvalid           = "Invalid"
+)
+
+// The types and reasons of the conditions of the listeners of Gateways
+const (
+	ListenerConditionAccepted     = "Accepted"
+	ListenerConditionConflicted   = "Conflicted"
+	ListenerConditionResolvedRefs = "ResolvedRefs"
+	ListenerConditionProgrammed   = "Programmed"
+
+	ListenerReasonAccepted              = "Accepted"
+	ListenerReasonUnsupportedProtocol   = "UnsupportedProtocol"
+	ListenerReasonUnsupportedValue      = "UnsupportedValue"
+	ListenerReasonNoConflicts           = "NoConflicts"
+	ListenerReasonProtocolConflict      = "ProtocolConflict"
+	ListenerReasonHostnameConflict      = "HostnameConflict"
+	ListenerReasonResolvedRefs          = "ResolvedRefs"
+	ListenerReasonInvalidCertificateRef = "InvalidCertificateRef"
+	ListenerReasonInvalidRouteKinds     = "InvalidRouteKinds"
+	ListenerReasonRefNotPermitted       = "RefNotPermitted"
+	ListenerReasonProgrammed            = "Programmed"
+	ListenerReasonInvalid               = "Invalid"
+)
+
+// The types and reasons of the conditions of routes
+const (
+	RouteConditionAccepted     = "Accepted"
+	RouteConditionResolvedRefs = "ResolvedRefs"
+
+	RouteReasonAccepted                   = "Accepted"
+	RouteReasonNotAllowedByListeners      = "NotAllowedByListeners"
+	RouteReasonNoMatchingListenerHostname = "NoMatchingListenerHostname"
+	RouteReasonNoMatchingParent           = "NoMatchingParent"
+	RouteReasonUnsupportedValue           = "UnsupportedValue"
+	RouteReasonResolvedRefs               = "ResolvedRefs"
+	RouteReasonRefNotPermitted            = "RefNotPermitted"
+	RouteReasonInvalidKind                = "InvalidKind"
+	RouteReasonBackendNotFound            = "BackendNotFound"
+)
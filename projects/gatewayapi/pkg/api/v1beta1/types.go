@@ -0,0 +1,361 @@
+// Package v1beta1 mirrors the subset of the gateway.networking.k8s.io/v1beta1 Gateway API that Gloo Edge implements.
+// The resources are read with the dynamic client and converted from their unstructured representation,
+// so the types only need to match the JSON of the Gateway API CRDs.
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const GroupName = "gateway.networking.k8s.io"
+
+var SchemeGroupVersion = schema.GroupVersion{Group: GroupName, Version: "v1beta1"}
+
+var (
+	GatewayClassesResource  = SchemeGroupVersion.WithResource("gatewayclasses")
+	GatewaysResource        = SchemeGroupVersion.WithResource("gateways")
+	HTTPRoutesResource      = SchemeGroupVersion.WithResource("httproutes")
+	ReferenceGrantsResource = SchemeGroupVersion.WithResource("referencegrants")
+)
+
+const (
+	GatewayClassKind   = "GatewayClass"
+	GatewayKind        = "Gateway"
+	HTTPRouteKind      = "HTTPRoute"
+	TLSRouteKind       = "TLSRoute"
+	ReferenceGrantKind = "ReferenceGrant"
+)
+
+type GatewayClass struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GatewayClassSpec   `json:"spec"`
+	Status GatewayClassStatus `json:"status,omitempty"`
+}
+
+type GatewayClassSpec struct {
+	// The controller managing the Gateways of the class, e.g. "solo.io/gloo-gateway"
+	ControllerName string  `json:"controllerName"`
+	Description    *string `json:"description,omitempty"`
+}
+
+type GatewayClassStatus struct {
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+type Gateway struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GatewaySpec   `json:"spec"`
+	Status GatewayStatus `json:"status,omitempty"`
+}
+
+type GatewaySpec struct {
+	GatewayClassName string     `json:"gatewayClassName"`
+	Listeners        []Listener `json:"listeners"`
+}
+
+type Listener struct {
+	Name string `json:"name"`
+	// The host the listener serves, which may be a wildcard like "*.example.com". All hosts are served if not set.
+	Hostname      *Hostname         `json:"hostname,omitempty"`
+	Port          PortNumber        `json:"port"`
+	Protocol      ProtocolType      `json:"protocol"`
+	TLS           *GatewayTLSConfig `json:"tls,omitempty"`
+	AllowedRoutes *AllowedRoutes    `json:"allowedRoutes,omitempty"`
+}
+
+type Hostname string
+
+type PortNumber int32
+
+type ProtocolType string
+
+const (
+	HTTPProtocolType  ProtocolType = "HTTP"
+	HTTPSProtocolType ProtocolType = "HTTPS"
+	TLSProtocolType   ProtocolType = "TLS"
+	TCPProtocolType   ProtocolType = "TCP"
+	UDPProtocolType   ProtocolType = "UDP"
+)
+
+type GatewayTLSConfig struct {
+	// Defaults to Terminate
+	Mode            *TLSModeType            `json:"mode,omitempty"`
+	CertificateRefs []SecretObjectReference `json:"certificateRefs,omitempty"`
+	Options         map[string]string       `json:"options,omitempty"`
+}
+
+type TLSModeType string
+
+const (
+	TLSModeTerminate   TLSModeType = "Terminate"
+	TLSModePassthrough TLSModeType = "Passthrough"
+)
+
+type SecretObjectReference struct {
+	// Defaults to the core group
+	Group *string `json:"group,omitempty"`
+	// Defaults to Secret
+	Kind *string `json:"kind,omitempty"`
+	Name string  `json:"name"`
+	// Defaults to the namespace of the Gateway
+	Namespace *string `json:"namespace,omitempty"`
+}
+
+type AllowedRoutes struct {
+	// Defaults to routes in the namespace of the Gateway
+	Namespaces *RouteNamespaces `json:"namespaces,omitempty"`
+	// Defaults to the kinds of routes supported for the protocol of the listener
+	Kinds []RouteGroupKind `json:"kinds,omitempty"`
+}
+
+type RouteNamespaces struct {
+	From     *FromNamespaces       `json:"from,omitempty"`
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+type FromNamespaces string
+
+const (
+	NamespacesFromAll      FromNamespaces = "All"
+	NamespacesFromSelector FromNamespaces = "Selector"
+	NamespacesFromSame     FromNamespaces = "Same"
+)
+
+type RouteGroupKind struct {
+	// Defaults to gateway.networking.k8s.io
+	Group *string `json:"group,omitempty"`
+	Kind  string  `json:"kind"`
+}
+
+type GatewayStatus struct {
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	Listeners  []ListenerStatus   `json:"listeners,omitempty"`
+}
+
+type ListenerStatus struct {
+	Name           string             `json:"name"`
+	SupportedKinds []RouteGroupKind   `json:"supportedKinds"`
+	AttachedRoutes int32              `json:"attachedRoutes"`
+	Conditions     []metav1.Condition `json:"conditions"`
+}
+
+type HTTPRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   HTTPRouteSpec `json:"spec"`
+	Status RouteStatus   `json:"status,omitempty"`
+}
+
+type CommonRouteSpec struct {
+	// The Gateways, or listeners of Gateways, the route attaches to
+	ParentRefs []ParentReference `json:"parentRefs,omitempty"`
+}
+
+type ParentReference struct {
+	// Defaults to gateway.networking.k8s.io
+	Group *string `json:"group,omitempty"`
+	// Defaults to Gateway
+	Kind *string `json:"kind,omitempty"`
+	// Defaults to the namespace of the route
+	Namespace *string `json:"namespace,omitempty"`
+	Name      string  `json:"name"`
+	// The name of the listener to attach to
+	SectionName *string     `json:"sectionName,omitempty"`
+	Port        *PortNumber `json:"port,omitempty"`
+}
+
+type HTTPRouteSpec struct {
+	CommonRouteSpec `json:",inline"`
+	Hostnames       []Hostname      `json:"hostnames,omitempty"`
+	Rules           []HTTPRouteRule `json:"rules,omitempty"`
+}
+
+type HTTPRouteRule struct {
+	// Defaults to a single match of the path prefix "/"
+	Matches     []HTTPRouteMatch  `json:"matches,omitempty"`
+	Filters     []HTTPRouteFilter `json:"filters,omitempty"`
+	BackendRefs []HTTPBackendRef  `json:"backendRefs,omitempty"`
+}
+
+type HTTPRouteMatch struct {
+	Path        *HTTPPathMatch        `json:"path,omitempty"`
+	Headers     []HTTPHeaderMatch     `json:"headers,omitempty"`
+	QueryParams []HTTPQueryParamMatch `json:"queryParams,omitempty"`
+	Method      *string               `json:"method,omitempty"`
+}
+
+type HTTPPathMatch struct {
+	// Defaults to PathPrefix
+	Type *PathMatchType `json:"type,omitempty"`
+	// Defaults to "/"
+	Value *string `json:"value,omitempty"`
+}
+
+type PathMatchType string
+
+const (
+	PathMatchExact             PathMatchType = "Exact"
+	PathMatchPathPrefix        PathMatchType = "PathPrefix"
+	PathMatchRegularExpression PathMatchType = "RegularExpression"
+)
+
+type HTTPHeaderMatch struct {
+	// Defaults to Exact
+	Type  *HeaderMatchType `json:"type,omitempty"`
+	Name  string           `json:"name"`
+	Value string           `json:"value"`
+}
+
+type HeaderMatchType string
+
+const (
+	HeaderMatchExact             HeaderMatchType = "Exact"
+	HeaderMatchRegularExpression HeaderMatchType = "RegularExpression"
+)
+
+type HTTPQueryParamMatch struct {
+	// Defaults to Exact
+	Type  *QueryParamMatchType `json:"type,omitempty"`
+	Name  string               `json:"name"`
+	Value string               `json:"value"`
+}
+
+type QueryParamMatchType string
+
+const (
+	QueryParamMatchExact             QueryParamMatchType = "Exact"
+	QueryParamMatchRegularExpression QueryParamMatchType = "RegularExpression"
+)
+
+type HTTPRouteFilter struct {
+	Type                   HTTPRouteFilterType        `json:"type"`
+	RequestHeaderModifier  *HTTPHeaderFilter          `json:"requestHeaderModifier,omitempty"`
+	ResponseHeaderModifier *HTTPHeaderFilter          `json:"responseHeaderModifier,omitempty"`
+	RequestMirror          *HTTPRequestMirrorFilter   `json:"requestMirror,omitempty"`
+	RequestRedirect        *HTTPRequestRedirectFilter `json:"requestRedirect,omitempty"`
+	URLRewrite             *HTTPURLRewriteFilter      `json:"urlRewrite,omitempty"`
+	ExtensionRef           *LocalObjectReference      `json:"extensionRef,omitempty"`
+}
+
+type HTTPRouteFilterType string
+
+const (
+	HTTPRouteFilterRequestHeaderModifier  HTTPRouteFilterType = "RequestHeaderModifier"
+	HTTPRouteFilterResponseHeaderModifier HTTPRouteFilterType = "ResponseHeaderModifier"
+	HTTPRouteFilterRequestMirror          HTTPRouteFilterType = "RequestMirror"
+	HTTPRouteFilterRequestRedirect        HTTPRouteFilterType = "RequestRedirect"
+	HTTPRouteFilterURLRewrite             HTTPRouteFilterType = "URLRewrite"
+	HTTPRouteFilterExtensionRef           HTTPRouteFilterType = "ExtensionRef"
+)
+
+type HTTPHeaderFilter struct {
+	Set    []HTTPHeader `json:"set,omitempty"`
+	Add    []HTTPHeader `json:"add,omitempty"`
+	Remove []string     `json:"remove,omitempty"`
+}
+
+type HTTPHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type HTTPRequestMirrorFilter struct {
+	BackendRef BackendObjectReference `json:"backendRef"`
+}
+
+type HTTPRequestRedirectFilter struct {
+	Scheme     *string           `json:"scheme,omitempty"`
+	Hostname   *Hostname         `json:"hostname,omitempty"`
+	Path       *HTTPPathModifier `json:"path,omitempty"`
+	Port       *PortNumber       `json:"port,omitempty"`
+	StatusCode *int              `json:"statusCode,omitempty"`
+}
+
+type HTTPURLRewriteFilter struct {
+	Hostname *Hostname         `json:"hostname,omitempty"`
+	Path     *HTTPPathModifier `json:"path,omitempty"`
+}
+
+type HTTPPathModifier struct {
+	Type               HTTPPathModifierType `json:"type"`
+	ReplaceFullPath    *string              `json:"replaceFullPath,omitempty"`
+	ReplacePrefixMatch *string              `json:"replacePrefixMatch,omitempty"`
+}
+
+type HTTPPathModifierType string
+
+const (
+	FullPathHTTPPathModifier    HTTPPathModifierType = "ReplaceFullPath"
+	PrefixMatchHTTPPathModifier HTTPPathModifierType = "ReplacePrefixMatch"
+)
+
+type LocalObjectReference struct {
+	Group string `json:"group"`
+	Kind  string `json:"kind"`
+	Name  string `json:"name"`
+}
+
+type HTTPBackendRef struct {
+	BackendRef `json:",inline"`
+	Filters    []HTTPRouteFilter `json:"filters,omitempty"`
+}
+
+type BackendRef struct {
+	BackendObjectReference `json:",inline"`
+	// Defaults to 1
+	Weight *int32 `json:"weight,omitempty"`
+}
+
+type BackendObjectReference struct {
+	// Defaults to the core group
+	Group *string `json:"group,omitempty"`
+	// Defaults to Service
+	Kind *string `json:"kind,omitempty"`
+	Name string  `json:"name"`
+	// Defaults to the namespace of the route
+	Namespace *string     `json:"namespace,omitempty"`
+	Port      *PortNumber `json:"port,omitempty"`
+}
+
+type RouteStatus struct {
+	// The status of the route for each of its parents, written by the controllers of the parents
+	Parents []RouteParentStatus `json:"parents"`
+}
+
+type RouteParentStatus struct {
+	ParentRef      ParentReference    `json:"parentRef"`
+	ControllerName string             `json:"controllerName"`
+	Conditions     []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// Permits references from resources in other namespaces to resources in the namespace of the ReferenceGrant
+type ReferenceGrant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ReferenceGrantSpec `json:"spec"`
+}
+
+type ReferenceGrantSpec struct {
+	From []ReferenceGrantFrom `json:"from"`
+	To   []ReferenceGrantTo   `json:"to"`
+}
+
+type ReferenceGrantFrom struct {
+	Group     string `json:"group"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+}
+
+type ReferenceGrantTo struct {
+	Group string `json:"group"`
+	Kind  string `json:"kind"`
+	// All resources of the kind may be referenced if not set
+	Name *string `json:"name,omitempty"`
+}
@@ -0,0 +1,226 @@
+package setup
+
+import (
+	"context"
+	"time"
+
+	"github.com/solo-io/gloo/pkg/utils"
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/api/v1alpha2"
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/api/v1beta1"
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/translator"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+type Opts struct {
+	WriteNamespace  string
+	WatchNamespaces []string
+	// GatewayClasses with this controller are ours, defaults to translator.DefaultControllerName
+	ControllerName string
+	// How often all resources are synced, even if they did not change
+	ResyncPeriod time.Duration
+}
+
+// The informers of the resources of a watched namespace
+type namespaceInformers struct {
+	gateways        informers.GenericInformer
+	httpRoutes      informers.GenericInformer
+	tlsRoutes       informers.GenericInformer
+	referenceGrants informers.GenericInformer
+	services        cache.SharedIndexInformer
+	secrets         cache.SharedIndexInformer
+}
+
+// Watches the Gateway API resources, and the services, secrets and namespaces they reference, and writes the proxies
+// of the Gateways of our GatewayClasses whenever they change. Returns once the resources are cached.
+func RunGatewayApi(ctx context.Context, cfg *rest.Config, proxyClient gloov1.ProxyClient, opts Opts) error {
+	ctx = contextutils.WithLogger(ctx, "gateway-api")
+	logger := contextutils.LoggerFrom(ctx)
+
+	kube, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return errors.Wrapf(err, "getting kube client")
+	}
+	dynamicClient, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		return errors.Wrapf(err, "getting dynamic kube client")
+	}
+
+	served := func(groupVersion schema.GroupVersion) bool {
+		_, err := kube.Discovery().ServerResourcesForGroupVersion(groupVersion.String())
+		return err == nil
+	}
+	if !served(v1beta1.SchemeGroupVersion) {
+		return errors.Errorf("the Gateway API CRDs of version %v are not installed", v1beta1.SchemeGroupVersion)
+	}
+	watchTLSRoutes := served(v1alpha2.SchemeGroupVersion)
+	if !watchTLSRoutes {
+		logger.Warnf("the Gateway API CRDs of version %v are not installed, TLSRoutes are not supported", v1alpha2.SchemeGroupVersion)
+	}
+
+	changed := make(chan struct{}, 1)
+	handler := cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(interface{}) { notify(changed) },
+		UpdateFunc: func(interface{}, interface{}) { notify(changed) },
+		DeleteFunc: func(interface{}) { notify(changed) },
+	}
+
+	dynamicFactories := []dynamicinformer.DynamicSharedInformerFactory{
+		dynamicinformer.NewDynamicSharedInformerFactory(dynamicClient, opts.ResyncPeriod),
+	}
+	kubeFactories := []informers.SharedInformerFactory{
+		informers.NewSharedInformerFactory(kube, opts.ResyncPeriod),
+	}
+	gatewayClasses := dynamicFactories[0].ForResource(v1beta1.GatewayClassesResource)
+	gatewayClasses.Informer().AddEventHandler(handler)
+	namespaces := kubeFactories[0].Core().V1().Namespaces().Informer()
+	namespaces.AddEventHandler(handler)
+
+	watchNamespaces := opts.WatchNamespaces
+	if utils.AllNamespaces(watchNamespaces) {
+		watchNamespaces = []string{metav1.NamespaceAll}
+	}
+	var namespaced []namespaceInformers
+	for _, namespace := range watchNamespaces {
+		dynamicFactory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(dynamicClient, opts.ResyncPeriod, namespace, nil)
+		kubeFactory := informers.NewSharedInformerFactoryWithOptions(kube, opts.ResyncPeriod, informers.WithNamespace(namespace))
+		dynamicFactories = append(dynamicFactories, dynamicFactory)
+		kubeFactories = append(kubeFactories, kubeFactory)
+
+		nsInformers := namespaceInformers{
+			gateways:        dynamicFactory.ForResource(v1beta1.GatewaysResource),
+			httpRoutes:      dynamicFactory.ForResource(v1beta1.HTTPRoutesResource),
+			referenceGrants: dynamicFactory.ForResource(v1beta1.ReferenceGrantsResource),
+			services:        kubeFactory.Core().V1().Services().Informer(),
+			secrets:         kubeFactory.Core().V1().Secrets().Informer(),
+		}
+		if watchTLSRoutes {
+			nsInformers.tlsRoutes = dynamicFactory.ForResource(v1alpha2.TLSRoutesResource)
+			nsInformers.tlsRoutes.Informer().AddEventHandler(handler)
+		}
+		for _, informer := range []informers.GenericInformer{nsInformers.gateways, nsInformers.httpRoutes, nsInformers.referenceGrants} {
+			informer.Informer().AddEventHandler(handler)
+		}
+		nsInformers.services.AddEventHandler(handler)
+		nsInformers.secrets.AddEventHandler(handler)
+		namespaced = append(namespaced, nsInformers)
+	}
+
+	for _, factory := range dynamicFactories {
+		factory.Start(ctx.Done())
+	}
+	for _, factory := range kubeFactories {
+		factory.Start(ctx.Done())
+	}
+	for _, factory := range dynamicFactories {
+		for resource, synced := range factory.WaitForCacheSync(ctx.Done()) {
+			if !synced {
+				return errors.Errorf("failed to sync the cache of %v", resource)
+			}
+		}
+	}
+	for _, factory := range kubeFactories {
+		for resourceType, synced := range factory.WaitForCacheSync(ctx.Done()) {
+			if !synced {
+				return errors.Errorf("failed to sync the cache of %v", resourceType)
+			}
+		}
+	}
+
+	snapshot := func() *translator.Snapshot {
+		snap := &translator.Snapshot{}
+		convert := func(informer informers.GenericInformer, newObj func() interface{}) {
+			objs, err := informer.Lister().List(labels.Everything())
+			if err != nil {
+				logger.Errorf("listing %v: %v", informer, err)
+				return
+			}
+			for _, obj := range objs {
+				u, ok := obj.(*unstructured.Unstructured)
+				if !ok {
+					continue
+				}
+				if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), newObj()); err != nil {
+					logger.Warnf("ignoring invalid %v %v.%v: %v", u.GetKind(), u.GetNamespace(), u.GetName(), err)
+				}
+			}
+		}
+
+		convert(gatewayClasses, func() interface{} {
+			class := &v1beta1.GatewayClass{}
+			snap.GatewayClasses = append(snap.GatewayClasses, class)
+			return class
+		})
+		for _, ns := range namespaced {
+			convert(ns.gateways, func() interface{} {
+				gateway := &v1beta1.Gateway{}
+				snap.Gateways = append(snap.Gateways, gateway)
+				return gateway
+			})
+			convert(ns.httpRoutes, func() interface{} {
+				route := &v1beta1.HTTPRoute{}
+				snap.HTTPRoutes = append(snap.HTTPRoutes, route)
+				return route
+			})
+			if ns.tlsRoutes != nil {
+				convert(ns.tlsRoutes, func() interface{} {
+					route := &v1alpha2.TLSRoute{}
+					snap.TLSRoutes = append(snap.TLSRoutes, route)
+					return route
+				})
+			}
+			convert(ns.referenceGrants, func() interface{} {
+				grant := &v1beta1.ReferenceGrant{}
+				snap.ReferenceGrants = append(snap.ReferenceGrants, grant)
+				return grant
+			})
+			for _, obj := range ns.services.GetStore().List() {
+				snap.Services = append(snap.Services, obj.(*corev1.Service))
+			}
+			for _, obj := range ns.secrets.GetStore().List() {
+				snap.Secrets = append(snap.Secrets, obj.(*corev1.Secret))
+			}
+		}
+		for _, obj := range namespaces.GetStore().List() {
+			snap.Namespaces = append(snap.Namespaces, obj.(*corev1.Namespace))
+		}
+		return snap
+	}
+
+	syncer := translator.NewSyncer(opts.WriteNamespace, opts.ControllerName, proxyClient, translator.NewStatusWriter(dynamicClient))
+	notify(changed)
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-changed:
+				if err := syncer.Sync(ctx, snapshot()); err != nil {
+					logger.Errorf("error syncing Gateway API resources: %v", err)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// Changes are coalesced, so that resources changing while syncing are synced once
+func notify(changed chan struct{}) {
+	select {
+	case changed <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,54 @@
+package translator
+
+import (
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/api/v1beta1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Accumulates the conditions of a resource, starting from its current conditions so that the
+// last transition time of conditions whose status did not change is kept
+type conditions struct {
+	generation int64
+	conditions []metav1.Condition
+}
+
+func newConditions(generation int64, current []metav1.Condition) *conditions {
+	return &conditions{
+		generation: generation,
+		conditions: append([]metav1.Condition(nil), current...),
+	}
+}
+
+func (c *conditions) set(conditionType string, status bool, reason, message string) {
+	condition := metav1.Condition{
+		Type:               conditionType,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: c.generation,
+		Reason:             reason,
+		Message:            message,
+	}
+	if status {
+		condition.Status = metav1.ConditionTrue
+	}
+	meta.SetStatusCondition(&c.conditions, condition)
+	// SetStatusCondition does not update the generation of existing conditions
+	meta.FindStatusCondition(c.conditions, conditionType).ObservedGeneration = c.generation
+}
+
+// Sets the ResolvedRefs condition of a route from the error resolving its references, if any
+func (c *conditions) setResolvedRefs(err error) {
+	if err == nil {
+		c.set(v1beta1.RouteConditionResolvedRefs, true, v1beta1.RouteReasonResolvedRefs, "")
+		return
+	}
+	reason := v1beta1.RouteReasonBackendNotFound
+	if refErr, ok := err.(*conditionErr); ok {
+		reason = refErr.reason
+	}
+	c.set(v1beta1.RouteConditionResolvedRefs, false, reason, err.Error())
+}
+
+func (c *conditions) list() []metav1.Condition {
+	return c.conditions
+}
@@ -0,0 +1,55 @@
+package translator
+
+import (
+	"strings"
+
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/api/v1beta1"
+)
+
+// The hostname of routes and listeners that serve all hostnames
+const anyHostname = "*"
+
+// Returns the hostnames of a route that a listener serves, which are the more specific of each pair of
+// matching listener and route hostnames. Returns nothing if the listener serves none of the hostnames of the route.
+func intersectHostnames(listenerHostname *v1beta1.Hostname, routeHostnames []v1beta1.Hostname) []string {
+	var listenerHost string
+	if listenerHostname != nil {
+		listenerHost = string(*listenerHostname)
+	}
+	if len(routeHostnames) == 0 {
+		if listenerHost == "" {
+			return []string{anyHostname}
+		}
+		return []string{listenerHost}
+	}
+
+	var hostnames []string
+	seen := make(map[string]bool)
+	for _, routeHostname := range routeHostnames {
+		routeHost := string(routeHostname)
+		var hostname string
+		switch {
+		case listenerHost == "", routeHost == listenerHost, matchesWildcard(listenerHost, routeHost):
+			hostname = routeHost
+		case matchesWildcard(routeHost, listenerHost):
+			hostname = listenerHost
+		default:
+			continue
+		}
+		if !seen[hostname] {
+			seen[hostname] = true
+			hostnames = append(hostnames, hostname)
+		}
+	}
+	return hostnames
+}
+
+// Wildcard hostnames like "*.example.com" match hostnames with any number of additional labels,
+// including more specific wildcards like "*.foo.example.com"
+func matchesWildcard(wildcard, hostname string) bool {
+	if !strings.HasPrefix(wildcard, "*.") {
+		return false
+	}
+	suffix := wildcard[1:]
+	return len(hostname) > len(suffix) && strings.HasSuffix(hostname, suffix)
+}
@@ -0,0 +1,365 @@
+package translator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	errors "github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/api/v1beta1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/matcher/v3"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/headers"
+	envoycore "github.com/solo-io/solo-kit/pkg/api/external/envoy/api/v2/core"
+)
+
+// The HTTP status of requests to rules without valid backends
+const noBackendsStatus = 500
+
+// The routes of an HTTPRoute, and whether they are valid
+type httpRouteTranslation struct {
+	route  *v1beta1.HTTPRoute
+	routes []*precedenceRoute
+	// Routes with unsupported configuration are not accepted
+	notAccepted *conditionErr
+	// The first backend that could not be resolved
+	invalidRefs error
+}
+
+func translateHTTPRoute(route *v1beta1.HTTPRoute, refs *referenceResolver) *httpRouteTranslation {
+	rt := &httpRouteTranslation{route: route}
+	for i, rule := range route.Spec.Rules {
+		routes, err := rt.translateRule(i, rule, refs)
+		if err != nil {
+			rt.notAccepted = newConditionErr(v1beta1.RouteReasonUnsupportedValue, "rule %v: %v", i, err)
+			rt.routes = nil
+			return rt
+		}
+		rt.routes = append(rt.routes, routes...)
+	}
+	return rt
+}
+
+func (rt *httpRouteTranslation) translateRule(ruleIndex int, rule v1beta1.HTTPRouteRule, refs *referenceResolver) ([]*precedenceRoute, error) {
+	var backendRefs []v1beta1.BackendRef
+	for _, backendRef := range rule.BackendRefs {
+		if len(backendRef.Filters) > 0 {
+			return nil, errors.Errorf("filters of backends are not supported")
+		}
+		backendRefs = append(backendRefs, backendRef.BackendRef)
+	}
+	destinations, err := refs.destinations(v1beta1.HTTPRouteKind, rt.route.Namespace, backendRefs)
+	if err != nil && rt.invalidRefs == nil {
+		rt.invalidRefs = err
+	}
+
+	options := &gloov1.RouteOptions{}
+	var redirect *v1beta1.HTTPRequestRedirectFilter
+	var rewrite *v1beta1.HTTPURLRewriteFilter
+	for _, filter := range rule.Filters {
+		switch filter.Type {
+		case v1beta1.HTTPRouteFilterRequestHeaderModifier:
+			if filter.RequestHeaderModifier == nil {
+				return nil, errors.Errorf("filter %v is not configured", filter.Type)
+			}
+			manipulation := headerManipulation(options)
+			manipulation.RequestHeadersToAdd = append(manipulation.RequestHeadersToAdd, requestHeaders(filter.RequestHeaderModifier)...)
+			manipulation.RequestHeadersToRemove = append(manipulation.RequestHeadersToRemove, filter.RequestHeaderModifier.Remove...)
+		case v1beta1.HTTPRouteFilterResponseHeaderModifier:
+			if filter.ResponseHeaderModifier == nil {
+				return nil, errors.Errorf("filter %v is not configured", filter.Type)
+			}
+			manipulation := headerManipulation(options)
+			manipulation.ResponseHeadersToAdd = append(manipulation.ResponseHeadersToAdd, responseHeaders(filter.ResponseHeaderModifier)...)
+			manipulation.ResponseHeadersToRemove = append(manipulation.ResponseHeadersToRemove, filter.ResponseHeaderModifier.Remove...)
+		case v1beta1.HTTPRouteFilterRequestRedirect:
+			if filter.RequestRedirect == nil {
+				return nil, errors.Errorf("filter %v is not configured", filter.Type)
+			}
+			redirect = filter.RequestRedirect
+		case v1beta1.HTTPRouteFilterURLRewrite:
+			if filter.URLRewrite == nil {
+				return nil, errors.Errorf("filter %v is not configured", filter.Type)
+			}
+			rewrite = filter.URLRewrite
+			if rewrite.Hostname != nil {
+				options.HostRewriteType = &gloov1.RouteOptions_HostRewrite{HostRewrite: string(*rewrite.Hostname)}
+			}
+		default:
+			return nil, errors.Errorf("filter %v is not supported", filter.Type)
+		}
+	}
+	if redirect != nil && rewrite != nil {
+		return nil, errors.Errorf("filters %v and %v cannot be combined", v1beta1.HTTPRouteFilterRequestRedirect, v1beta1.HTTPRouteFilterURLRewrite)
+	}
+
+	matches := rule.Matches
+	if len(matches) == 0 {
+		matches = []v1beta1.HTTPRouteMatch{{}}
+	}
+	var routes []*precedenceRoute
+	for matchIndex, match := range matches {
+		pathType, path := pathOf(match)
+		for _, matcher := range pathMatchers(pathType, path) {
+			matcher.Headers = headerMatchers(match.Headers)
+			matcher.QueryParameters = queryParameterMatchers(match.QueryParams)
+			if match.Method != nil {
+				matcher.Methods = []string{*match.Method}
+			}
+
+			route := &gloov1.Route{
+				Name:     fmt.Sprintf("%v.%v-rule-%v-match-%v", rt.route.Namespace, rt.route.Name, ruleIndex, matchIndex),
+				Matchers: []*matchers.Matcher{matcher},
+			}
+			routeOptions := options
+			switch {
+			case redirect != nil:
+				action, err := redirectAction(redirect, pathType, matcher)
+				if err != nil {
+					return nil, err
+				}
+				route.Action = &gloov1.Route_RedirectAction{RedirectAction: action}
+			case len(destinations) == 0:
+				route.Action = &gloov1.Route_DirectResponseAction{
+					DirectResponseAction: &gloov1.DirectResponseAction{Status: noBackendsStatus},
+				}
+			default:
+				route.Action = &gloov1.Route_RouteAction{RouteAction: routeAction(destinations)}
+				if rewrite != nil && rewrite.Path != nil {
+					// the prefix rewrite depends on the matcher, so the options cannot be shared
+					routeOptions = proto.Clone(options).(*gloov1.RouteOptions)
+					if err := rewritePath(routeOptions, rewrite.Path, pathType, matcher); err != nil {
+						return nil, err
+					}
+				}
+			}
+			if !proto.Equal(routeOptions, &gloov1.RouteOptions{}) {
+				route.Options = routeOptions
+			}
+
+			routes = append(routes, &precedenceRoute{
+				route:      route,
+				pathType:   pathType,
+				path:       path,
+				method:     match.Method != nil,
+				headers:    len(match.Headers),
+				query:      len(match.QueryParams),
+				created:    rt.route.CreationTimestamp,
+				namespace:  rt.route.Namespace,
+				name:       rt.route.Name,
+				ruleIndex:  ruleIndex,
+				matchIndex: matchIndex,
+			})
+		}
+	}
+	return routes, nil
+}
+
+func pathOf(match v1beta1.HTTPRouteMatch) (v1beta1.PathMatchType, string) {
+	pathType, path := v1beta1.PathMatchPathPrefix, "/"
+	if match.Path != nil {
+		if match.Path.Type != nil {
+			pathType = *match.Path.Type
+		}
+		if match.Path.Value != nil {
+			path = *match.Path.Value
+		}
+	}
+	return pathType, path
+}
+
+// Path prefixes match whole path elements, so /foo matches /foo and /foo/bar but not /foobar.
+// Each returned matcher is translated to its own route, as the rewrite of a prefix depends on the matched prefix.
+func pathMatchers(pathType v1beta1.PathMatchType, path string) []*matchers.Matcher {
+	switch pathType {
+	case v1beta1.PathMatchExact:
+		return []*matchers.Matcher{{PathSpecifier: &matchers.Matcher_Exact{Exact: path}}}
+	case v1beta1.PathMatchRegularExpression:
+		return []*matchers.Matcher{{PathSpecifier: &matchers.Matcher_Regex{Regex: path}}}
+	default:
+		prefix := strings.TrimSuffix(path, "/")
+		if prefix == "" {
+			return []*matchers.Matcher{{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/"}}}
+		}
+		return []*matchers.Matcher{
+			{PathSpecifier: &matchers.Matcher_Exact{Exact: prefix}},
+			{PathSpecifier: &matchers.Matcher_Prefix{Prefix: prefix + "/"}},
+		}
+	}
+}
+
+func headerMatchers(headerMatches []v1beta1.HTTPHeaderMatch) []*matchers.HeaderMatcher {
+	var headerMatchers []*matchers.HeaderMatcher
+	for _, headerMatch := range headerMatches {
+		headerMatchers = append(headerMatchers, &matchers.HeaderMatcher{
+			Name:  headerMatch.Name,
+			Value: headerMatch.Value,
+			Regex: headerMatch.Type != nil && *headerMatch.Type == v1beta1.HeaderMatchRegularExpression,
+		})
+	}
+	return headerMatchers
+}
+
+func queryParameterMatchers(queryParamMatches []v1beta1.HTTPQueryParamMatch) []*matchers.QueryParameterMatcher {
+	var queryParameterMatchers []*matchers.QueryParameterMatcher
+	for _, queryParamMatch := range queryParamMatches {
+		queryParameterMatchers = append(queryParameterMatchers, &matchers.QueryParameterMatcher{
+			Name:  queryParamMatch.Name,
+			Value: queryParamMatch.Value,
+			Regex: queryParamMatch.Type != nil && *queryParamMatch.Type == v1beta1.QueryParamMatchRegularExpression,
+		})
+	}
+	return queryParameterMatchers
+}
+
+func headerManipulation(options *gloov1.RouteOptions) *headers.HeaderManipulation {
+	if options.GetHeaderManipulation() == nil {
+		options.HeaderManipulation = &headers.HeaderManipulation{}
+	}
+	return options.GetHeaderManipulation()
+}
+
+// Set headers replace existing values, while added headers are appended to them
+func requestHeaders(filter *v1beta1.HTTPHeaderFilter) []*envoycore.HeaderValueOption {
+	var options []*envoycore.HeaderValueOption
+	for _, header := range filter.Set {
+		options = append(options, &envoycore.HeaderValueOption{
+			HeaderOption: &envoycore.HeaderValueOption_Header{
+				Header: &envoycore.HeaderValue{Key: header.Name, Value: header.Value},
+			},
+			Append: &wrappers.BoolValue{Value: false},
+		})
+	}
+	for _, header := range filter.Add {
+		options = append(options, &envoycore.HeaderValueOption{
+			HeaderOption: &envoycore.HeaderValueOption_Header{
+				Header: &envoycore.HeaderValue{Key: header.Name, Value: header.Value},
+			},
+			Append: &wrappers.BoolValue{Value: true},
+		})
+	}
+	return options
+}
+
+func responseHeaders(filter *v1beta1.HTTPHeaderFilter) []*headers.HeaderValueOption {
+	var options []*headers.HeaderValueOption
+	for _, header := range filter.Set {
+		options = append(options, &headers.HeaderValueOption{
+			Header: &headers.HeaderValue{Key: header.Name, Value: header.Value},
+			Append: &wrappers.BoolValue{Value: false},
+		})
+	}
+	for _, header := range filter.Add {
+		options = append(options, &headers.HeaderValueOption{
+			Header: &headers.HeaderValue{Key: header.Name, Value: header.Value},
+			Append: &wrappers.BoolValue{Value: true},
+		})
+	}
+	return options
+}
+
+func routeAction(destinations []*gloov1.WeightedDestination) *gloov1.RouteAction {
+	if len(destinations) == 1 {
+		return &gloov1.RouteAction{
+			Destination: &gloov1.RouteAction_Single{Single: destinations[0].GetDestination()},
+		}
+	}
+	return &gloov1.RouteAction{
+		Destination: &gloov1.RouteAction_Multi{
+			Multi: &gloov1.MultiDestination{Destinations: destinations},
+		},
+	}
+}
+
+func redirectAction(redirect *v1beta1.HTTPRequestRedirectFilter, pathType v1beta1.PathMatchType, matcher *matchers.Matcher) (*gloov1.RedirectAction, error) {
+	action := &gloov1.RedirectAction{
+		ResponseCode: gloov1.RedirectAction_FOUND,
+	}
+	if redirect.Scheme != nil {
+		switch *redirect.Scheme {
+		case "https":
+			action.HttpsRedirect = true
+		case "http":
+		default:
+			return nil, errors.Errorf("redirect scheme %v is not supported", *redirect.Scheme)
+		}
+	}
+	if redirect.Hostname != nil {
+		action.HostRedirect = string(*redirect.Hostname)
+	}
+	if redirect.Port != nil {
+		return nil, errors.Errorf("redirect port is not supported")
+	}
+	if redirect.StatusCode != nil {
+		switch *redirect.StatusCode {
+		case 301:
+			action.ResponseCode = gloov1.RedirectAction_MOVED_PERMANENTLY
+		case 302:
+			action.ResponseCode = gloov1.RedirectAction_FOUND
+		default:
+			return nil, errors.Errorf("redirect status code %v is not supported", *redirect.StatusCode)
+		}
+	}
+	if redirect.Path != nil {
+		switch redirect.Path.Type {
+		case v1beta1.FullPathHTTPPathModifier:
+			if redirect.Path.ReplaceFullPath == nil {
+				return nil, errors.Errorf("path modifier %v is not configured", redirect.Path.Type)
+			}
+			action.PathRewriteSpecifier = &gloov1.RedirectAction_PathRedirect{PathRedirect: *redirect.Path.ReplaceFullPath}
+		case v1beta1.PrefixMatchHTTPPathModifier:
+			prefix, err := replacedPrefix(redirect.Path, pathType, matcher)
+			if err != nil {
+				return nil, err
+			}
+			action.PathRewriteSpecifier = &gloov1.RedirectAction_PrefixRewrite{PrefixRewrite: prefix}
+		default:
+			return nil, errors.Errorf("path modifier %v is not supported", redirect.Path.Type)
+		}
+	}
+	return action, nil
+}
+
+func rewritePath(options *gloov1.RouteOptions, path *v1beta1.HTTPPathModifier, pathType v1beta1.PathMatchType, matcher *matchers.Matcher) error {
+	switch path.Type {
+	case v1beta1.FullPathHTTPPathModifier:
+		if path.ReplaceFullPath == nil {
+			return errors.Errorf("path modifier %v is not configured", path.Type)
+		}
+		options.RegexRewrite = &v3.RegexMatchAndSubstitute{
+			Pattern:      &v3.RegexMatcher{Regex: "^.*$"},
+			Substitution: *path.ReplaceFullPath,
+		}
+	case v1beta1.PrefixMatchHTTPPathModifier:
+		prefix, err := replacedPrefix(path, pathType, matcher)
+		if err != nil {
+			return err
+		}
+		options.PrefixRewrite = &wrappers.StringValue{Value: prefix}
+	default:
+		return errors.Errorf("path modifier %v is not supported", path.Type)
+	}
+	return nil
+}
+
+// Returns the prefix replacing the prefix matched by the matcher, which ends with a slash if the matched prefix does.
+// The prefix of a path prefix match ends before the separator of the next path element, so replacing /foo with /bar
+// rewrites /foo/baz to /bar/baz.
+func replacedPrefix(path *v1beta1.HTTPPathModifier, pathType v1beta1.PathMatchType, matcher *matchers.Matcher) (string, error) {
+	if pathType != v1beta1.PathMatchPathPrefix {
+		return "", errors.Errorf("path modifier %v requires a %v path match", path.Type, v1beta1.PathMatchPathPrefix)
+	}
+	if path.ReplacePrefixMatch == nil {
+		return "", errors.Errorf("path modifier %v is not configured", path.Type)
+	}
+	prefix := strings.TrimSuffix(*path.ReplacePrefixMatch, "/")
+	if matcher.GetPrefix() != "" {
+		return prefix + "/", nil
+	}
+	if prefix == "" {
+		return "/", nil
+	}
+	return prefix, nil
+}
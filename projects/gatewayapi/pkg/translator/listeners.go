@@ -0,0 +1,266 @@
+package translator
+
+import (
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/api/v1beta1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// A Gateway of one of our GatewayClasses, and the routes attached to its listeners
+type gatewayTranslation struct {
+	gateway   *v1beta1.Gateway
+	listeners []*listenerTranslation
+}
+
+type listenerTranslation struct {
+	listener       v1beta1.Listener
+	supportedKinds []v1beta1.RouteGroupKind
+	// The TLS certificate of listeners that terminate TLS
+	secret *core.ResourceRef
+
+	// Listeners that are not accepted, conflict with other listeners or have an invalid certificate are not programmed
+	notAccepted  *conditionErr
+	conflict     *conditionErr
+	invalidRefs  *conditionErr
+	invalidKinds *conditionErr
+
+	attachedRoutes int32
+	httpRoutes     []*attachedHTTPRoute
+	tlsRoutes      []*attachedTLSRoute
+}
+
+// The translation of an HTTPRoute, serving the hostnames it shares with a listener
+type attachedHTTPRoute struct {
+	route     *httpRouteTranslation
+	hostnames []string
+}
+
+// The translation of a TLSRoute, serving the hostnames it shares with a listener
+type attachedTLSRoute struct {
+	route     *tlsRouteTranslation
+	hostnames []string
+}
+
+func translateGateway(gateway *v1beta1.Gateway, refs *referenceResolver) *gatewayTranslation {
+	gt := &gatewayTranslation{gateway: gateway}
+	for _, listener := range gateway.Spec.Listeners {
+		gt.listeners = append(gt.listeners, translateListener(gateway, listener, refs))
+	}
+	markConflicts(gt.listeners)
+	return gt
+}
+
+func translateListener(gateway *v1beta1.Gateway, listener v1beta1.Listener, refs *referenceResolver) *listenerTranslation {
+	lt := &listenerTranslation{listener: listener}
+
+	var routeKind string
+	switch listener.Protocol {
+	case v1beta1.HTTPProtocolType, v1beta1.HTTPSProtocolType:
+		routeKind = v1beta1.HTTPRouteKind
+	case v1beta1.TLSProtocolType:
+		routeKind = v1beta1.TLSRouteKind
+	default:
+		lt.notAccepted = newConditionErr(v1beta1.ListenerReasonUnsupportedProtocol, "protocol %v is not supported", listener.Protocol)
+		return lt
+	}
+	lt.supportedKinds, lt.invalidKinds = supportedKinds(listener.AllowedRoutes, routeKind)
+
+	if listener.Protocol == v1beta1.HTTPProtocolType {
+		return lt
+	}
+	if listener.TLS == nil {
+		lt.notAccepted = newConditionErr(v1beta1.ListenerReasonUnsupportedValue, "protocol %v requires TLS to be configured", listener.Protocol)
+		return lt
+	}
+	mode := v1beta1.TLSModeTerminate
+	if listener.TLS.Mode != nil {
+		mode = *listener.TLS.Mode
+	}
+	switch {
+	case mode == v1beta1.TLSModePassthrough && listener.Protocol == v1beta1.HTTPSProtocolType:
+		lt.notAccepted = newConditionErr(v1beta1.ListenerReasonUnsupportedValue, "protocol %v requires TLS mode %v", listener.Protocol, v1beta1.TLSModeTerminate)
+	case mode == v1beta1.TLSModePassthrough:
+		// TLS is not terminated, so no certificate is needed
+	case mode != v1beta1.TLSModeTerminate:
+		lt.notAccepted = newConditionErr(v1beta1.ListenerReasonUnsupportedValue, "TLS mode %v is not supported", mode)
+	case len(listener.TLS.CertificateRefs) == 0:
+		lt.invalidRefs = newConditionErr(v1beta1.ListenerReasonInvalidCertificateRef, "TLS mode %v requires a certificate", mode)
+	default:
+		// the first certificate is served, as each listener has a single SSL config
+		lt.secret, lt.invalidRefs = refs.certificate(gateway, listener.TLS.CertificateRefs[0])
+	}
+	return lt
+}
+
+// Returns the allowed kinds of routes the listener supports. Without allowed kinds, routes of the kind supported
+// for the protocol of the listener are allowed.
+func supportedKinds(allowedRoutes *v1beta1.AllowedRoutes, routeKind string) ([]v1beta1.RouteGroupKind, *conditionErr) {
+	group := v1beta1.GroupName
+	if allowedRoutes == nil || len(allowedRoutes.Kinds) == 0 {
+		return []v1beta1.RouteGroupKind{{Group: &group, Kind: routeKind}}, nil
+	}
+	var kinds []v1beta1.RouteGroupKind
+	var err *conditionErr
+	for _, kind := range allowedRoutes.Kinds {
+		if valueOr(kind.Group, v1beta1.GroupName) == v1beta1.GroupName && kind.Kind == routeKind {
+			kinds = append(kinds, v1beta1.RouteGroupKind{Group: &group, Kind: kind.Kind})
+		} else if err == nil {
+			err = newConditionErr(v1beta1.ListenerReasonInvalidRouteKinds, "route kind %v is not supported", groupKind(valueOr(kind.Group, v1beta1.GroupName), kind.Kind))
+		}
+	}
+	return kinds, err
+}
+
+// Listeners sharing a port must have the same protocol, and different hostnames
+func markConflicts(listeners []*listenerTranslation) {
+	byPort := make(map[v1beta1.PortNumber][]*listenerTranslation)
+	for _, lt := range listeners {
+		if lt.notAccepted == nil {
+			byPort[lt.listener.Port] = append(byPort[lt.listener.Port], lt)
+		}
+	}
+	for _, sharingPort := range byPort {
+		for _, lt := range sharingPort {
+			for _, other := range sharingPort {
+				if lt == other {
+					continue
+				}
+				if lt.listener.Protocol != other.listener.Protocol {
+					lt.conflict = newConditionErr(v1beta1.ListenerReasonProtocolConflict, "port %v is used by listeners with different protocols", lt.listener.Port)
+					break
+				}
+				if hostnameOf(lt.listener) == hostnameOf(other.listener) {
+					lt.conflict = newConditionErr(v1beta1.ListenerReasonHostnameConflict, "hostname %v is used by another listener on port %v", hostnameOf(lt.listener), lt.listener.Port)
+				}
+			}
+		}
+	}
+}
+
+func hostnameOf(listener v1beta1.Listener) string {
+	if listener.Hostname == nil {
+		return anyHostname
+	}
+	return string(*listener.Hostname)
+}
+
+// Listeners that are programmed are translated to the listeners of the proxy of their Gateway
+func (lt *listenerTranslation) programmed() bool {
+	return lt.notAccepted == nil && lt.conflict == nil && lt.invalidRefs == nil
+}
+
+// Returns whether routes of the kind in the namespace may attach to the listener
+func (lt *listenerTranslation) allows(gateway *v1beta1.Gateway, routeKind, routeNamespace string, refs *referenceResolver) bool {
+	var kindAllowed bool
+	for _, kind := range lt.supportedKinds {
+		if kind.Kind == routeKind {
+			kindAllowed = true
+		}
+	}
+	if !kindAllowed {
+		return false
+	}
+
+	from := v1beta1.NamespacesFromSame
+	var selector *metav1.LabelSelector
+	if allowedRoutes := lt.listener.AllowedRoutes; allowedRoutes != nil && allowedRoutes.Namespaces != nil {
+		if allowedRoutes.Namespaces.From != nil {
+			from = *allowedRoutes.Namespaces.From
+		}
+		selector = allowedRoutes.Namespaces.Selector
+	}
+	switch from {
+	case v1beta1.NamespacesFromAll:
+		return true
+	case v1beta1.NamespacesFromSelector:
+		if selector == nil {
+			return false
+		}
+		namespace, ok := refs.namespaces[routeNamespace]
+		if !ok {
+			return false
+		}
+		namespaceSelector, err := metav1.LabelSelectorAsSelector(selector)
+		if err != nil {
+			return false
+		}
+		return namespaceSelector.Matches(labels.Set(namespace.Labels))
+	default:
+		return routeNamespace == gateway.Namespace
+	}
+}
+
+func (gt *gatewayTranslation) status() v1beta1.GatewayStatus {
+	existingListeners := make(map[string]v1beta1.ListenerStatus)
+	for _, listenerStatus := range gt.gateway.Status.Listeners {
+		existingListeners[listenerStatus.Name] = listenerStatus
+	}
+
+	var status v1beta1.GatewayStatus
+	var accepted, programmed int
+	for _, lt := range gt.listeners {
+		if lt.notAccepted == nil {
+			accepted++
+		}
+		if lt.programmed() {
+			programmed++
+		}
+		status.Listeners = append(status.Listeners, lt.status(gt.gateway.Generation, existingListeners[lt.listener.Name]))
+	}
+
+	conditions := newConditions(gt.gateway.Generation, gt.gateway.Status.Conditions)
+	switch accepted {
+	case len(gt.listeners):
+		conditions.set(v1beta1.GatewayConditionAccepted, true, v1beta1.GatewayReasonAccepted, "")
+	case 0:
+		conditions.set(v1beta1.GatewayConditionAccepted, false, v1beta1.GatewayReasonListenersNotValid, "no listener is valid")
+	default:
+		conditions.set(v1beta1.GatewayConditionAccepted, true, v1beta1.GatewayReasonListenersNotValid, "some listeners are not valid")
+	}
+	if programmed > 0 {
+		conditions.set(v1beta1.GatewayConditionProgrammed, true, v1beta1.GatewayReasonProgrammed, "")
+	} else {
+		conditions.set(v1beta1.GatewayConditionProgrammed, false, v1beta1.GatewayReasonInvalid, "no listener is programmed")
+	}
+	status.Conditions = conditions.list()
+	return status
+}
+
+func (lt *listenerTranslation) status(generation int64, existing v1beta1.ListenerStatus) v1beta1.ListenerStatus {
+	conditions := newConditions(generation, existing.Conditions)
+	if lt.notAccepted != nil {
+		conditions.set(v1beta1.ListenerConditionAccepted, false, lt.notAccepted.reason, lt.notAccepted.message)
+	} else {
+		conditions.set(v1beta1.ListenerConditionAccepted, true, v1beta1.ListenerReasonAccepted, "")
+	}
+	if lt.conflict != nil {
+		conditions.set(v1beta1.ListenerConditionConflicted, true, lt.conflict.reason, lt.conflict.message)
+	} else {
+		conditions.set(v1beta1.ListenerConditionConflicted, false, v1beta1.ListenerReasonNoConflicts, "")
+	}
+	switch {
+	case lt.invalidRefs != nil:
+		conditions.set(v1beta1.ListenerConditionResolvedRefs, false, lt.invalidRefs.reason, lt.invalidRefs.message)
+	case lt.invalidKinds != nil:
+		conditions.set(v1beta1.ListenerConditionResolvedRefs, false, lt.invalidKinds.reason, lt.invalidKinds.message)
+	default:
+		conditions.set(v1beta1.ListenerConditionResolvedRefs, true, v1beta1.ListenerReasonResolvedRefs, "")
+	}
+	if lt.programmed() {
+		conditions.set(v1beta1.ListenerConditionProgrammed, true, v1beta1.ListenerReasonProgrammed, "")
+	} else {
+		conditions.set(v1beta1.ListenerConditionProgrammed, false, v1beta1.ListenerReasonInvalid, "the listener is not valid")
+	}
+	// supportedKinds is a required field, so it is written even if empty
+	supportedKinds := lt.supportedKinds
+	if supportedKinds == nil {
+		supportedKinds = []v1beta1.RouteGroupKind{}
+	}
+	return v1beta1.ListenerStatus{
+		Name:           lt.listener.Name,
+		SupportedKinds: supportedKinds,
+		AttachedRoutes: lt.attachedRoutes,
+		Conditions:     conditions.list(),
+	}
+}
@@ -0,0 +1,79 @@
+package translator
+
+import (
+	"sort"
+
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/api/v1beta1"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// A route of an HTTPRoute, with the properties that determine its precedence over the routes it shares a virtual host with
+type precedenceRoute struct {
+	route *gloov1.Route
+
+	pathType v1beta1.PathMatchType
+	path     string
+	method   bool
+	headers  int
+	query    int
+
+	created    metav1.Time
+	namespace  string
+	name       string
+	ruleIndex  int
+	matchIndex int
+}
+
+// Sorts routes by the precedence defined by the Gateway API, as envoy serves requests with the first matching route:
+// exact paths, then the longest path prefixes, then routes matching the method, then those matching the most headers,
+// then those matching the most query parameters. Ties go to the oldest route, then the route first by namespace
+// and name, then the first rule and match of the route.
+func sortByPrecedence(routes []*precedenceRoute) {
+	sort.SliceStable(routes, func(i, j int) bool {
+		return routes[i].precedes(routes[j])
+	})
+}
+
+func (r *precedenceRoute) precedes(other *precedenceRoute) bool {
+	if pathTypeRank(r.pathType) != pathTypeRank(other.pathType) {
+		return pathTypeRank(r.pathType) < pathTypeRank(other.pathType)
+	}
+	if len(r.path) != len(other.path) {
+		return len(r.path) > len(other.path)
+	}
+	if r.method != other.method {
+		return r.method
+	}
+	if r.headers != other.headers {
+		return r.headers > other.headers
+	}
+	if r.query != other.query {
+		return r.query > other.query
+	}
+	if !r.created.Equal(&other.created) {
+		return r.created.Before(&other.created)
+	}
+	if r.namespace != other.namespace {
+		return r.namespace < other.namespace
+	}
+	if r.name != other.name {
+		return r.name < other.name
+	}
+	if r.ruleIndex != other.ruleIndex {
+		return r.ruleIndex < other.ruleIndex
+	}
+	return r.matchIndex < other.matchIndex
+}
+
+// Regular expressions are implementation specific, and go last
+func pathTypeRank(pathType v1beta1.PathMatchType) int {
+	switch pathType {
+	case v1beta1.PathMatchExact:
+		return 0
+	case v1beta1.PathMatchPathPrefix:
+		return 1
+	default:
+		return 2
+	}
+}
@@ -0,0 +1,189 @@
+package translator
+
+import (
+	"fmt"
+
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/api/v1beta1"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	coreGroup   = ""
+	serviceKind = "Service"
+	secretKind  = "Secret"
+)
+
+// An error reported with the reason of a condition, e.g. a reference that cannot be resolved
+type conditionErr struct {
+	reason  string
+	message string
+}
+
+func (e *conditionErr) Error() string {
+	return e.message
+}
+
+func newConditionErr(reason, format string, args ...interface{}) *conditionErr {
+	return &conditionErr{reason: reason, message: fmt.Sprintf(format, args...)}
+}
+
+// Resolves the references of Gateway API resources to the resources of the snapshot
+type referenceResolver struct {
+	services   map[types.NamespacedName]*corev1.Service
+	secrets    map[types.NamespacedName]*corev1.Secret
+	namespaces map[string]*corev1.Namespace
+	grants     []*v1beta1.ReferenceGrant
+}
+
+func newReferenceResolver(snap *Snapshot) *referenceResolver {
+	r := &referenceResolver{
+		services:   make(map[types.NamespacedName]*corev1.Service),
+		secrets:    make(map[types.NamespacedName]*corev1.Secret),
+		namespaces: make(map[string]*corev1.Namespace),
+		grants:     snap.ReferenceGrants,
+	}
+	for _, svc := range snap.Services {
+		r.services[types.NamespacedName{Namespace: svc.Namespace, Name: svc.Name}] = svc
+	}
+	for _, secret := range snap.Secrets {
+		r.secrets[types.NamespacedName{Namespace: secret.Namespace, Name: secret.Name}] = secret
+	}
+	for _, ns := range snap.Namespaces {
+		r.namespaces[ns.Name] = ns
+	}
+	return r
+}
+
+// References to resources in the same namespace are always permitted, while references to resources in other
+// namespaces must be permitted by a ReferenceGrant in the namespace of the referenced resource.
+func (r *referenceResolver) permitted(fromKind, fromNamespace, toGroup, toKind, toNamespace, toName string) bool {
+	if fromNamespace == toNamespace {
+		return true
+	}
+	for _, grant := range r.grants {
+		if grant.Namespace != toNamespace {
+			continue
+		}
+		if !grantsFrom(grant, fromKind, fromNamespace) {
+			continue
+		}
+		for _, to := range grant.Spec.To {
+			if to.Group == toGroup && to.Kind == toKind && (to.Name == nil || *to.Name == toName) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func grantsFrom(grant *v1beta1.ReferenceGrant, fromKind, fromNamespace string) bool {
+	for _, from := range grant.Spec.From {
+		if from.Group == v1beta1.GroupName && from.Kind == fromKind && from.Namespace == fromNamespace {
+			return true
+		}
+	}
+	return false
+}
+
+// Resolves the certificate of a listener of a Gateway to a TLS secret
+func (r *referenceResolver) certificate(gateway *v1beta1.Gateway, ref v1beta1.SecretObjectReference) (*core.ResourceRef, *conditionErr) {
+	group, kind := valueOr(ref.Group, coreGroup), valueOr(ref.Kind, secretKind)
+	if group != coreGroup || kind != secretKind {
+		return nil, newConditionErr(v1beta1.ListenerReasonInvalidCertificateRef, "certificate %v of unsupported kind %v", ref.Name, groupKind(group, kind))
+	}
+	namespace := valueOr(ref.Namespace, gateway.Namespace)
+	if !r.permitted(v1beta1.GatewayKind, gateway.Namespace, group, kind, namespace, ref.Name) {
+		return nil, newConditionErr(v1beta1.ListenerReasonRefNotPermitted, "reference to certificate %v.%v is not permitted by a ReferenceGrant", namespace, ref.Name)
+	}
+	secret, ok := r.secrets[types.NamespacedName{Namespace: namespace, Name: ref.Name}]
+	if !ok {
+		return nil, newConditionErr(v1beta1.ListenerReasonInvalidCertificateRef, "certificate %v.%v not found", namespace, ref.Name)
+	}
+	if secret.Type != corev1.SecretTypeTLS {
+		return nil, newConditionErr(v1beta1.ListenerReasonInvalidCertificateRef, "certificate %v.%v is not a secret of type %v", namespace, ref.Name, corev1.SecretTypeTLS)
+	}
+	return &core.ResourceRef{Name: ref.Name, Namespace: namespace}, nil
+}
+
+// Resolves the backends of a route to weighted destinations. Invalid backends are left out, so their share of
+// the traffic is served by the valid backends; the first invalid backend is returned as an error.
+func (r *referenceResolver) destinations(routeKind, routeNamespace string, backendRefs []v1beta1.BackendRef) ([]*gloov1.WeightedDestination, error) {
+	var destinations []*gloov1.WeightedDestination
+	var firstErr error
+	for _, backendRef := range backendRefs {
+		destination, err := r.destination(routeKind, routeNamespace, backendRef.BackendObjectReference)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		weight := int32(1)
+		if backendRef.Weight != nil {
+			weight = *backendRef.Weight
+		}
+		if weight <= 0 {
+			continue
+		}
+		destinations = append(destinations, &gloov1.WeightedDestination{
+			Destination: destination,
+			Weight:      uint32(weight),
+		})
+	}
+	return destinations, firstErr
+}
+
+func (r *referenceResolver) destination(routeKind, routeNamespace string, ref v1beta1.BackendObjectReference) (*gloov1.Destination, error) {
+	group, kind := valueOr(ref.Group, coreGroup), valueOr(ref.Kind, serviceKind)
+	if group != coreGroup || kind != serviceKind {
+		return nil, newConditionErr(v1beta1.RouteReasonInvalidKind, "backend %v of unsupported kind %v", ref.Name, groupKind(group, kind))
+	}
+	namespace := valueOr(ref.Namespace, routeNamespace)
+	if !r.permitted(routeKind, routeNamespace, group, kind, namespace, ref.Name) {
+		return nil, newConditionErr(v1beta1.RouteReasonRefNotPermitted, "reference to backend %v.%v is not permitted by a ReferenceGrant", namespace, ref.Name)
+	}
+	svc, ok := r.services[types.NamespacedName{Namespace: namespace, Name: ref.Name}]
+	if !ok {
+		return nil, newConditionErr(v1beta1.RouteReasonBackendNotFound, "service %v.%v not found", namespace, ref.Name)
+	}
+	if ref.Port == nil {
+		return nil, newConditionErr(v1beta1.RouteReasonBackendNotFound, "port of service %v.%v is required", namespace, ref.Name)
+	}
+	if !hasPort(svc, int32(*ref.Port)) {
+		return nil, newConditionErr(v1beta1.RouteReasonBackendNotFound, "port %v of service %v.%v not found", *ref.Port, namespace, ref.Name)
+	}
+	return &gloov1.Destination{
+		DestinationType: &gloov1.Destination_Kube{
+			Kube: &gloov1.KubernetesServiceDestination{
+				Ref:  &core.ResourceRef{Name: ref.Name, Namespace: namespace},
+				Port: uint32(*ref.Port),
+			},
+		},
+	}, nil
+}
+
+func hasPort(svc *corev1.Service, port int32) bool {
+	for _, servicePort := range svc.Spec.Ports {
+		if servicePort.Port == port {
+			return true
+		}
+	}
+	return false
+}
+
+func groupKind(group, kind string) string {
+	if group == coreGroup {
+		return kind
+	}
+	return kind + "." + group
+}
+
+func valueOr(value *string, defaultValue string) string {
+	if value == nil {
+		return defaultValue
+	}
+	return *value
+}
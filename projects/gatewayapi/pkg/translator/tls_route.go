@@ -0,0 +1,41 @@
+package translator
+
+import (
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/api/v1alpha2"
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/api/v1beta1"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+)
+
+// The destination of the TLS connections of a TLSRoute
+type tlsRouteTranslation struct {
+	route       *v1alpha2.TLSRoute
+	destination *gloov1.TcpHost_TcpAction
+	// The first backend that could not be resolved
+	invalidRefs error
+}
+
+// TLSRoutes cannot match anything but the SNI of connections, so the backends of all rules are merged
+func translateTLSRoute(route *v1alpha2.TLSRoute, refs *referenceResolver) *tlsRouteTranslation {
+	rt := &tlsRouteTranslation{route: route}
+	var backendRefs []v1beta1.BackendRef
+	for _, rule := range route.Spec.Rules {
+		backendRefs = append(backendRefs, rule.BackendRefs...)
+	}
+	destinations, err := refs.destinations(v1beta1.TLSRouteKind, route.Namespace, backendRefs)
+	rt.invalidRefs = err
+	switch len(destinations) {
+	case 0:
+		// connections without a destination are closed
+	case 1:
+		rt.destination = &gloov1.TcpHost_TcpAction{
+			Destination: &gloov1.TcpHost_TcpAction_Single{Single: destinations[0].GetDestination()},
+		}
+	default:
+		rt.destination = &gloov1.TcpHost_TcpAction{
+			Destination: &gloov1.TcpHost_TcpAction_Multi{
+				Multi: &gloov1.MultiDestination{Destinations: destinations},
+			},
+		}
+	}
+	return rt
+}
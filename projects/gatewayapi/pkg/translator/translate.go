@@ -0,0 +1,407 @@
+package translator
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/api/v1alpha2"
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/api/v1beta1"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// The controller name GatewayClasses handled by Gloo Edge specify by default
+const DefaultControllerName = "solo.io/gloo-gateway"
+
+// The resources the proxies of Gateways are translated from
+type Snapshot struct {
+	GatewayClasses  []*v1beta1.GatewayClass
+	Gateways        []*v1beta1.Gateway
+	HTTPRoutes      []*v1beta1.HTTPRoute
+	TLSRoutes       []*v1alpha2.TLSRoute
+	ReferenceGrants []*v1beta1.ReferenceGrant
+	Namespaces      []*corev1.Namespace
+	Services        []*corev1.Service
+	Secrets         []*corev1.Secret
+}
+
+// The proxies of the Gateways of our GatewayClasses, and the statuses of the resources of the snapshot we manage
+type Result struct {
+	Proxies              gloov1.ProxyList
+	GatewayClassStatuses map[*v1beta1.GatewayClass]v1beta1.GatewayClassStatus
+	GatewayStatuses      map[*v1beta1.Gateway]v1beta1.GatewayStatus
+	HTTPRouteStatuses    map[*v1beta1.HTTPRoute]v1beta1.RouteStatus
+	TLSRouteStatuses     map[*v1alpha2.TLSRoute]v1beta1.RouteStatus
+}
+
+type translation struct {
+	controllerName string
+	refs           *referenceResolver
+	gateways       map[types.NamespacedName]*gatewayTranslation
+}
+
+// Translates the Gateways of the GatewayClasses with our controller name to a proxy each, written to the namespace.
+// The listeners of a Gateway are translated to listeners of its proxy, serving the routes attached to them.
+func Translate(snap *Snapshot, controllerName, namespace string) *Result {
+	t := &translation{
+		controllerName: controllerName,
+		refs:           newReferenceResolver(snap),
+		gateways:       make(map[types.NamespacedName]*gatewayTranslation),
+	}
+	result := &Result{
+		GatewayClassStatuses: make(map[*v1beta1.GatewayClass]v1beta1.GatewayClassStatus),
+		GatewayStatuses:      make(map[*v1beta1.Gateway]v1beta1.GatewayStatus),
+		HTTPRouteStatuses:    make(map[*v1beta1.HTTPRoute]v1beta1.RouteStatus),
+		TLSRouteStatuses:     make(map[*v1alpha2.TLSRoute]v1beta1.RouteStatus),
+	}
+
+	ourClasses := make(map[string]bool)
+	for _, class := range snap.GatewayClasses {
+		if class.Spec.ControllerName != controllerName {
+			continue
+		}
+		ourClasses[class.Name] = true
+		conditions := newConditions(class.Generation, class.Status.Conditions)
+		conditions.set(v1beta1.GatewayClassConditionAccepted, true, v1beta1.GatewayClassReasonAccepted, "")
+		result.GatewayClassStatuses[class] = v1beta1.GatewayClassStatus{Conditions: conditions.list()}
+	}
+
+	var gateways []*gatewayTranslation
+	for _, gateway := range snap.Gateways {
+		if !ourClasses[gateway.Spec.GatewayClassName] {
+			continue
+		}
+		gt := translateGateway(gateway, t.refs)
+		t.gateways[types.NamespacedName{Namespace: gateway.Namespace, Name: gateway.Name}] = gt
+		gateways = append(gateways, gt)
+	}
+
+	for _, route := range snap.HTTPRoutes {
+		rt := translateHTTPRoute(route, t.refs)
+		attachTo := func(lt *listenerTranslation, hostnames []string) {
+			lt.httpRoutes = append(lt.httpRoutes, &attachedHTTPRoute{route: rt, hostnames: hostnames})
+		}
+		status := t.routeStatus(v1beta1.HTTPRouteKind, &route.ObjectMeta, route.Spec.CommonRouteSpec, route.Spec.Hostnames, route.Status, rt.notAccepted, rt.invalidRefs, attachTo)
+		result.HTTPRouteStatuses[route] = status
+	}
+	for _, route := range snap.TLSRoutes {
+		rt := translateTLSRoute(route, t.refs)
+		attachTo := func(lt *listenerTranslation, hostnames []string) {
+			lt.tlsRoutes = append(lt.tlsRoutes, &attachedTLSRoute{route: rt, hostnames: hostnames})
+		}
+		status := t.routeStatus(v1beta1.TLSRouteKind, &route.ObjectMeta, route.Spec.CommonRouteSpec, route.Spec.Hostnames, route.Status, nil, rt.invalidRefs, attachTo)
+		result.TLSRouteStatuses[route] = status
+	}
+
+	for _, gt := range gateways {
+		result.GatewayStatuses[gt.gateway] = gt.status()
+		if proxy := gt.proxy(namespace); proxy != nil {
+			result.Proxies = append(result.Proxies, proxy)
+		}
+	}
+	sort.SliceStable(result.Proxies, func(i, j int) bool {
+		return result.Proxies[i].GetMetadata().GetName() < result.Proxies[j].GetMetadata().GetName()
+	})
+	return result
+}
+
+// The name of the proxy of a Gateway, which must match the role of the envoy serving the Gateway
+func ProxyName(gateway *v1beta1.Gateway) string {
+	return gateway.Namespace + "-" + gateway.Name
+}
+
+// Attaches the route to its parents, and returns its status with the conditions of our parents.
+// The statuses of the parents of other controllers are kept.
+func (t *translation) routeStatus(
+	routeKind string,
+	route *metav1.ObjectMeta,
+	spec v1beta1.CommonRouteSpec,
+	hostnames []v1beta1.Hostname,
+	current v1beta1.RouteStatus,
+	notAccepted *conditionErr,
+	invalidRefs error,
+	attachTo func(*listenerTranslation, []string),
+) v1beta1.RouteStatus {
+	// parents is a required field, so it is written even if empty
+	status := v1beta1.RouteStatus{Parents: []v1beta1.RouteParentStatus{}}
+	for _, parent := range current.Parents {
+		if parent.ControllerName != t.controllerName {
+			status.Parents = append(status.Parents, parent)
+		}
+	}
+
+	for _, parentRef := range spec.ParentRefs {
+		gt := t.parentGateway(route.Namespace, parentRef)
+		if gt == nil {
+			continue
+		}
+		var currentConditions []metav1.Condition
+		for _, parent := range current.Parents {
+			if parent.ControllerName == t.controllerName && parentRefsEqual(parent.ParentRef, parentRef) {
+				currentConditions = parent.Conditions
+			}
+		}
+		conditions := newConditions(route.Generation, currentConditions)
+
+		parentNotAccepted := notAccepted
+		if parentNotAccepted == nil {
+			parentNotAccepted = t.attach(gt, routeKind, route.Namespace, hostnames, parentRef, attachTo)
+		}
+		if parentNotAccepted != nil {
+			conditions.set(v1beta1.RouteConditionAccepted, false, parentNotAccepted.reason, parentNotAccepted.message)
+		} else {
+			conditions.set(v1beta1.RouteConditionAccepted, true, v1beta1.RouteReasonAccepted, "")
+		}
+		conditions.setResolvedRefs(invalidRefs)
+
+		status.Parents = append(status.Parents, v1beta1.RouteParentStatus{
+			ParentRef:      parentRef,
+			ControllerName: t.controllerName,
+			Conditions:     conditions.list(),
+		})
+	}
+	return status
+}
+
+// Returns the Gateway a parent reference of a route refers to, if it is one of ours
+func (t *translation) parentGateway(routeNamespace string, parentRef v1beta1.ParentReference) *gatewayTranslation {
+	if valueOr(parentRef.Group, v1beta1.GroupName) != v1beta1.GroupName || valueOr(parentRef.Kind, v1beta1.GatewayKind) != v1beta1.GatewayKind {
+		return nil
+	}
+	return t.gateways[types.NamespacedName{Namespace: valueOr(parentRef.Namespace, routeNamespace), Name: parentRef.Name}]
+}
+
+// Attaches a route to the listeners of the Gateway that the parent reference selects, which allow the route and
+// share hostnames with it. Returns the reason the route is not accepted if no listener does.
+func (t *translation) attach(gt *gatewayTranslation, routeKind, routeNamespace string, hostnames []v1beta1.Hostname, parentRef v1beta1.ParentReference, attachTo func(*listenerTranslation, []string)) *conditionErr {
+	var selected, allowed, attached int
+	for _, lt := range gt.listeners {
+		if parentRef.SectionName != nil && *parentRef.SectionName != lt.listener.Name {
+			continue
+		}
+		if parentRef.Port != nil && *parentRef.Port != lt.listener.Port {
+			continue
+		}
+		selected++
+		if !lt.programmed() || !lt.allows(gt.gateway, routeKind, routeNamespace, t.refs) {
+			continue
+		}
+		allowed++
+		listenerHostnames := intersectHostnames(lt.listener.Hostname, hostnames)
+		if len(listenerHostnames) == 0 {
+			continue
+		}
+		lt.attachedRoutes++
+		attachTo(lt, listenerHostnames)
+		attached++
+	}
+	switch {
+	case attached > 0:
+		return nil
+	case selected == 0:
+		return newConditionErr(v1beta1.RouteReasonNoMatchingParent, "no listener of Gateway %v.%v matches the parent reference", gt.gateway.Namespace, gt.gateway.Name)
+	case allowed == 0:
+		return newConditionErr(v1beta1.RouteReasonNotAllowedByListeners, "no listener of Gateway %v.%v allows the route", gt.gateway.Namespace, gt.gateway.Name)
+	default:
+		return newConditionErr(v1beta1.RouteReasonNoMatchingListenerHostname, "no listener of Gateway %v.%v serves the hostnames of the route", gt.gateway.Namespace, gt.gateway.Name)
+	}
+}
+
+func parentRefsEqual(a, b v1beta1.ParentReference) bool {
+	return valueOr(a.Group, v1beta1.GroupName) == valueOr(b.Group, v1beta1.GroupName) &&
+		valueOr(a.Kind, v1beta1.GatewayKind) == valueOr(b.Kind, v1beta1.GatewayKind) &&
+		valueOr(a.Namespace, "") == valueOr(b.Namespace, "") &&
+		a.Name == b.Name &&
+		valueOr(a.SectionName, "") == valueOr(b.SectionName, "") &&
+		portOr(a.Port) == portOr(b.Port)
+}
+
+func portOr(port *v1beta1.PortNumber) v1beta1.PortNumber {
+	if port == nil {
+		return 0
+	}
+	return *port
+}
+
+// Translates the programmed listeners of the Gateway to the listeners of its proxy, one per port.
+// Returns nil if no listener is programmed.
+func (gt *gatewayTranslation) proxy(namespace string) *gloov1.Proxy {
+	var ports []v1beta1.PortNumber
+	listenersByPort := make(map[v1beta1.PortNumber][]*listenerTranslation)
+	for _, lt := range gt.listeners {
+		if !lt.programmed() {
+			continue
+		}
+		port := lt.listener.Port
+		if _, ok := listenersByPort[port]; !ok {
+			ports = append(ports, port)
+		}
+		listenersByPort[port] = append(listenersByPort[port], lt)
+	}
+	if len(ports) == 0 {
+		return nil
+	}
+
+	var listeners []*gloov1.Listener
+	for _, port := range ports {
+		sharingPort := listenersByPort[port]
+		// listeners sharing a port have the same protocol
+		protocol := sharingPort[0].listener.Protocol
+		listener := &gloov1.Listener{
+			Name:        fmt.Sprintf("listener-%v", port),
+			BindAddress: "::",
+			BindPort:    uint32(port),
+		}
+		switch protocol {
+		case v1beta1.HTTPProtocolType, v1beta1.HTTPSProtocolType:
+			listener.ListenerType = &gloov1.Listener_HttpListener{
+				HttpListener: &gloov1.HttpListener{
+					VirtualHosts: virtualHosts(sharingPort, uint32(port)),
+				},
+			}
+			if protocol == v1beta1.HTTPSProtocolType {
+				listener.SslConfigurations = sslConfigs(sharingPort)
+			}
+		case v1beta1.TLSProtocolType:
+			listener.ListenerType = &gloov1.Listener_TcpListener{
+				TcpListener: &gloov1.TcpListener{
+					TcpHosts: tcpHosts(sharingPort),
+				},
+			}
+		}
+		listeners = append(listeners, listener)
+	}
+	return &gloov1.Proxy{
+		Metadata: &core.Metadata{
+			Name:      ProxyName(gt.gateway),
+			Namespace: namespace,
+		},
+		Listeners: listeners,
+	}
+}
+
+// Serves the routes of each hostname with a virtual host. A route is served by the virtual host of
+// the most specific hostname it shares with a listener, as envoy prefers exact hostnames to wildcards.
+func virtualHosts(listeners []*listenerTranslation, port uint32) []*gloov1.VirtualHost {
+	routesByHost := make(map[string][]*precedenceRoute)
+	attached := make(map[string]map[*httpRouteTranslation]bool)
+	for _, lt := range listeners {
+		for _, route := range lt.httpRoutes {
+			for _, host := range route.hostnames {
+				if attached[host] == nil {
+					attached[host] = make(map[*httpRouteTranslation]bool)
+				}
+				// a route may be attached to several listeners of the port with the same hostname
+				if attached[host][route.route] {
+					continue
+				}
+				attached[host][route.route] = true
+				routesByHost[host] = append(routesByHost[host], route.route.routes...)
+			}
+		}
+	}
+
+	var virtualHosts []*gloov1.VirtualHost
+	for host, routes := range routesByHost {
+		sortByPrecedence(routes)
+		var glooRoutes []*gloov1.Route
+		for _, route := range routes {
+			glooRoutes = append(glooRoutes, route.route)
+		}
+		virtualHosts = append(virtualHosts, &gloov1.VirtualHost{
+			Name:    host,
+			Domains: domains(host, port),
+			Routes:  glooRoutes,
+		})
+	}
+	sort.SliceStable(virtualHosts, func(i, j int) bool {
+		return virtualHosts[i].GetName() < virtualHosts[j].GetName()
+	})
+	return virtualHosts
+}
+
+// Requests may specify the port in the host header
+func domains(host string, port uint32) []string {
+	if host == anyHostname {
+		return []string{anyHostname}
+	}
+	return []string{host, host + ":" + strconv.Itoa(int(port))}
+}
+
+// Serves the certificate of each listener for its hostname
+func sslConfigs(listeners []*listenerTranslation) []*gloov1.SslConfig {
+	var sslConfigs []*gloov1.SslConfig
+	for _, lt := range listeners {
+		sslConfig := &gloov1.SslConfig{
+			SslSecrets: &gloov1.SslConfig_SecretRef{SecretRef: lt.secret},
+		}
+		if lt.listener.Hostname != nil {
+			sslConfig.SniDomains = []string{string(*lt.listener.Hostname)}
+		}
+		sslConfigs = append(sslConfigs, sslConfig)
+	}
+	return sslConfigs
+}
+
+// Routes TLS connections to the TLSRoutes attached to the listeners by their SNI. If several routes share a
+// hostname, the oldest route serves it.
+func tcpHosts(listeners []*listenerTranslation) []*gloov1.TcpHost {
+	var tcpHosts []*gloov1.TcpHost
+	servedHosts := make(map[string]bool)
+	for _, lt := range listeners {
+		routes := append([]*attachedTLSRoute(nil), lt.tlsRoutes...)
+		sort.SliceStable(routes, func(i, j int) bool {
+			return olderThan(&routes[i].route.route.ObjectMeta, &routes[j].route.route.ObjectMeta)
+		})
+		for _, route := range routes {
+			if route.route.destination == nil {
+				continue
+			}
+			for _, host := range route.hostnames {
+				if servedHosts[host] {
+					continue
+				}
+				servedHosts[host] = true
+				tcpHosts = append(tcpHosts, &gloov1.TcpHost{
+					Name:        fmt.Sprintf("%v.%v-%v", route.route.route.Namespace, route.route.route.Name, host),
+					SslConfig:   tcpSslConfig(lt, host),
+					Destination: route.route.destination,
+				})
+			}
+		}
+	}
+	return tcpHosts
+}
+
+// Connections are passed through to their destination without terminating TLS, unless the listener has a certificate
+func tcpSslConfig(lt *listenerTranslation, host string) *gloov1.SslConfig {
+	var sniDomains []string
+	if host != anyHostname {
+		sniDomains = []string{host}
+	}
+	if lt.secret != nil {
+		return &gloov1.SslConfig{
+			SslSecrets: &gloov1.SslConfig_SecretRef{SecretRef: lt.secret},
+			SniDomains: sniDomains,
+		}
+	}
+	if sniDomains == nil {
+		// connections without SNI matching are proxied as is
+		return nil
+	}
+	return &gloov1.SslConfig{SniDomains: sniDomains}
+}
+
+func olderThan(a, b *metav1.ObjectMeta) bool {
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+	if a.Namespace != b.Namespace {
+		return a.Namespace < b.Namespace
+	}
+	return a.Name < b.Name
+}
@@ -0,0 +1,759 @@
+package translator_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/api/v1alpha2"
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/api/v1beta1"
+	. "github.com/solo-io/gloo/projects/gatewayapi/pkg/translator"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	. "github.com/solo-io/solo-kit/test/matchers"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	writeNamespace = "gloo-system"
+	gatewayClass   = `
+metadata:
+  name: gloo
+spec:
+  controllerName: solo.io/gloo-gateway
+`
+	httpGateway = `
+metadata:
+  name: gw
+  namespace: default
+spec:
+  gatewayClassName: gloo
+  listeners:
+  - name: http
+    port: 80
+    protocol: HTTP
+`
+	httpRoute = `
+metadata:
+  name: route
+  namespace: default
+spec:
+  parentRefs:
+  - name: gw
+  rules:
+  - matches:
+    - path:
+        type: PathPrefix
+        value: /foo
+    backendRefs:
+    - name: svc
+      port: 8080
+`
+)
+
+func gatewayClassOf(spec string) *v1beta1.GatewayClass {
+	class := &v1beta1.GatewayClass{}
+	ExpectWithOffset(1, yaml.Unmarshal([]byte(spec), class)).To(Succeed())
+	return class
+}
+
+func gatewayOf(spec string) *v1beta1.Gateway {
+	gateway := &v1beta1.Gateway{}
+	ExpectWithOffset(1, yaml.Unmarshal([]byte(spec), gateway)).To(Succeed())
+	return gateway
+}
+
+func httpRouteOf(spec string) *v1beta1.HTTPRoute {
+	route := &v1beta1.HTTPRoute{}
+	ExpectWithOffset(1, yaml.Unmarshal([]byte(spec), route)).To(Succeed())
+	return route
+}
+
+func tlsRouteOf(spec string) *v1alpha2.TLSRoute {
+	route := &v1alpha2.TLSRoute{}
+	ExpectWithOffset(1, yaml.Unmarshal([]byte(spec), route)).To(Succeed())
+	return route
+}
+
+func referenceGrantOf(spec string) *v1beta1.ReferenceGrant {
+	grant := &v1beta1.ReferenceGrant{}
+	ExpectWithOffset(1, yaml.Unmarshal([]byte(spec), grant)).To(Succeed())
+	return grant
+}
+
+func service(namespace, name string, port int32) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Port: port}}},
+	}
+}
+
+func kubeDestination(namespace, name string, port uint32) *gloov1.Destination {
+	return &gloov1.Destination{
+		DestinationType: &gloov1.Destination_Kube{
+			Kube: &gloov1.KubernetesServiceDestination{
+				Ref:  &core.ResourceRef{Namespace: namespace, Name: name},
+				Port: port,
+			},
+		},
+	}
+}
+
+func condition(conditions []metav1.Condition, conditionType string) metav1.Condition {
+	for _, condition := range conditions {
+		if condition.Type == conditionType {
+			return condition
+		}
+	}
+	Fail("condition " + conditionType + " not found")
+	return metav1.Condition{}
+}
+
+var _ = Describe("Translate", func() {
+	var snap *Snapshot
+
+	BeforeEach(func() {
+		snap = &Snapshot{
+			GatewayClasses: []*v1beta1.GatewayClass{gatewayClassOf(gatewayClass)},
+			Gateways:       []*v1beta1.Gateway{gatewayOf(httpGateway)},
+			HTTPRoutes:     []*v1beta1.HTTPRoute{httpRouteOf(httpRoute)},
+			Services:       []*corev1.Service{service("default", "svc", 8080)},
+		}
+	})
+
+	// the routes of the only virtual host of the only listener of the only proxy
+	routesOf := func(result *Result) []*gloov1.Route {
+		Expect(result.Proxies).To(HaveLen(1))
+		Expect(result.Proxies[0].GetListeners()).To(HaveLen(1))
+		virtualHosts := result.Proxies[0].GetListeners()[0].GetHttpListener().GetVirtualHosts()
+		Expect(virtualHosts).To(HaveLen(1))
+		return virtualHosts[0].GetRoutes()
+	}
+
+	routeConditions := func(result *Result, route *v1beta1.HTTPRoute) []metav1.Condition {
+		parents := result.HTTPRouteStatuses[route].Parents
+		Expect(parents).To(HaveLen(1))
+		return parents[0].Conditions
+	}
+
+	It("translates the Gateways of our GatewayClasses", func() {
+		otherClass := gatewayClassOf(`
+metadata:
+  name: other
+spec:
+  controllerName: example.com/other
+`)
+		otherGateway := gatewayOf(httpGateway)
+		otherGateway.Name = "other"
+		otherGateway.Spec.GatewayClassName = "other"
+		snap.GatewayClasses = append(snap.GatewayClasses, otherClass)
+		snap.Gateways = append(snap.Gateways, otherGateway)
+
+		result := Translate(snap, DefaultControllerName, writeNamespace)
+
+		Expect(result.GatewayClassStatuses).To(HaveLen(1))
+		Expect(condition(result.GatewayClassStatuses[snap.GatewayClasses[0]].Conditions, v1beta1.GatewayClassConditionAccepted).Status).To(Equal(metav1.ConditionTrue))
+		Expect(result.GatewayStatuses).To(HaveLen(1))
+		Expect(result.GatewayStatuses).To(HaveKey(snap.Gateways[0]))
+
+		Expect(result.Proxies).To(HaveLen(1))
+		Expect(result.Proxies[0]).To(MatchProto(&gloov1.Proxy{
+			Metadata: &core.Metadata{Name: "default-gw", Namespace: writeNamespace},
+			Listeners: []*gloov1.Listener{{
+				Name:        "listener-80",
+				BindAddress: "::",
+				BindPort:    80,
+				ListenerType: &gloov1.Listener_HttpListener{
+					HttpListener: &gloov1.HttpListener{
+						VirtualHosts: []*gloov1.VirtualHost{{
+							Name:    "*",
+							Domains: []string{"*"},
+							Routes: []*gloov1.Route{
+								{
+									Name:     "default.route-rule-0-match-0",
+									Matchers: []*matchers.Matcher{{PathSpecifier: &matchers.Matcher_Exact{Exact: "/foo"}}},
+									Action: &gloov1.Route_RouteAction{RouteAction: &gloov1.RouteAction{
+										Destination: &gloov1.RouteAction_Single{Single: kubeDestination("default", "svc", 8080)},
+									}},
+								},
+								{
+									Name:     "default.route-rule-0-match-0",
+									Matchers: []*matchers.Matcher{{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/foo/"}}},
+									Action: &gloov1.Route_RouteAction{RouteAction: &gloov1.RouteAction{
+										Destination: &gloov1.RouteAction_Single{Single: kubeDestination("default", "svc", 8080)},
+									}},
+								},
+							},
+						}},
+					},
+				},
+			}},
+		}))
+	})
+
+	It("reports the status of gateways, listeners and routes", func() {
+		result := Translate(snap, DefaultControllerName, writeNamespace)
+
+		gatewayStatus := result.GatewayStatuses[snap.Gateways[0]]
+		Expect(condition(gatewayStatus.Conditions, v1beta1.GatewayConditionAccepted).Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition(gatewayStatus.Conditions, v1beta1.GatewayConditionProgrammed).Status).To(Equal(metav1.ConditionTrue))
+		Expect(gatewayStatus.Listeners).To(HaveLen(1))
+		Expect(gatewayStatus.Listeners[0].Name).To(Equal("http"))
+		Expect(gatewayStatus.Listeners[0].AttachedRoutes).To(BeEquivalentTo(1))
+		Expect(gatewayStatus.Listeners[0].SupportedKinds).To(HaveLen(1))
+		Expect(gatewayStatus.Listeners[0].SupportedKinds[0].Kind).To(Equal(v1beta1.HTTPRouteKind))
+		Expect(condition(gatewayStatus.Listeners[0].Conditions, v1beta1.ListenerConditionConflicted).Status).To(Equal(metav1.ConditionFalse))
+
+		parents := result.HTTPRouteStatuses[snap.HTTPRoutes[0]].Parents
+		Expect(parents).To(HaveLen(1))
+		Expect(parents[0].ControllerName).To(Equal(DefaultControllerName))
+		Expect(parents[0].ParentRef.Name).To(Equal("gw"))
+		Expect(condition(parents[0].Conditions, v1beta1.RouteConditionAccepted).Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition(parents[0].Conditions, v1beta1.RouteConditionResolvedRefs).Status).To(Equal(metav1.ConditionTrue))
+	})
+
+	It("keeps the parent statuses of other controllers", func() {
+		other := v1beta1.RouteParentStatus{
+			ParentRef:      v1beta1.ParentReference{Name: "other"},
+			ControllerName: "example.com/other",
+		}
+		snap.HTTPRoutes[0].Status.Parents = []v1beta1.RouteParentStatus{other}
+
+		result := Translate(snap, DefaultControllerName, writeNamespace)
+
+		parents := result.HTTPRouteStatuses[snap.HTTPRoutes[0]].Parents
+		Expect(parents).To(HaveLen(2))
+		Expect(parents[0]).To(Equal(other))
+		Expect(parents[1].ControllerName).To(Equal(DefaultControllerName))
+	})
+
+	It("serves the hostnames shared by listeners and routes", func() {
+		snap.Gateways[0].Spec.Listeners[0].Hostname = hostname("*.example.com")
+		snap.HTTPRoutes[0].Spec.Hostnames = []v1beta1.Hostname{"foo.example.com", "example.org"}
+
+		result := Translate(snap, DefaultControllerName, writeNamespace)
+
+		virtualHosts := result.Proxies[0].GetListeners()[0].GetHttpListener().GetVirtualHosts()
+		Expect(virtualHosts).To(HaveLen(1))
+		Expect(virtualHosts[0].GetName()).To(Equal("foo.example.com"))
+		Expect(virtualHosts[0].GetDomains()).To(Equal([]string{"foo.example.com", "foo.example.com:80"}))
+	})
+
+	It("does not accept routes without hostnames shared with a listener", func() {
+		snap.Gateways[0].Spec.Listeners[0].Hostname = hostname("*.example.com")
+		snap.HTTPRoutes[0].Spec.Hostnames = []v1beta1.Hostname{"example.org"}
+
+		result := Translate(snap, DefaultControllerName, writeNamespace)
+
+		accepted := condition(routeConditions(result, snap.HTTPRoutes[0]), v1beta1.RouteConditionAccepted)
+		Expect(accepted.Status).To(Equal(metav1.ConditionFalse))
+		Expect(accepted.Reason).To(Equal(v1beta1.RouteReasonNoMatchingListenerHostname))
+		Expect(result.GatewayStatuses[snap.Gateways[0]].Listeners[0].AttachedRoutes).To(BeEquivalentTo(0))
+	})
+
+	It("does not accept routes of other namespaces by default", func() {
+		snap.HTTPRoutes[0].Namespace = "other"
+		snap.HTTPRoutes[0].Spec.ParentRefs[0].Namespace = stringPtr("default")
+
+		result := Translate(snap, DefaultControllerName, writeNamespace)
+
+		accepted := condition(routeConditions(result, snap.HTTPRoutes[0]), v1beta1.RouteConditionAccepted)
+		Expect(accepted.Status).To(Equal(metav1.ConditionFalse))
+		Expect(accepted.Reason).To(Equal(v1beta1.RouteReasonNotAllowedByListeners))
+	})
+
+	It("accepts routes of namespaces selected by the listener", func() {
+		snap.Gateways[0].Spec.Listeners[0].AllowedRoutes = &v1beta1.AllowedRoutes{
+			Namespaces: &v1beta1.RouteNamespaces{
+				From:     fromNamespaces(v1beta1.NamespacesFromSelector),
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"routes": "true"}},
+			},
+		}
+		snap.Namespaces = []*corev1.Namespace{{ObjectMeta: metav1.ObjectMeta{Name: "other", Labels: map[string]string{"routes": "true"}}}}
+		snap.HTTPRoutes[0].Namespace = "other"
+		snap.HTTPRoutes[0].Spec.ParentRefs[0].Namespace = stringPtr("default")
+		snap.Services = append(snap.Services, service("other", "svc", 8080))
+
+		result := Translate(snap, DefaultControllerName, writeNamespace)
+
+		accepted := condition(routeConditions(result, snap.HTTPRoutes[0]), v1beta1.RouteConditionAccepted)
+		Expect(accepted.Status).To(Equal(metav1.ConditionTrue))
+	})
+
+	It("does not accept routes referring to missing listeners", func() {
+		snap.HTTPRoutes[0].Spec.ParentRefs[0].SectionName = stringPtr("missing")
+
+		result := Translate(snap, DefaultControllerName, writeNamespace)
+
+		accepted := condition(routeConditions(result, snap.HTTPRoutes[0]), v1beta1.RouteConditionAccepted)
+		Expect(accepted.Status).To(Equal(metav1.ConditionFalse))
+		Expect(accepted.Reason).To(Equal(v1beta1.RouteReasonNoMatchingParent))
+	})
+
+	Context("listeners", func() {
+
+		listenerConditions := func(result *Result, i int) []metav1.Condition {
+			return result.GatewayStatuses[snap.Gateways[0]].Listeners[i].Conditions
+		}
+
+		It("does not accept listeners of unsupported protocols", func() {
+			snap.Gateways[0].Spec.Listeners[0].Protocol = v1beta1.UDPProtocolType
+
+			result := Translate(snap, DefaultControllerName, writeNamespace)
+
+			accepted := condition(listenerConditions(result, 0), v1beta1.ListenerConditionAccepted)
+			Expect(accepted.Status).To(Equal(metav1.ConditionFalse))
+			Expect(accepted.Reason).To(Equal(v1beta1.ListenerReasonUnsupportedProtocol))
+			Expect(result.GatewayStatuses[snap.Gateways[0]].Listeners[0].SupportedKinds).To(BeEmpty())
+			Expect(condition(result.GatewayStatuses[snap.Gateways[0]].Conditions, v1beta1.GatewayConditionProgrammed).Status).To(Equal(metav1.ConditionFalse))
+			Expect(result.Proxies).To(BeEmpty())
+		})
+
+		DescribeTable("marks conflicting listeners",
+			func(protocol v1beta1.ProtocolType, reason string) {
+				listener := snap.Gateways[0].Spec.Listeners[0]
+				listener.Name = "other"
+				listener.Protocol = protocol
+				if protocol == v1beta1.HTTPSProtocolType {
+					listener.TLS = &v1beta1.GatewayTLSConfig{
+						CertificateRefs: []v1beta1.SecretObjectReference{{Name: "cert"}},
+					}
+					snap.Secrets = []*corev1.Secret{{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "cert"},
+						Type:       corev1.SecretTypeTLS,
+					}}
+				}
+				snap.Gateways[0].Spec.Listeners = append(snap.Gateways[0].Spec.Listeners, listener)
+
+				result := Translate(snap, DefaultControllerName, writeNamespace)
+
+				for i := range snap.Gateways[0].Spec.Listeners {
+					conflicted := condition(listenerConditions(result, i), v1beta1.ListenerConditionConflicted)
+					Expect(conflicted.Status).To(Equal(metav1.ConditionTrue))
+					Expect(conflicted.Reason).To(Equal(reason))
+				}
+				Expect(result.Proxies).To(BeEmpty())
+			},
+			Entry("with the same hostname", v1beta1.HTTPProtocolType, v1beta1.ListenerReasonHostnameConflict),
+			Entry("with different protocols", v1beta1.HTTPSProtocolType, v1beta1.ListenerReasonProtocolConflict),
+		)
+
+		It("serves the certificates of HTTPS listeners", func() {
+			snap.Gateways[0].Spec.Listeners[0] = v1beta1.Listener{
+				Name:     "https",
+				Hostname: hostname("example.com"),
+				Port:     443,
+				Protocol: v1beta1.HTTPSProtocolType,
+				TLS: &v1beta1.GatewayTLSConfig{
+					CertificateRefs: []v1beta1.SecretObjectReference{{Name: "cert"}},
+				},
+			}
+			snap.Secrets = []*corev1.Secret{{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "cert"},
+				Type:       corev1.SecretTypeTLS,
+			}}
+
+			result := Translate(snap, DefaultControllerName, writeNamespace)
+
+			Expect(result.Proxies).To(HaveLen(1))
+			listener := result.Proxies[0].GetListeners()[0]
+			Expect(listener.GetBindPort()).To(BeEquivalentTo(443))
+			Expect(listener.GetSslConfigurations()).To(HaveLen(1))
+			Expect(listener.GetSslConfigurations()[0]).To(MatchProto(&gloov1.SslConfig{
+				SslSecrets: &gloov1.SslConfig_SecretRef{SecretRef: &core.ResourceRef{Namespace: "default", Name: "cert"}},
+				SniDomains: []string{"example.com"},
+			}))
+		})
+
+		It("requires a ReferenceGrant for certificates of other namespaces", func() {
+			snap.Gateways[0].Spec.Listeners[0] = v1beta1.Listener{
+				Name:     "https",
+				Port:     443,
+				Protocol: v1beta1.HTTPSProtocolType,
+				TLS: &v1beta1.GatewayTLSConfig{
+					CertificateRefs: []v1beta1.SecretObjectReference{{Name: "cert", Namespace: stringPtr("certs")}},
+				},
+			}
+			snap.Secrets = []*corev1.Secret{{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "certs", Name: "cert"},
+				Type:       corev1.SecretTypeTLS,
+			}}
+
+			result := Translate(snap, DefaultControllerName, writeNamespace)
+
+			resolvedRefs := condition(listenerConditions(result, 0), v1beta1.ListenerConditionResolvedRefs)
+			Expect(resolvedRefs.Status).To(Equal(metav1.ConditionFalse))
+			Expect(resolvedRefs.Reason).To(Equal(v1beta1.ListenerReasonRefNotPermitted))
+			Expect(result.Proxies).To(BeEmpty())
+
+			snap.ReferenceGrants = []*v1beta1.ReferenceGrant{referenceGrantOf(`
+metadata:
+  name: certs
+  namespace: certs
+spec:
+  from:
+  - group: gateway.networking.k8s.io
+    kind: Gateway
+    namespace: default
+  to:
+  - group: ""
+    kind: Secret
+`)}
+
+			result = Translate(snap, DefaultControllerName, writeNamespace)
+
+			resolvedRefs = condition(listenerConditions(result, 0), v1beta1.ListenerConditionResolvedRefs)
+			Expect(resolvedRefs.Status).To(Equal(metav1.ConditionTrue))
+			Expect(result.Proxies).To(HaveLen(1))
+		})
+	})
+
+	Context("backends", func() {
+
+		It("requires a ReferenceGrant for services of other namespaces", func() {
+			snap.HTTPRoutes[0].Spec.Rules[0].BackendRefs[0].Namespace = stringPtr("backends")
+			snap.Services = []*corev1.Service{service("backends", "svc", 8080)}
+
+			result := Translate(snap, DefaultControllerName, writeNamespace)
+
+			resolvedRefs := condition(routeConditions(result, snap.HTTPRoutes[0]), v1beta1.RouteConditionResolvedRefs)
+			Expect(resolvedRefs.Status).To(Equal(metav1.ConditionFalse))
+			Expect(resolvedRefs.Reason).To(Equal(v1beta1.RouteReasonRefNotPermitted))
+			Expect(routesOf(result)[0].GetDirectResponseAction().GetStatus()).To(BeEquivalentTo(500))
+
+			snap.ReferenceGrants = []*v1beta1.ReferenceGrant{referenceGrantOf(`
+metadata:
+  name: backends
+  namespace: backends
+spec:
+  from:
+  - group: gateway.networking.k8s.io
+    kind: HTTPRoute
+    namespace: default
+  to:
+  - group: ""
+    kind: Service
+    name: svc
+`)}
+
+			result = Translate(snap, DefaultControllerName, writeNamespace)
+
+			resolvedRefs = condition(routeConditions(result, snap.HTTPRoutes[0]), v1beta1.RouteConditionResolvedRefs)
+			Expect(resolvedRefs.Status).To(Equal(metav1.ConditionTrue))
+			Expect(routesOf(result)[0].GetRouteAction().GetSingle()).To(MatchProto(kubeDestination("backends", "svc", 8080)))
+		})
+
+		It("reports missing services", func() {
+			snap.Services = nil
+
+			result := Translate(snap, DefaultControllerName, writeNamespace)
+
+			resolvedRefs := condition(routeConditions(result, snap.HTTPRoutes[0]), v1beta1.RouteConditionResolvedRefs)
+			Expect(resolvedRefs.Status).To(Equal(metav1.ConditionFalse))
+			Expect(resolvedRefs.Reason).To(Equal(v1beta1.RouteReasonBackendNotFound))
+		})
+
+		It("splits traffic between weighted backends", func() {
+			snap.HTTPRoutes[0].Spec.Rules[0].BackendRefs = append(snap.HTTPRoutes[0].Spec.Rules[0].BackendRefs, v1beta1.HTTPBackendRef{
+				BackendRef: v1beta1.BackendRef{
+					BackendObjectReference: v1beta1.BackendObjectReference{Name: "canary", Port: portNumber(8080)},
+					Weight:                 int32Ptr(3),
+				},
+			})
+			snap.Services = append(snap.Services, service("default", "canary", 8080))
+
+			result := Translate(snap, DefaultControllerName, writeNamespace)
+
+			Expect(routesOf(result)[0].GetRouteAction()).To(MatchProto(&gloov1.RouteAction{
+				Destination: &gloov1.RouteAction_Multi{Multi: &gloov1.MultiDestination{
+					Destinations: []*gloov1.WeightedDestination{
+						{Destination: kubeDestination("default", "svc", 8080), Weight: 1},
+						{Destination: kubeDestination("default", "canary", 8080), Weight: 3},
+					},
+				}},
+			}))
+		})
+	})
+
+	Context("filters", func() {
+
+		filter := func(spec string) {
+			f := v1beta1.HTTPRouteFilter{}
+			ExpectWithOffset(1, yaml.Unmarshal([]byte(spec), &f)).To(Succeed())
+			snap.HTTPRoutes[0].Spec.Rules[0].Filters = append(snap.HTTPRoutes[0].Spec.Rules[0].Filters, f)
+		}
+
+		It("modifies request headers", func() {
+			filter(`
+type: RequestHeaderModifier
+requestHeaderModifier:
+  set:
+  - name: x-set
+    value: a
+  add:
+  - name: x-add
+    value: b
+  remove:
+  - x-remove
+`)
+
+			result := Translate(snap, DefaultControllerName, writeNamespace)
+
+			manipulation := routesOf(result)[0].GetOptions().GetHeaderManipulation()
+			Expect(manipulation.GetRequestHeadersToAdd()).To(HaveLen(2))
+			Expect(manipulation.GetRequestHeadersToAdd()[0].GetHeader().GetKey()).To(Equal("x-set"))
+			Expect(manipulation.GetRequestHeadersToAdd()[0].GetAppend()).To(MatchProto(&wrappers.BoolValue{Value: false}))
+			Expect(manipulation.GetRequestHeadersToAdd()[1].GetHeader().GetKey()).To(Equal("x-add"))
+			Expect(manipulation.GetRequestHeadersToAdd()[1].GetAppend()).To(MatchProto(&wrappers.BoolValue{Value: true}))
+			Expect(manipulation.GetRequestHeadersToRemove()).To(Equal([]string{"x-remove"}))
+		})
+
+		It("redirects requests", func() {
+			filter(`
+type: RequestRedirect
+requestRedirect:
+  scheme: https
+  hostname: example.com
+  statusCode: 301
+  path:
+    type: ReplacePrefixMatch
+    replacePrefixMatch: /bar
+`)
+
+			result := Translate(snap, DefaultControllerName, writeNamespace)
+
+			routes := routesOf(result)
+			Expect(routes).To(HaveLen(2))
+			Expect(routes[0].GetRedirectAction()).To(MatchProto(&gloov1.RedirectAction{
+				HostRedirect:         "example.com",
+				HttpsRedirect:        true,
+				ResponseCode:         gloov1.RedirectAction_MOVED_PERMANENTLY,
+				PathRewriteSpecifier: &gloov1.RedirectAction_PrefixRewrite{PrefixRewrite: "/bar"},
+			}))
+			Expect(routes[1].GetRedirectAction().GetPrefixRewrite()).To(Equal("/bar/"))
+		})
+
+		It("rewrites the prefix of paths", func() {
+			filter(`
+type: URLRewrite
+urlRewrite:
+  hostname: example.com
+  path:
+    type: ReplacePrefixMatch
+    replacePrefixMatch: /
+`)
+
+			result := Translate(snap, DefaultControllerName, writeNamespace)
+
+			routes := routesOf(result)
+			Expect(routes).To(HaveLen(2))
+			Expect(routes[0].GetOptions().GetHostRewrite()).To(Equal("example.com"))
+			Expect(routes[0].GetOptions().GetPrefixRewrite()).To(MatchProto(&wrappers.StringValue{Value: "/"}))
+			Expect(routes[1].GetOptions().GetPrefixRewrite()).To(MatchProto(&wrappers.StringValue{Value: "/"}))
+		})
+
+		It("does not accept routes with unsupported filters", func() {
+			filter(`
+type: RequestMirror
+requestMirror:
+  backendRef:
+    name: svc
+    port: 8080
+`)
+
+			result := Translate(snap, DefaultControllerName, writeNamespace)
+
+			accepted := condition(routeConditions(result, snap.HTTPRoutes[0]), v1beta1.RouteConditionAccepted)
+			Expect(accepted.Status).To(Equal(metav1.ConditionFalse))
+			Expect(accepted.Reason).To(Equal(v1beta1.RouteReasonUnsupportedValue))
+			Expect(result.Proxies[0].GetListeners()[0].GetHttpListener().GetVirtualHosts()).To(BeEmpty())
+		})
+	})
+
+	It("orders routes by precedence", func() {
+		older := httpRouteOf(`
+metadata:
+  name: older
+  namespace: default
+  creationTimestamp: "2020-01-01T00:00:00Z"
+spec:
+  parentRefs:
+  - name: gw
+  rules:
+  - matches:
+    - path:
+        type: PathPrefix
+        value: /
+    backendRefs:
+    - name: svc
+      port: 8080
+`)
+		snap.HTTPRoutes[0].CreationTimestamp = metav1.NewTime(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+		snap.HTTPRoutes[0].Spec.Rules = append(snap.HTTPRoutes[0].Spec.Rules,
+			v1beta1.HTTPRouteRule{
+				Matches: []v1beta1.HTTPRouteMatch{{
+					Path: &v1beta1.HTTPPathMatch{Type: pathMatchType(v1beta1.PathMatchExact), Value: stringPtr("/exact")},
+				}},
+			},
+			v1beta1.HTTPRouteRule{
+				Matches: []v1beta1.HTTPRouteMatch{{
+					Path:    &v1beta1.HTTPPathMatch{Type: pathMatchType(v1beta1.PathMatchPathPrefix), Value: stringPtr("/")},
+					Headers: []v1beta1.HTTPHeaderMatch{{Name: "x-canary", Value: "true"}},
+				}},
+			},
+		)
+		snap.HTTPRoutes = append(snap.HTTPRoutes, older)
+
+		result := Translate(snap, DefaultControllerName, writeNamespace)
+
+		var names []string
+		for _, route := range routesOf(result) {
+			names = append(names, route.GetName())
+		}
+		Expect(names).To(Equal([]string{
+			"default.route-rule-1-match-0",
+			"default.route-rule-0-match-0",
+			"default.route-rule-0-match-0",
+			"default.route-rule-2-match-0",
+			"default.older-rule-0-match-0",
+		}))
+	})
+
+	It("passes TLS connections through by SNI", func() {
+		snap.Gateways[0].Spec.Listeners[0] = v1beta1.Listener{
+			Name:     "tls",
+			Hostname: hostname("*.example.com"),
+			Port:     443,
+			Protocol: v1beta1.TLSProtocolType,
+			TLS:      &v1beta1.GatewayTLSConfig{Mode: tlsMode(v1beta1.TLSModePassthrough)},
+		}
+		route := tlsRouteOf(`
+metadata:
+  name: route
+  namespace: default
+spec:
+  parentRefs:
+  - name: gw
+  hostnames:
+  - foo.example.com
+  rules:
+  - backendRefs:
+    - name: svc
+      port: 8443
+`)
+		snap.HTTPRoutes = nil
+		snap.TLSRoutes = []*v1alpha2.TLSRoute{route}
+		snap.Services = []*corev1.Service{service("default", "svc", 8443)}
+
+		result := Translate(snap, DefaultControllerName, writeNamespace)
+
+		Expect(result.Proxies).To(HaveLen(1))
+		Expect(result.Proxies[0].GetListeners()[0].GetTcpListener()).To(MatchProto(&gloov1.TcpListener{
+			TcpHosts: []*gloov1.TcpHost{{
+				Name:      "default.route-foo.example.com",
+				SslConfig: &gloov1.SslConfig{SniDomains: []string{"foo.example.com"}},
+				Destination: &gloov1.TcpHost_TcpAction{
+					Destination: &gloov1.TcpHost_TcpAction_Single{Single: kubeDestination("default", "svc", 8443)},
+				},
+			}},
+		}))
+		parents := result.TLSRouteStatuses[route].Parents
+		Expect(parents).To(HaveLen(1))
+		Expect(condition(parents[0].Conditions, v1beta1.RouteConditionAccepted).Status).To(Equal(metav1.ConditionTrue))
+	})
+})
+
+type statusWrite struct {
+	resource  schema.GroupVersionResource
+	namespace string
+	name      string
+}
+
+type fakeStatusWriter struct {
+	writes []statusWrite
+}
+
+func (w *fakeStatusWriter) WriteStatus(_ context.Context, resource schema.GroupVersionResource, namespace, name string, _ interface{}) error {
+	w.writes = append(w.writes, statusWrite{resource: resource, namespace: namespace, name: name})
+	return nil
+}
+
+var _ = Describe("TranslatorSyncer", func() {
+
+	It("writes the proxies and the statuses that changed", func() {
+		ctx := context.Background()
+		proxyClient, err := gloov1.NewProxyClient(ctx, &factory.MemoryResourceClientFactory{Cache: memory.NewInMemoryResourceCache()})
+		Expect(err).NotTo(HaveOccurred())
+		statusWriter := &fakeStatusWriter{}
+		syncer := NewSyncer(writeNamespace, "", proxyClient, statusWriter)
+		snap := &Snapshot{
+			GatewayClasses: []*v1beta1.GatewayClass{gatewayClassOf(gatewayClass)},
+			Gateways:       []*v1beta1.Gateway{gatewayOf(httpGateway)},
+			HTTPRoutes:     []*v1beta1.HTTPRoute{httpRouteOf(httpRoute)},
+			Services:       []*corev1.Service{service("default", "svc", 8080)},
+		}
+
+		Expect(syncer.Sync(ctx, snap)).To(Succeed())
+
+		proxies, err := proxyClient.List(writeNamespace, clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(proxies).To(HaveLen(1))
+		Expect(proxies[0].GetMetadata().GetName()).To(Equal("default-gw"))
+		Expect(proxies[0].GetMetadata().GetLabels()).To(Equal(map[string]string{"created_by": "gateway-api"}))
+		Expect(statusWriter.writes).To(ConsistOf(
+			statusWrite{resource: v1beta1.GatewayClassesResource, name: "gloo"},
+			statusWrite{resource: v1beta1.GatewaysResource, namespace: "default", name: "gw"},
+			statusWrite{resource: v1beta1.HTTPRoutesResource, namespace: "default", name: "route"},
+		))
+
+		// the statuses written are current now
+		result := Translate(snap, DefaultControllerName, writeNamespace)
+		snap.GatewayClasses[0].Status = result.GatewayClassStatuses[snap.GatewayClasses[0]]
+		snap.Gateways[0].Status = result.GatewayStatuses[snap.Gateways[0]]
+		snap.HTTPRoutes[0].Status = result.HTTPRouteStatuses[snap.HTTPRoutes[0]]
+		statusWriter.writes = nil
+
+		Expect(syncer.Sync(ctx, snap)).To(Succeed())
+
+		Expect(statusWriter.writes).To(BeEmpty())
+	})
+})
+
+func hostname(h string) *v1beta1.Hostname {
+	hostname := v1beta1.Hostname(h)
+	return &hostname
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
+
+func portNumber(port v1beta1.PortNumber) *v1beta1.PortNumber {
+	return &port
+}
+
+func pathMatchType(pathType v1beta1.PathMatchType) *v1beta1.PathMatchType {
+	return &pathType
+}
+
+func tlsMode(mode v1beta1.TLSModeType) *v1beta1.TLSModeType {
+	return &mode
+}
+
+func fromNamespaces(from v1beta1.FromNamespaces) *v1beta1.FromNamespaces {
+	return &from
+}
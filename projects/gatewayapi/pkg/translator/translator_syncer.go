@@ -0,0 +1,109 @@
+package translator
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/go-multierror"
+	errors "github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gateway/pkg/utils"
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/api/v1alpha2"
+	"github.com/solo-io/gloo/projects/gatewayapi/pkg/api/v1beta1"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+)
+
+// Writes the status of Gateway API resources
+type StatusWriter interface {
+	WriteStatus(ctx context.Context, resource schema.GroupVersionResource, namespace, name string, status interface{}) error
+}
+
+type dynamicStatusWriter struct {
+	client dynamic.Interface
+}
+
+// Patches the status subresource of resources with the dynamic client
+func NewStatusWriter(client dynamic.Interface) StatusWriter {
+	return &dynamicStatusWriter{client: client}
+}
+
+func (w *dynamicStatusWriter) WriteStatus(ctx context.Context, resource schema.GroupVersionResource, namespace, name string, status interface{}) error {
+	patch, err := json.Marshal(map[string]interface{}{"status": status})
+	if err != nil {
+		return err
+	}
+	_, err = w.client.Resource(resource).Namespace(namespace).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{}, "status")
+	return err
+}
+
+type TranslatorSyncer struct {
+	writeNamespace  string
+	controllerName  string
+	proxyReconciler gloov1.ProxyReconciler
+	statusWriter    StatusWriter
+}
+
+func NewSyncer(writeNamespace, controllerName string, proxyClient gloov1.ProxyClient, statusWriter StatusWriter) *TranslatorSyncer {
+	if controllerName == "" {
+		controllerName = DefaultControllerName
+	}
+	return &TranslatorSyncer{
+		writeNamespace:  writeNamespace,
+		controllerName:  controllerName,
+		proxyReconciler: gloov1.NewProxyReconciler(proxyClient),
+		statusWriter:    statusWriter,
+	}
+}
+
+// Writes the proxies of the Gateways of the snapshot, and the statuses of the resources that changed
+func (s *TranslatorSyncer) Sync(ctx context.Context, snap *Snapshot) error {
+	ctx = contextutils.WithLogger(ctx, "gatewayApiTranslatorSyncer")
+	logger := contextutils.LoggerFrom(ctx)
+	logger.Infof("begin sync (%v gateways, %v http routes, %v tls routes)", len(snap.Gateways), len(snap.HTTPRoutes), len(snap.TLSRoutes))
+	defer logger.Infof("end sync")
+
+	result := Translate(snap, s.controllerName, s.writeNamespace)
+
+	labels := map[string]string{
+		"created_by": "gateway-api",
+	}
+	for _, proxy := range result.Proxies {
+		proxy.Metadata.Labels = labels
+	}
+	if err := s.proxyReconciler.Reconcile(s.writeNamespace, result.Proxies, utils.TransitionFunction, clients.ListOpts{
+		Ctx:      ctx,
+		Selector: labels,
+	}); err != nil {
+		return err
+	}
+
+	// the proxies are written even if some statuses cannot be
+	var errs *multierror.Error
+	writeStatus := func(resource schema.GroupVersionResource, meta metav1.ObjectMeta, current, status interface{}) {
+		if equality.Semantic.DeepEqual(current, status) {
+			return
+		}
+		if err := s.statusWriter.WriteStatus(ctx, resource, meta.Namespace, meta.Name, status); err != nil {
+			errs = multierror.Append(errs, errors.Wrapf(err, "writing status of %v %v.%v", resource.Resource, meta.Namespace, meta.Name))
+		}
+	}
+	for class, status := range result.GatewayClassStatuses {
+		writeStatus(v1beta1.GatewayClassesResource, class.ObjectMeta, class.Status, status)
+	}
+	for gateway, status := range result.GatewayStatuses {
+		writeStatus(v1beta1.GatewaysResource, gateway.ObjectMeta, gateway.Status, status)
+	}
+	for route, status := range result.HTTPRouteStatuses {
+		writeStatus(v1beta1.HTTPRoutesResource, route.ObjectMeta, route.Status, status)
+	}
+	for route, status := range result.TLSRouteStatuses {
+		writeStatus(v1alpha2.TLSRoutesResource, route.ObjectMeta, route.Status, status)
+	}
+	return errs.ErrorOrNil()
+}
@@ -55,6 +55,7 @@ func RootCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.
 
 	cmd.AddCommand(VirtualService(opts))
 	cmd.AddCommand(RouteTable(opts))
+	cmd.AddCommand(Route(opts))
 	cmd.AddCommand(Proxy(opts))
 	cmd.AddCommand(Upstream(opts))
 	cmd.AddCommand(UpstreamGroup(opts))
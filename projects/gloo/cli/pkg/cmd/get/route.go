@@ -0,0 +1,198 @@
+package get
+
+import (
+	"io"
+	"os"
+
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gateway/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/flagutils"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/prerun"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/printers"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+	skprotoutils "github.com/solo-io/solo-kit/pkg/utils/protoutils"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+var (
+	MissingVirtualServiceErr   = eris.New("please provide the name of a virtual service with --virtualservice")
+	VirtualServiceNotInFileErr = func(namespace, name, file string) error {
+		return eris.Errorf("virtual service %s.%s not found in %s", namespace, name, file)
+	}
+)
+
+func Route(opts *options.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     constants.ROUTE_COMMAND.Use,
+		Aliases: constants.ROUTE_COMMAND.Aliases,
+		Short:   "list the routes of a virtual service",
+		Long: "usage: glooctl get route --virtualservice NAME [--namespace=namespace] [--flatten] [-f FILE] [-o FORMAT]\n\n" +
+			"With --flatten, the routes delegated to route tables are merged into the routes of the virtual service " +
+			"the same way the gateway does, and the routes are listed in their final order along with the virtual " +
+			"service and route tables each of them originates from, followed by the warnings and errors of the virtual " +
+			"service and of each route table. Routes that are short-circuited by earlier routes are reported as " +
+			"warnings.\n\n" +
+			"If a file is provided, the virtual service, route tables, route options and virtual host options are read " +
+			"from it instead of the cluster.",
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Top.File == "" {
+				return prerun.CallParentPrerun(cmd, args)
+			}
+			// resources read from a file don't require access to the cluster, so skip the checks of the get command
+			if root := cmd.Root(); root.PersistentPreRunE != nil {
+				return root.PersistentPreRunE(cmd, args)
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Get.VirtualService == "" {
+				return MissingVirtualServiceErr
+			}
+			vs, snap, err := getRouteResources(opts)
+			if err != nil {
+				return err
+			}
+			if !opts.Get.Flatten {
+				return printers.PrintRoutes(vs.GetVirtualHost().GetRoutes(), opts.Top.Output)
+			}
+
+			reports := reporter.ResourceReports{}
+			vh, err := translator.FlattenVirtualService(vs, snap, reports)
+			if err != nil {
+				return err
+			}
+			return printers.PrintFlattenedRoutes(vh.GetRoutes(), reports, opts.Top.Output)
+		},
+	}
+	pflags := cmd.Flags()
+	pflags.StringVar(&opts.Get.VirtualService, "virtualservice", "", "the virtual service to list the routes of")
+	pflags.BoolVar(&opts.Get.Flatten, "flatten", false, "merge the routes of delegated route tables into the routes of the virtual service")
+	flagutils.AddFileFlag(pflags, &opts.Top.File)
+	return cmd
+}
+
+// returns the virtual service and a snapshot holding the resources its routes can delegate to
+func getRouteResources(opts *options.Options) (*v1.VirtualService, *v1.ApiSnapshot, error) {
+	if opts.Top.File != "" {
+		return getRouteResourcesFromFile(opts.Top.File, opts.Metadata.GetNamespace(), opts.Get.VirtualService)
+	}
+
+	ctx := opts.Top.Ctx
+	vs, err := helpers.MustNamespacedVirtualServiceClient(ctx, opts.Metadata.GetNamespace()).
+		Read(opts.Metadata.GetNamespace(), opts.Get.VirtualService, clients.ReadOpts{Ctx: ctx})
+	if err != nil {
+		return nil, nil, err
+	}
+	snap := &v1.ApiSnapshot{}
+	if !opts.Get.Flatten {
+		return vs, snap, nil
+	}
+
+	// route tables and options can be delegated to across namespaces
+	for _, ns := range helpers.MustGetNamespaces(ctx) {
+		routeTables, err := helpers.MustNamespacedRouteTableClient(ctx, ns).List(ns, clients.ListOpts{Ctx: ctx})
+		if err != nil {
+			return nil, nil, err
+		}
+		snap.RouteTables = append(snap.RouteTables, routeTables...)
+
+		routeOptions, err := helpers.MustNamespacedRouteOptionClient(ctx, ns).List(ns, clients.ListOpts{Ctx: ctx})
+		if err != nil {
+			return nil, nil, err
+		}
+		snap.RouteOptions = append(snap.RouteOptions, routeOptions...)
+
+		virtualHostOptions, err := helpers.MustNamespacedVirtualHostOptionClient(ctx, ns).List(ns, clients.ListOpts{Ctx: ctx})
+		if err != nil {
+			return nil, nil, err
+		}
+		snap.VirtualHostOptions = append(snap.VirtualHostOptions, virtualHostOptions...)
+	}
+	return vs, snap, nil
+}
+
+// reads the resources from a file of kubernetes yaml or json manifests, such as the output of `kubectl get -o yaml`.
+// resources without a namespace are placed in the given namespace.
+func getRouteResourcesFromFile(file, namespace, name string) (*v1.VirtualService, *v1.ApiSnapshot, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	var items []unstructured.Unstructured
+	decoder := yaml.NewYAMLOrJSONDecoder(f, 4096)
+	for {
+		var obj unstructured.Unstructured
+		if err := decoder.Decode(&obj.Object); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, nil, eris.Wrapf(err, "parsing %s", file)
+		}
+		if obj.Object == nil {
+			// empty document
+			continue
+		}
+		if obj.IsList() {
+			list, err := obj.ToList()
+			if err != nil {
+				return nil, nil, err
+			}
+			items = append(items, list.Items...)
+			continue
+		}
+		items = append(items, obj)
+	}
+
+	snap := &v1.ApiSnapshot{}
+	for _, item := range items {
+		if item.GetNamespace() == "" {
+			item.SetNamespace(namespace)
+		}
+		jsonBytes, err := item.MarshalJSON()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		switch item.GroupVersionKind() {
+		case v1.VirtualServiceGVK:
+			var vs v1.VirtualService
+			if err := skprotoutils.UnmarshalResource(jsonBytes, &vs); err != nil {
+				return nil, nil, err
+			}
+			snap.VirtualServices = append(snap.VirtualServices, &vs)
+		case v1.RouteTableGVK:
+			var rt v1.RouteTable
+			if err := skprotoutils.UnmarshalResource(jsonBytes, &rt); err != nil {
+				return nil, nil, err
+			}
+			snap.RouteTables = append(snap.RouteTables, &rt)
+		case v1.RouteOptionGVK:
+			var routeOption v1.RouteOption
+			if err := skprotoutils.UnmarshalResource(jsonBytes, &routeOption); err != nil {
+				return nil, nil, err
+			}
+			snap.RouteOptions = append(snap.RouteOptions, &routeOption)
+		case v1.VirtualHostOptionGVK:
+			var virtualHostOption v1.VirtualHostOption
+			if err := skprotoutils.UnmarshalResource(jsonBytes, &virtualHostOption); err != nil {
+				return nil, nil, err
+			}
+			snap.VirtualHostOptions = append(snap.VirtualHostOptions, &virtualHostOption)
+		}
+	}
+
+	vs, err := snap.VirtualServices.Find(namespace, name)
+	if err != nil {
+		return nil, nil, VirtualServiceNotInFileErr(namespace, name, file)
+	}
+	return vs, snap, nil
+}
@@ -0,0 +1,243 @@
+package get_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/get"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/testutils"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Get Route", func() {
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+	)
+
+	BeforeEach(func() {
+		helpers.UseMemoryClients()
+		ctx, cancel = context.WithCancel(context.Background())
+		_, err := helpers.MustKubeClient().CoreV1().Namespaces().Create(ctx, &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: defaults.GlooSystem,
+			},
+		}, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		helpers.UseDefaultClients()
+		cancel()
+	})
+
+	// the first route of the virtual service short-circuits the route delegated to the route tables
+	const expectedFlattenedRoutes = `+----+------+---------------+------------------------------+--------------------------------+
+| ID | NAME |   MATCHERS    |            ACTION            |             SOURCE             |
++----+------+---------------+------------------------------+--------------------------------+
+| 1  |      | /api/v        | 200 (direct response)        | gloo-system.vs (virtual        |
+|    |      |               |                              | service)                       |
+| 2  |      | /api/v1/users | gloo-system.users (upstream) | gloo-system.vs (virtual        |
+|    |      |               |                              | service) -> gloo-system.rt-a   |
+|    |      |               |                              | (route table) ->               |
+|    |      |               |                              | gloo-system.rt-b (route table) |
++----+------+---------------+------------------------------+--------------------------------+
+gloo-system.vs (virtual service):
+Warnings:
+- virtual host [gloo-system.vs] has unordered prefix routes, earlier prefix [/api/v] short-circuited later route`
+
+	// the second route of rt-b does not begin with the prefix of the route delegating to it
+	const expectedRouteTableReport = `gloo-system.rt-b (route table):
+Errors:
+- required prefix: /api/v1, prefix: /users: invalid route: route table matchers must begin with the prefix of their parent route's matcher`
+
+	Context("resources in the cluster", func() {
+
+		BeforeEach(func() {
+			vs := &gatewayv1.VirtualService{
+				Metadata: &core.Metadata{Name: "vs", Namespace: defaults.GlooSystem},
+				VirtualHost: &gatewayv1.VirtualHost{
+					Domains: []string{"*"},
+					Routes: []*gatewayv1.Route{
+						{
+							Matchers: []*matchers.Matcher{{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/api/v"}}},
+							Action: &gatewayv1.Route_DirectResponseAction{
+								DirectResponseAction: &gloov1.DirectResponseAction{Status: 200},
+							},
+						},
+						{
+							Matchers: []*matchers.Matcher{{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/api"}}},
+							Action: &gatewayv1.Route_DelegateAction{
+								DelegateAction: &gatewayv1.DelegateAction{
+									DelegationType: &gatewayv1.DelegateAction_Selector{
+										Selector: &gatewayv1.RouteTableSelector{
+											Labels: map[string]string{"team": "api"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			rtA := &gatewayv1.RouteTable{
+				Metadata: &core.Metadata{Name: "rt-a", Namespace: defaults.GlooSystem, Labels: map[string]string{"team": "api"}},
+				Routes: []*gatewayv1.Route{{
+					Matchers: []*matchers.Matcher{{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/api/v1"}}},
+					Action: &gatewayv1.Route_DelegateAction{
+						DelegateAction: &gatewayv1.DelegateAction{
+							DelegationType: &gatewayv1.DelegateAction_Ref{
+								Ref: &core.ResourceRef{Name: "rt-b", Namespace: defaults.GlooSystem},
+							},
+						},
+					},
+				}},
+			}
+			rtB := &gatewayv1.RouteTable{
+				Metadata: &core.Metadata{Name: "rt-b", Namespace: defaults.GlooSystem},
+				Routes: []*gatewayv1.Route{
+					{
+						Matchers: []*matchers.Matcher{{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/api/v1/users"}}},
+						Action: &gatewayv1.Route_RouteAction{
+							RouteAction: &gloov1.RouteAction{
+								Destination: &gloov1.RouteAction_Single{
+									Single: &gloov1.Destination{
+										DestinationType: &gloov1.Destination_Upstream{
+											Upstream: &core.ResourceRef{Name: "users", Namespace: defaults.GlooSystem},
+										},
+									},
+								},
+							},
+						},
+					},
+					{
+						Matchers: []*matchers.Matcher{{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/users"}}},
+						Action: &gatewayv1.Route_DirectResponseAction{
+							DirectResponseAction: &gloov1.DirectResponseAction{Status: 404},
+						},
+					},
+				},
+			}
+
+			_, err := helpers.MustVirtualServiceClient(ctx).Write(vs, clients.WriteOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			for _, rt := range []*gatewayv1.RouteTable{rtA, rtB} {
+				_, err = helpers.MustRouteTableClient(ctx).Write(rt, clients.WriteOpts{})
+				Expect(err).NotTo(HaveOccurred())
+			}
+		})
+
+		It("requires a virtual service", func() {
+			_, err := testutils.GlooctlOut("get route")
+			Expect(err).To(MatchError(get.MissingVirtualServiceErr))
+		})
+
+		It("gets the routes of the virtual service", func() {
+			out, err := testutils.GlooctlOut("get route --virtualservice vs")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(ContainSubstring("| 1  |      | /api/v   | Path Prefix | *     |         | direct response action |"))
+			Expect(out).NotTo(ContainSubstring("/api/v1/users"))
+		})
+
+		It("flattens the routes of the virtual service", func() {
+			out, err := testutils.GlooctlOut("get route --virtualservice vs --flatten")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(ContainSubstring(expectedFlattenedRoutes))
+			Expect(out).To(ContainSubstring(expectedRouteTableReport))
+		})
+	})
+
+	Context("resources in a file", func() {
+		var file string
+
+		BeforeEach(func() {
+			f, err := ioutil.TempFile("", "routes-*.yaml")
+			Expect(err).NotTo(HaveOccurred())
+			defer f.Close()
+			_, err = f.WriteString(`apiVersion: gateway.solo.io/v1
+kind: VirtualService
+metadata:
+  name: vs
+spec:
+  virtualHost:
+    domains: ['*']
+    routes:
+    - matchers:
+      - prefix: /api/v
+      directResponseAction:
+        status: 200
+    - matchers:
+      - prefix: /api
+      delegateAction:
+        selector:
+          labels:
+            team: api
+---
+apiVersion: v1
+kind: List
+items:
+- apiVersion: gateway.solo.io/v1
+  kind: RouteTable
+  metadata:
+    name: rt-a
+    labels:
+      team: api
+  spec:
+    routes:
+    - matchers:
+      - prefix: /api/v1
+      delegateAction:
+        ref:
+          name: rt-b
+          namespace: gloo-system
+- apiVersion: gateway.solo.io/v1
+  kind: RouteTable
+  metadata:
+    name: rt-b
+    namespace: gloo-system
+  spec:
+    routes:
+    - matchers:
+      - prefix: /api/v1/users
+      routeAction:
+        single:
+          upstream:
+            name: users
+            namespace: gloo-system
+    - matchers:
+      - prefix: /users
+      directResponseAction:
+        status: 404
+`)
+			Expect(err).NotTo(HaveOccurred())
+			file = f.Name()
+		})
+
+		AfterEach(func() {
+			os.Remove(file)
+		})
+
+		It("flattens the routes of the virtual service", func() {
+			out, err := testutils.GlooctlOut("get route --virtualservice vs --flatten -f " + file)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(ContainSubstring(expectedFlattenedRoutes))
+			Expect(out).To(ContainSubstring(expectedRouteTableReport))
+		})
+
+		It("errors when the virtual service is not in the file", func() {
+			_, err := testutils.GlooctlOut("get route --virtualservice other --flatten -f " + file)
+			Expect(err).To(MatchError(get.VirtualServiceNotInFileErr(defaults.GlooSystem, "other", file)))
+		})
+	})
+})
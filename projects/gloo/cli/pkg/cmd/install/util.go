@@ -47,6 +47,7 @@ func init() {
 		"ratelimitconfigs.ratelimit.solo.io",
 		"virtualhostoptions.gateway.solo.io",
 		"routeoptions.gateway.solo.io",
+		"tcproutes.gateway.solo.io",
 		// gloo-fed CRDs:
 		"glooinstances.fed.solo.io",
 		"failoverschemes.fed.solo.io",
@@ -101,7 +101,9 @@ type Upgrade struct {
 }
 
 type Get struct {
-	Selector InputMapStringString
+	Selector       InputMapStringString
+	VirtualService string // the virtual service to list the routes of
+	Flatten        bool   // merge the routes of delegated route tables into the routes of the virtual service
 }
 
 type Delete struct {
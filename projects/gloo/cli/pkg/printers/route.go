@@ -5,12 +5,17 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 
 	pbgostruct "github.com/golang/protobuf/ptypes/struct"
+	"github.com/hashicorp/go-multierror"
 	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gateway/pkg/translator"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 
 	"github.com/olekukonko/tablewriter"
 	"github.com/solo-io/go-utils/cliutils"
@@ -38,6 +43,133 @@ func PrintRoutes(routes []*v1.Route, outputType OutputType) error {
 		}, os.Stdout)
 }
 
+// PrintFlattenedRoutes prints the routes of a virtual service after its delegated routes have been merged into them,
+// followed by the warnings and errors reported on the virtual service and its route tables while doing so
+func PrintFlattenedRoutes(routes []*gloov1.Route, reports reporter.ResourceReports, outputType OutputType) error {
+	err := cliutils.PrintList(outputType.String(), "", routes,
+		func(data interface{}, w io.Writer) error {
+			FlattenedRouteTable(data.([]*gloov1.Route), w)
+			return nil
+		}, os.Stdout)
+	if err != nil {
+		return err
+	}
+	printRouteReports(reports, os.Stderr)
+	return nil
+}
+
+// FlattenedRouteTable prints the routes in their final order, along with the resources each route originates from
+func FlattenedRouteTable(list []*gloov1.Route, w io.Writer) {
+	table := tablewriter.NewWriter(w)
+	table.SetHeader([]string{"Id", "Name", "Matchers", "Action", "Source"})
+
+	for i, r := range list {
+		matcher, _, _, _ := Matchers(r.GetMatchers())
+		table.Append([]string{strconv.Itoa(i + 1), r.GetName(), matcher, glooRouteActionString(r), routeSourceChain(r)})
+	}
+
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.Render()
+}
+
+// the sources of a route are appended as the route is passed up the delegation tree, so they are listed in reverse
+func routeSourceChain(r *gloov1.Route) string {
+	meta, err := translator.GetSourceMeta(r)
+	if err != nil {
+		return "unknown"
+	}
+	var sources []string
+	for i := len(meta.Sources) - 1; i >= 0; i-- {
+		source := meta.Sources[i]
+		sources = append(sources, fmt.Sprintf("%s (%s)", source.ResourceRef.Key(), resourceKindName(source.ResourceKind)))
+	}
+	return strings.Join(sources, " -> ")
+}
+
+func resourceKindName(kind string) string {
+	switch kind {
+	case resources.Kind(&v1.VirtualService{}):
+		return "virtual service"
+	case resources.Kind(&v1.RouteTable{}):
+		return "route table"
+	}
+	return kind
+}
+
+func glooRouteActionString(r *gloov1.Route) string {
+	switch action := r.GetAction().(type) {
+	case *gloov1.Route_RouteAction:
+		switch dest := action.RouteAction.GetDestination().(type) {
+		case *gloov1.RouteAction_Multi:
+			return fmt.Sprintf("%v destinations", len(dest.Multi.GetDestinations()))
+		case *gloov1.RouteAction_Single:
+			switch destType := dest.Single.GetDestinationType().(type) {
+			case *gloov1.Destination_Upstream:
+				return fmt.Sprintf("%s (upstream)", destType.Upstream.Key())
+			case *gloov1.Destination_Kube:
+				return fmt.Sprintf("%s (service)", destType.Kube.GetRef().Key())
+			case *gloov1.Destination_Consul:
+				return fmt.Sprintf("%s (consul service)", destType.Consul.GetServiceName())
+			}
+		case *gloov1.RouteAction_UpstreamGroup:
+			return fmt.Sprintf("%s (upstream group)", dest.UpstreamGroup.Key())
+		case *gloov1.RouteAction_ClusterHeader:
+			return fmt.Sprintf("%s (cluster header)", dest.ClusterHeader)
+		}
+		return "route action"
+	case *gloov1.Route_DirectResponseAction:
+		return fmt.Sprintf("%d (direct response)", action.DirectResponseAction.GetStatus())
+	case *gloov1.Route_RedirectAction:
+		return fmt.Sprintf("%s (redirect)", action.RedirectAction.GetHostRedirect())
+	}
+	return "unknown"
+}
+
+// the reports of the virtual service are printed first, followed by those of the route tables in the delegation chain
+func printRouteReports(reports reporter.ResourceReports, w io.Writer) {
+	var reported []resources.InputResource
+	for resource, report := range reports {
+		if len(report.Warnings) > 0 || report.Errors != nil {
+			reported = append(reported, resource)
+		}
+	}
+	vsKind := resources.Kind(&v1.VirtualService{})
+	sort.SliceStable(reported, func(i, j int) bool {
+		kindI, kindJ := resources.Kind(reported[i]), resources.Kind(reported[j])
+		if (kindI == vsKind) != (kindJ == vsKind) {
+			return kindI == vsKind
+		}
+		if kindI != kindJ {
+			return kindI < kindJ
+		}
+		return reported[i].GetMetadata().Ref().Key() < reported[j].GetMetadata().Ref().Key()
+	})
+
+	for _, resource := range reported {
+		fmt.Fprintf(w, "%s (%s):\n", resource.GetMetadata().Ref().Key(), resourceKindName(resources.Kind(resource)))
+		printRouteReport(reports[resource], w)
+	}
+}
+
+func printRouteReport(report reporter.Report, w io.Writer) {
+	if len(report.Warnings) > 0 {
+		fmt.Fprintln(w, "Warnings:")
+		for _, warning := range report.Warnings {
+			fmt.Fprintf(w, "- %s\n", warning)
+		}
+	}
+	if report.Errors != nil {
+		fmt.Fprintln(w, "Errors:")
+		errs := []error{report.Errors}
+		if multiErr, ok := report.Errors.(*multierror.Error); ok {
+			errs = multiErr.WrappedErrors()
+		}
+		for _, err := range errs {
+			fmt.Fprintf(w, "- %s\n", err.Error())
+		}
+	}
+}
+
 // Destination represents a single destination of a route
 // It can be either an upstream or upstream-function pair
 
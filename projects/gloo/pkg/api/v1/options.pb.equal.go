@@ -348,6 +348,40 @@ func (m *TcpListenerOptions) Equal(that interface{}) bool {
 	return true
 }
 
+// Equal function
+func (m *UdpListenerOptions) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*UdpListenerOptions)
+	if !ok {
+		that2, ok := that.(UdpListenerOptions)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetUdpProxySettings()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetUdpProxySettings()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetUdpProxySettings(), target.GetUdpProxySettings()) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equal function
 func (m *VirtualHostOptions) Equal(that interface{}) bool {
 	if that == nil {
@@ -81,6 +81,80 @@ func (m *UpstreamSpec) Equal(that interface{}) bool {
 		return false
 	}
 
+	if h, ok := interface{}(m.GetDiscoveryOptions()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetDiscoveryOptions()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetDiscoveryOptions(), target.GetDiscoveryOptions()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *LambdaDiscoveryOptions) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*LambdaDiscoveryOptions)
+	if !ok {
+		that2, ok := that.(LambdaDiscoveryOptions)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetFunctionNamePrefixes()) != len(target.GetFunctionNamePrefixes()) {
+		return false
+	}
+	for idx, v := range m.GetFunctionNamePrefixes() {
+
+		if strings.Compare(v, target.GetFunctionNamePrefixes()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if len(m.GetTags()) != len(target.GetTags()) {
+		return false
+	}
+	for k, v := range m.GetTags() {
+
+		if strings.Compare(v, target.GetTags()[k]) != 0 {
+			return false
+		}
+
+	}
+
+	if h, ok := interface{}(m.GetDiscoverVersions()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetDiscoverVersions()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetDiscoverVersions(), target.GetDiscoverVersions()) {
+			return false
+		}
+	}
+
+	if m.GetDiscoverAliases() != target.GetDiscoverAliases() {
+		return false
+	}
+
+	if m.GetMaxFunctions() != target.GetMaxFunctions() {
+		return false
+	}
+
 	return true
 }
 
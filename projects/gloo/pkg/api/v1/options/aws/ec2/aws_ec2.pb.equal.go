@@ -89,6 +89,25 @@ func (m *UpstreamSpec) Equal(that interface{}) bool {
 		return false
 	}
 
+	if len(m.GetAutoScalingGroups()) != len(target.GetAutoScalingGroups()) {
+		return false
+	}
+	for idx, v := range m.GetAutoScalingGroups() {
+
+		if strings.Compare(v, target.GetAutoScalingGroups()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if m.GetHealthCheckInstances() != target.GetHealthCheckInstances() {
+		return false
+	}
+
+	if strings.Compare(m.GetPortTag(), target.GetPortTag()) != 0 {
+		return false
+	}
+
 	return true
 }
 
@@ -119,5 +119,13 @@ func (m *UpstreamSpec) Equal(that interface{}) bool {
 
 	}
 
+	if strings.Compare(m.GetNamespace(), target.GetNamespace()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetPartition(), target.GetPartition()) != 0 {
+		return false
+	}
+
 	return true
 }
@@ -107,5 +107,13 @@ func (m *UpstreamSpec) Hash(hasher hash.Hash64) (uint64, error) {
 
 	}
 
+	if _, err = hasher.Write([]byte(m.GetNamespace())); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte(m.GetPartition())); err != nil {
+		return 0, err
+	}
+
 	return hasher.Sum64(), nil
 }
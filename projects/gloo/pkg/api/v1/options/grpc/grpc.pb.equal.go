@@ -113,6 +113,10 @@ func (m *DestinationSpec) Equal(that interface{}) bool {
 		}
 	}
 
+	if m.GetUseHttpAnnotation() != target.GetUseHttpAnnotation() {
+		return false
+	}
+
 	return true
 }
 
@@ -50,5 +50,80 @@ func (m *HealthCheck) Equal(that interface{}) bool {
 		return false
 	}
 
+	if h, ok := interface{}(m.GetPassThroughMode()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetPassThroughMode()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetPassThroughMode(), target.GetPassThroughMode()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetCacheTime()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetCacheTime()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetCacheTime(), target.GetCacheTime()) {
+			return false
+		}
+	}
+
+	if len(m.GetUpstreamMinHealthyPercentages()) != len(target.GetUpstreamMinHealthyPercentages()) {
+		return false
+	}
+	for idx, v := range m.GetUpstreamMinHealthyPercentages() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetUpstreamMinHealthyPercentages()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetUpstreamMinHealthyPercentages()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	return true
+}
+
+// Equal function
+func (m *HealthCheck_UpstreamMinHealthyPercentage) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*HealthCheck_UpstreamMinHealthyPercentage)
+	if !ok {
+		that2, ok := that.(HealthCheck_UpstreamMinHealthyPercentage)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetUpstream()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetUpstream()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetUpstream(), target.GetUpstream()) {
+			return false
+		}
+	}
+
+	if m.GetPercentage() != target.GetPercentage() {
+		return false
+	}
+
 	return true
 }
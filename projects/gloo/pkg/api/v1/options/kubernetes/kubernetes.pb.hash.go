@@ -114,5 +114,25 @@ func (m *UpstreamSpec) Hash(hasher hash.Hash64) (uint64, error) {
 		}
 	}
 
+	if h, ok := interface{}(m.GetSynthesizedHealthCheck()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("SynthesizedHealthCheck")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetSynthesizedHealthCheck(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("SynthesizedHealthCheck")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
 	return hasher.Sum64(), nil
 }
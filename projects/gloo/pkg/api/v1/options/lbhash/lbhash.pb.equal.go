@@ -108,6 +108,44 @@ func (m *Cookie) Equal(that interface{}) bool {
 	return true
 }
 
+// Equal function
+func (m *HeaderRegex) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*HeaderRegex)
+	if !ok {
+		that2, ok := that.(HeaderRegex)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetHeaderName(), target.GetHeaderName()) != 0 {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetRegexRewrite()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetRegexRewrite()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetRegexRewrite(), target.GetRegexRewrite()) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equal function
 func (m *HashPolicy) Equal(that interface{}) bool {
 	if that == nil {
@@ -168,6 +206,39 @@ func (m *HashPolicy) Equal(that interface{}) bool {
 			return false
 		}
 
+	case *HashPolicy_QueryParameter:
+		if _, ok := target.KeyType.(*HashPolicy_QueryParameter); !ok {
+			return false
+		}
+
+		if strings.Compare(m.GetQueryParameter(), target.GetQueryParameter()) != 0 {
+			return false
+		}
+
+	case *HashPolicy_HeaderRegex:
+		if _, ok := target.KeyType.(*HashPolicy_HeaderRegex); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetHeaderRegex()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetHeaderRegex()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetHeaderRegex(), target.GetHeaderRegex()) {
+				return false
+			}
+		}
+
+	case *HashPolicy_FilterState:
+		if _, ok := target.KeyType.(*HashPolicy_FilterState); !ok {
+			return false
+		}
+
+		if strings.Compare(m.GetFilterState(), target.GetFilterState()) != 0 {
+			return false
+		}
+
 	default:
 		// m is nil but target is not nil
 		if m.KeyType != target.KeyType {
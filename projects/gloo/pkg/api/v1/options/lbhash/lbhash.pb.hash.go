@@ -109,6 +109,46 @@ func (m *Cookie) Hash(hasher hash.Hash64) (uint64, error) {
 	return hasher.Sum64(), nil
 }
 
+// Hash function
+func (m *HeaderRegex) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("lbhash.options.gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/lbhash.HeaderRegex")); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte(m.GetHeaderName())); err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetRegexRewrite()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("RegexRewrite")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetRegexRewrite(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("RegexRewrite")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return hasher.Sum64(), nil
+}
+
 // Hash function
 func (m *HashPolicy) Hash(hasher hash.Hash64) (uint64, error) {
 	if m == nil {
@@ -164,6 +204,40 @@ func (m *HashPolicy) Hash(hasher hash.Hash64) (uint64, error) {
 			return 0, err
 		}
 
+	case *HashPolicy_QueryParameter:
+
+		if _, err = hasher.Write([]byte(m.GetQueryParameter())); err != nil {
+			return 0, err
+		}
+
+	case *HashPolicy_HeaderRegex:
+
+		if h, ok := interface{}(m.GetHeaderRegex()).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("HeaderRegex")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(m.GetHeaderRegex(), nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("HeaderRegex")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
+	case *HashPolicy_FilterState:
+
+		if _, err = hasher.Write([]byte(m.GetFilterState())); err != nil {
+			return 0, err
+		}
+
 	}
 
 	return hasher.Sum64(), nil
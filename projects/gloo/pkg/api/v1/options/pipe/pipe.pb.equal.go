@@ -60,5 +60,27 @@ func (m *UpstreamSpec) Equal(that interface{}) bool {
 		}
 	}
 
+	if m.GetAbstract() != target.GetAbstract() {
+		return false
+	}
+
+	if m.GetMode() != target.GetMode() {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetSynthesizedHealthCheck()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetSynthesizedHealthCheck()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetSynthesizedHealthCheck(), target.GetSynthesizedHealthCheck()) {
+			return false
+		}
+	}
+
+	if strings.Compare(m.GetHealthCheckPath(), target.GetHealthCheckPath()) != 0 {
+		return false
+	}
+
 	return true
 }
@@ -63,6 +63,36 @@ func (m *ProtocolUpgradeConfig) Equal(that interface{}) bool {
 			}
 		}
 
+	case *ProtocolUpgradeConfig_Named:
+		if _, ok := target.UpgradeType.(*ProtocolUpgradeConfig_Named); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetNamed()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetNamed()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetNamed(), target.GetNamed()) {
+				return false
+			}
+		}
+
+	case *ProtocolUpgradeConfig_Connect:
+		if _, ok := target.UpgradeType.(*ProtocolUpgradeConfig_Connect); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetConnect()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetConnect()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetConnect(), target.GetConnect()) {
+				return false
+			}
+		}
+
 	default:
 		// m is nil but target is not nil
 		if m.UpgradeType != target.UpgradeType {
@@ -106,3 +136,113 @@ func (m *ProtocolUpgradeConfig_ProtocolUpgradeSpec) Equal(that interface{}) bool
 
 	return true
 }
+
+// Equal function
+func (m *ProtocolUpgradeConfig_NamedProtocolUpgradeSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ProtocolUpgradeConfig_NamedProtocolUpgradeSpec)
+	if !ok {
+		that2, ok := that.(ProtocolUpgradeConfig_NamedProtocolUpgradeSpec)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetName(), target.GetName()) != 0 {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetEnabled()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetEnabled()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetEnabled(), target.GetEnabled()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *ProtocolUpgradeConfig_ConnectSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ProtocolUpgradeConfig_ConnectSpec)
+	if !ok {
+		that2, ok := that.(ProtocolUpgradeConfig_ConnectSpec)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetEnabled()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetEnabled()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetEnabled(), target.GetEnabled()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetTerminate()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetTerminate()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetTerminate(), target.GetTerminate()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *ProtocolUpgradeConfig_ConnectSpec_Termination) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ProtocolUpgradeConfig_ConnectSpec_Termination)
+	if !ok {
+		that2, ok := that.(ProtocolUpgradeConfig_ConnectSpec_Termination)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetAllowPost() != target.GetAllowPost() {
+		return false
+	}
+
+	return true
+}
@@ -35,6 +35,8 @@ type ProtocolUpgradeConfig struct {
 
 	// Types that are assignable to UpgradeType:
 	//	*ProtocolUpgradeConfig_Websocket
+	//	*ProtocolUpgradeConfig_Named
+	//	*ProtocolUpgradeConfig_Connect
 	UpgradeType isProtocolUpgradeConfig_UpgradeType `protobuf_oneof:"upgrade_type"`
 }
 
@@ -84,6 +86,20 @@ func (x *ProtocolUpgradeConfig) GetWebsocket() *ProtocolUpgradeConfig_ProtocolUp
 	return nil
 }
 
+func (x *ProtocolUpgradeConfig) GetNamed() *ProtocolUpgradeConfig_NamedProtocolUpgradeSpec {
+	if x, ok := x.GetUpgradeType().(*ProtocolUpgradeConfig_Named); ok {
+		return x.Named
+	}
+	return nil
+}
+
+func (x *ProtocolUpgradeConfig) GetConnect() *ProtocolUpgradeConfig_ConnectSpec {
+	if x, ok := x.GetUpgradeType().(*ProtocolUpgradeConfig_Connect); ok {
+		return x.Connect
+	}
+	return nil
+}
+
 type isProtocolUpgradeConfig_UpgradeType interface {
 	isProtocolUpgradeConfig_UpgradeType()
 }
@@ -93,8 +109,23 @@ type ProtocolUpgradeConfig_Websocket struct {
 	Websocket *ProtocolUpgradeConfig_ProtocolUpgradeSpec `protobuf:"bytes,1,opt,name=websocket,proto3,oneof"`
 }
 
+type ProtocolUpgradeConfig_Named struct {
+	// Specification for upgrade requests of any other named protocol, e.g. `spdy/3.1` or `h2c`.
+	Named *ProtocolUpgradeConfig_NamedProtocolUpgradeSpec `protobuf:"bytes,2,opt,name=named,proto3,oneof"`
+}
+
+type ProtocolUpgradeConfig_Connect struct {
+	// Specification for HTTP CONNECT requests.
+	// When set on a route, the route matches CONNECT requests only, regardless of its path matchers.
+	Connect *ProtocolUpgradeConfig_ConnectSpec `protobuf:"bytes,3,opt,name=connect,proto3,oneof"`
+}
+
 func (*ProtocolUpgradeConfig_Websocket) isProtocolUpgradeConfig_UpgradeType() {}
 
+func (*ProtocolUpgradeConfig_Named) isProtocolUpgradeConfig_UpgradeType() {}
+
+func (*ProtocolUpgradeConfig_Connect) isProtocolUpgradeConfig_UpgradeType() {}
+
 type ProtocolUpgradeConfig_ProtocolUpgradeSpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -143,6 +174,172 @@ func (x *ProtocolUpgradeConfig_ProtocolUpgradeSpec) GetEnabled() *wrappers.BoolV
 	return nil
 }
 
+type ProtocolUpgradeConfig_NamedProtocolUpgradeSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required. The case-insensitive name of the upgrade, as sent by clients in the `Upgrade` header,
+	// e.g. `spdy/3.1` or `h2c`.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether the upgrade should be enabled. If left unset, Envoy will enable the protocol upgrade.
+	Enabled *wrappers.BoolValue `protobuf:"bytes,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *ProtocolUpgradeConfig_NamedProtocolUpgradeSpec) Reset() {
+	*x = ProtocolUpgradeConfig_NamedProtocolUpgradeSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_protocol_upgrade_protocol_upgrade_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtocolUpgradeConfig_NamedProtocolUpgradeSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtocolUpgradeConfig_NamedProtocolUpgradeSpec) ProtoMessage() {}
+
+func (x *ProtocolUpgradeConfig_NamedProtocolUpgradeSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_protocol_upgrade_protocol_upgrade_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtocolUpgradeConfig_NamedProtocolUpgradeSpec.ProtoReflect.Descriptor instead.
+func (*ProtocolUpgradeConfig_NamedProtocolUpgradeSpec) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_protocol_upgrade_protocol_upgrade_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *ProtocolUpgradeConfig_NamedProtocolUpgradeSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProtocolUpgradeConfig_NamedProtocolUpgradeSpec) GetEnabled() *wrappers.BoolValue {
+	if x != nil {
+		return x.Enabled
+	}
+	return nil
+}
+
+type ProtocolUpgradeConfig_ConnectSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether CONNECT requests should be enabled. If left unset, Envoy will enable CONNECT requests.
+	Enabled *wrappers.BoolValue `protobuf:"bytes,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// If set, Envoy terminates CONNECT requests and forwards their payload to the upstream as raw TCP.
+	// If left unset, CONNECT requests are proxied to the upstream as-is.
+	// Termination can only be configured on routes.
+	Terminate *ProtocolUpgradeConfig_ConnectSpec_Termination `protobuf:"bytes,2,opt,name=terminate,proto3" json:"terminate,omitempty"`
+}
+
+func (x *ProtocolUpgradeConfig_ConnectSpec) Reset() {
+	*x = ProtocolUpgradeConfig_ConnectSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_protocol_upgrade_protocol_upgrade_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtocolUpgradeConfig_ConnectSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtocolUpgradeConfig_ConnectSpec) ProtoMessage() {}
+
+func (x *ProtocolUpgradeConfig_ConnectSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_protocol_upgrade_protocol_upgrade_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtocolUpgradeConfig_ConnectSpec.ProtoReflect.Descriptor instead.
+func (*ProtocolUpgradeConfig_ConnectSpec) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_protocol_upgrade_protocol_upgrade_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *ProtocolUpgradeConfig_ConnectSpec) GetEnabled() *wrappers.BoolValue {
+	if x != nil {
+		return x.Enabled
+	}
+	return nil
+}
+
+func (x *ProtocolUpgradeConfig_ConnectSpec) GetTerminate() *ProtocolUpgradeConfig_ConnectSpec_Termination {
+	if x != nil {
+		return x.Terminate
+	}
+	return nil
+}
+
+// Configuration for terminating CONNECT requests in Envoy.
+type ProtocolUpgradeConfig_ConnectSpec_Termination struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If set, the route will also allow forwarding POST payload as raw TCP.
+	AllowPost bool `protobuf:"varint,1,opt,name=allow_post,json=allowPost,proto3" json:"allow_post,omitempty"`
+}
+
+func (x *ProtocolUpgradeConfig_ConnectSpec_Termination) Reset() {
+	*x = ProtocolUpgradeConfig_ConnectSpec_Termination{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_protocol_upgrade_protocol_upgrade_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtocolUpgradeConfig_ConnectSpec_Termination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtocolUpgradeConfig_ConnectSpec_Termination) ProtoMessage() {}
+
+func (x *ProtocolUpgradeConfig_ConnectSpec_Termination) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_protocol_upgrade_protocol_upgrade_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtocolUpgradeConfig_ConnectSpec_Termination.ProtoReflect.Descriptor instead.
+func (*ProtocolUpgradeConfig_ConnectSpec_Termination) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_protocol_upgrade_protocol_upgrade_proto_rawDescGZIP(), []int{0, 2, 0}
+}
+
+func (x *ProtocolUpgradeConfig_ConnectSpec_Termination) GetAllowPost() bool {
+	if x != nil {
+		return x.AllowPost
+	}
+	return false
+}
+
 var File_github_com_solo_io_gloo_projects_gloo_api_v1_options_protocol_upgrade_protocol_upgrade_proto protoreflect.FileDescriptor
 
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_protocol_upgrade_protocol_upgrade_proto_rawDesc = []byte{
@@ -157,7 +354,7 @@ var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_protocol_upgrade_p
 	0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x65, 0x78, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe6, 0x01, 0x0a, 0x15, 0x50, 0x72,
+	0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x89, 0x06, 0x0a, 0x15, 0x50, 0x72,
 	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x43, 0x6f, 0x6e,
 	0x66, 0x69, 0x67, 0x12, 0x70, 0x0a, 0x09, 0x77, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x50, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
@@ -166,18 +363,53 @@ var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_protocol_upgrade_p
 	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x43, 0x6f,
 	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x55, 0x70, 0x67,
 	0x72, 0x61, 0x64, 0x65, 0x53, 0x70, 0x65, 0x63, 0x48, 0x00, 0x52, 0x09, 0x77, 0x65, 0x62, 0x73,
-	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x1a, 0x4b, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x34, 0x0a, 0x07,
-	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x42, 0x53, 0x5a, 0x49, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0xc0,
-	0xf5, 0x04, 0x01, 0xb8, 0xf5, 0x04, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x6d, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x55, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f,
+	0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x53, 0x70, 0x65, 0x63, 0x48, 0x00, 0x52, 0x05, 0x6e,
+	0x61, 0x6d, 0x65, 0x64, 0x12, 0x64, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x48, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x5f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x53, 0x70, 0x65, 0x63, 0x48,
+	0x00, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x1a, 0x4b, 0x0a, 0x13, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x53, 0x70, 0x65,
+	0x63, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x1a, 0x64, 0x0a, 0x18, 0x4e, 0x61, 0x6d, 0x65, 0x64,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x53,
+	0x70, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x1a, 0xe5, 0x01,
+	0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x34, 0x0a,
+	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x12, 0x72, 0x0a, 0x09, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x54, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x5f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x53, 0x70, 0x65, 0x63,
+	0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x74, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x1a, 0x2c, 0x0a, 0x0b, 0x54, 0x65, 0x72, 0x6d, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f,
+	0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x53, 0x5a, 0x49, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f,
+	0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x75, 0x70, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0xc0, 0xf5, 0x04, 0x01, 0xb8, 0xf5, 0x04, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -192,20 +424,28 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_protocol_upgrade_
 	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_protocol_upgrade_protocol_upgrade_proto_rawDescData
 }
 
-var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_protocol_upgrade_protocol_upgrade_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_protocol_upgrade_protocol_upgrade_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_protocol_upgrade_protocol_upgrade_proto_goTypes = []interface{}{
-	(*ProtocolUpgradeConfig)(nil),                     // 0: protocol_upgrade.options.gloo.solo.io.ProtocolUpgradeConfig
-	(*ProtocolUpgradeConfig_ProtocolUpgradeSpec)(nil), // 1: protocol_upgrade.options.gloo.solo.io.ProtocolUpgradeConfig.ProtocolUpgradeSpec
-	(*wrappers.BoolValue)(nil),                        // 2: google.protobuf.BoolValue
+	(*ProtocolUpgradeConfig)(nil),                          // 0: protocol_upgrade.options.gloo.solo.io.ProtocolUpgradeConfig
+	(*ProtocolUpgradeConfig_ProtocolUpgradeSpec)(nil),      // 1: protocol_upgrade.options.gloo.solo.io.ProtocolUpgradeConfig.ProtocolUpgradeSpec
+	(*ProtocolUpgradeConfig_NamedProtocolUpgradeSpec)(nil), // 2: protocol_upgrade.options.gloo.solo.io.ProtocolUpgradeConfig.NamedProtocolUpgradeSpec
+	(*ProtocolUpgradeConfig_ConnectSpec)(nil),              // 3: protocol_upgrade.options.gloo.solo.io.ProtocolUpgradeConfig.ConnectSpec
+	(*ProtocolUpgradeConfig_ConnectSpec_Termination)(nil),  // 4: protocol_upgrade.options.gloo.solo.io.ProtocolUpgradeConfig.ConnectSpec.Termination
+	(*wrappers.BoolValue)(nil),                             // 5: google.protobuf.BoolValue
 }
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_protocol_upgrade_protocol_upgrade_proto_depIdxs = []int32{
 	1, // 0: protocol_upgrade.options.gloo.solo.io.ProtocolUpgradeConfig.websocket:type_name -> protocol_upgrade.options.gloo.solo.io.ProtocolUpgradeConfig.ProtocolUpgradeSpec
-	2, // 1: protocol_upgrade.options.gloo.solo.io.ProtocolUpgradeConfig.ProtocolUpgradeSpec.enabled:type_name -> google.protobuf.BoolValue
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	2, // 1: protocol_upgrade.options.gloo.solo.io.ProtocolUpgradeConfig.named:type_name -> protocol_upgrade.options.gloo.solo.io.ProtocolUpgradeConfig.NamedProtocolUpgradeSpec
+	3, // 2: protocol_upgrade.options.gloo.solo.io.ProtocolUpgradeConfig.connect:type_name -> protocol_upgrade.options.gloo.solo.io.ProtocolUpgradeConfig.ConnectSpec
+	5, // 3: protocol_upgrade.options.gloo.solo.io.ProtocolUpgradeConfig.ProtocolUpgradeSpec.enabled:type_name -> google.protobuf.BoolValue
+	5, // 4: protocol_upgrade.options.gloo.solo.io.ProtocolUpgradeConfig.NamedProtocolUpgradeSpec.enabled:type_name -> google.protobuf.BoolValue
+	5, // 5: protocol_upgrade.options.gloo.solo.io.ProtocolUpgradeConfig.ConnectSpec.enabled:type_name -> google.protobuf.BoolValue
+	4, // 6: protocol_upgrade.options.gloo.solo.io.ProtocolUpgradeConfig.ConnectSpec.terminate:type_name -> protocol_upgrade.options.gloo.solo.io.ProtocolUpgradeConfig.ConnectSpec.Termination
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() {
@@ -240,9 +480,47 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_protocol_upgrade_
 				return nil
 			}
 		}
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_protocol_upgrade_protocol_upgrade_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtocolUpgradeConfig_NamedProtocolUpgradeSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_protocol_upgrade_protocol_upgrade_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtocolUpgradeConfig_ConnectSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_protocol_upgrade_protocol_upgrade_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtocolUpgradeConfig_ConnectSpec_Termination); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_protocol_upgrade_protocol_upgrade_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*ProtocolUpgradeConfig_Websocket)(nil),
+		(*ProtocolUpgradeConfig_Named)(nil),
+		(*ProtocolUpgradeConfig_Connect)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -250,7 +528,7 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_protocol_upgrade_
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_solo_io_gloo_projects_gloo_api_v1_options_protocol_upgrade_protocol_upgrade_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
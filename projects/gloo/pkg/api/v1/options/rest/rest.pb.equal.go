@@ -73,6 +73,91 @@ func (m *ServiceSpec) Equal(that interface{}) bool {
 		}
 	}
 
+	if len(m.GetResponses()) != len(target.GetResponses()) {
+		return false
+	}
+	for k, v := range m.GetResponses() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetResponses()[k]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetResponses()[k]) {
+				return false
+			}
+		}
+
+	}
+
+	return true
+}
+
+// Equal function
+func (m *ResponseSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ResponseSpec)
+	if !ok {
+		that2, ok := that.(ResponseSpec)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetTransformation()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetTransformation()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetTransformation(), target.GetTransformation()) {
+			return false
+		}
+	}
+
+	if len(m.GetStatusMappings()) != len(target.GetStatusMappings()) {
+		return false
+	}
+	for idx, v := range m.GetStatusMappings() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetStatusMappings()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetStatusMappings()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if len(m.GetContentTypes()) != len(target.GetContentTypes()) {
+		return false
+	}
+	for idx, v := range m.GetContentTypes() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetContentTypes()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetContentTypes()[idx]) {
+				return false
+			}
+		}
+
+	}
+
 	return true
 }
 
@@ -174,3 +259,83 @@ func (m *ServiceSpec_SwaggerInfo) Equal(that interface{}) bool {
 
 	return true
 }
+
+// Equal function
+func (m *ResponseSpec_StatusMapping) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ResponseSpec_StatusMapping)
+	if !ok {
+		that2, ok := that.(ResponseSpec_StatusMapping)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetFrom() != target.GetFrom() {
+		return false
+	}
+
+	if m.GetTo() != target.GetTo() {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetBody()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetBody()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetBody(), target.GetBody()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *ResponseSpec_ContentType) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ResponseSpec_ContentType)
+	if !ok {
+		that2, ok := that.(ResponseSpec_ContentType)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetContentType(), target.GetContentType()) != 0 {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetTransformation()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetTransformation()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetTransformation(), target.GetTransformation()) {
+			return false
+		}
+	}
+
+	return true
+}
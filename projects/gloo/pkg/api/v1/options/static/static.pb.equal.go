@@ -87,6 +87,16 @@ func (m *UpstreamSpec) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetSynthesizedHealthCheck()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetSynthesizedHealthCheck()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetSynthesizedHealthCheck(), target.GetSynthesizedHealthCheck()) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -63,6 +63,10 @@ func (m *Stats) Equal(that interface{}) bool {
 
 	}
 
+	if m.GetGenerateRouteVirtualClusters() != target.GetGenerateRouteVirtualClusters() {
+		return false
+	}
+
 	return true
 }
 
@@ -99,5 +103,15 @@ func (m *VirtualCluster) Equal(that interface{}) bool {
 		return false
 	}
 
+	if h, ok := interface{}(m.GetMatcher()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMatcher()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMatcher(), target.GetMatcher()) {
+			return false
+		}
+	}
+
 	return true
 }
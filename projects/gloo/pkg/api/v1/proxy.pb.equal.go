@@ -208,6 +208,36 @@ func (m *Listener) Equal(that interface{}) bool {
 			}
 		}
 
+	case *Listener_UdpListener:
+		if _, ok := target.ListenerType.(*Listener_UdpListener); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetUdpListener()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetUdpListener()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetUdpListener(), target.GetUdpListener()) {
+				return false
+			}
+		}
+
+	case *Listener_HybridListener:
+		if _, ok := target.ListenerType.(*Listener_HybridListener); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetHybridListener()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetHybridListener()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetHybridListener(), target.GetHybridListener()) {
+				return false
+			}
+		}
+
 	default:
 		// m is nil but target is not nil
 		if m.ListenerType != target.ListenerType {
@@ -321,6 +351,240 @@ func (m *TcpHost) Equal(that interface{}) bool {
 	return true
 }
 
+// Equal function
+func (m *UdpListener) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*UdpListener)
+	if !ok {
+		that2, ok := that.(UdpListener)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetDestination()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetDestination()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetDestination(), target.GetDestination()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetOptions()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetOptions()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetOptions(), target.GetOptions()) {
+			return false
+		}
+	}
+
+	if strings.Compare(m.GetStatPrefix(), target.GetStatPrefix()) != 0 {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *HybridListener) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*HybridListener)
+	if !ok {
+		that2, ok := that.(HybridListener)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetMatchedListeners()) != len(target.GetMatchedListeners()) {
+		return false
+	}
+	for idx, v := range m.GetMatchedListeners() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetMatchedListeners()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetMatchedListeners()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	return true
+}
+
+// Equal function
+func (m *MatchedListener) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*MatchedListener)
+	if !ok {
+		that2, ok := that.(MatchedListener)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetMatcher()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMatcher()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMatcher(), target.GetMatcher()) {
+			return false
+		}
+	}
+
+	switch m.ListenerType.(type) {
+
+	case *MatchedListener_HttpListener:
+		if _, ok := target.ListenerType.(*MatchedListener_HttpListener); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetHttpListener()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetHttpListener()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetHttpListener(), target.GetHttpListener()) {
+				return false
+			}
+		}
+
+	case *MatchedListener_TcpListener:
+		if _, ok := target.ListenerType.(*MatchedListener_TcpListener); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetTcpListener()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetTcpListener()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetTcpListener(), target.GetTcpListener()) {
+				return false
+			}
+		}
+
+	default:
+		// m is nil but target is not nil
+		if m.ListenerType != target.ListenerType {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *Matcher) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*Matcher)
+	if !ok {
+		that2, ok := that.(Matcher)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetSslConfig()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetSslConfig()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetSslConfig(), target.GetSslConfig()) {
+			return false
+		}
+	}
+
+	if len(m.GetSourcePrefixRanges()) != len(target.GetSourcePrefixRanges()) {
+		return false
+	}
+	for idx, v := range m.GetSourcePrefixRanges() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetSourcePrefixRanges()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetSourcePrefixRanges()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if len(m.GetApplicationProtocols()) != len(target.GetApplicationProtocols()) {
+		return false
+	}
+	for idx, v := range m.GetApplicationProtocols() {
+
+		if strings.Compare(v, target.GetApplicationProtocols()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if h, ok := interface{}(m.GetDestinationPort()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetDestinationPort()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetDestinationPort(), target.GetDestinationPort()) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equal function
 func (m *HttpListener) Equal(that interface{}) bool {
 	if that == nil {
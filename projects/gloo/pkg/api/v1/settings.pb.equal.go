@@ -998,6 +998,18 @@ func (m *Settings_ConsulConfiguration) Equal(that interface{}) bool {
 		}
 	}
 
+	if strings.Compare(m.GetNamespace(), target.GetNamespace()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetPartition(), target.GetPartition()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetConnectServiceName(), target.GetConnectServiceName()) != 0 {
+		return false
+	}
+
 	return true
 }
 
@@ -1237,6 +1249,26 @@ func (m *GlooOptions_AWSOptions) Equal(that interface{}) bool {
 		return false
 	}
 
+	if h, ok := interface{}(m.GetEc2PollingInterval()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetEc2PollingInterval()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetEc2PollingInterval(), target.GetEc2PollingInterval()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetEc2PollingMaxBackoff()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetEc2PollingMaxBackoff()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetEc2PollingMaxBackoff(), target.GetEc2PollingMaxBackoff()) {
+			return false
+		}
+	}
+
 	switch m.CredentialsFetcher.(type) {
 
 	case *GlooOptions_AWSOptions_EnableCredentialsDiscovey:
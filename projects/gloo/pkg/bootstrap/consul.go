@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/consul/api"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/solo-kit/pkg/utils/prototime"
 )
@@ -60,5 +61,16 @@ func ConsulClientForSettings(ctx context.Context, settings *v1.Settings) (*api.C
 		}
 	}
 
+	// Scope all requests to the configured namespace and partition, unless a request overrides them
+	httpClient, err := api.NewHttpClient(cfg.Transport, cfg.TLSConfig)
+	if err != nil {
+		return nil, err
+	}
+	httpClient.Transport = consul.NewScopedRoundTripper(httpClient.Transport, consul.Scope{
+		Namespace: consulSettings.GetNamespace(),
+		Partition: consulSettings.GetPartition(),
+	})
+	cfg.HttpClient = httpClient
+
 	return api.NewClient(cfg)
 }
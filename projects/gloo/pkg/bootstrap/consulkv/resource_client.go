@@ -0,0 +1,134 @@
+package consulkv
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	consulapi "github.com/hashicorp/consul/api"
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	skconsul "github.com/solo-io/solo-kit/pkg/api/v1/clients/consul"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"github.com/solo-io/solo-kit/pkg/utils/protoutils"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// Builds clients that store resources in the Consul key-value store, like factory.ConsulResourceClientFactory.
+// The clients watch their key prefix with blocking queries, so changes propagate as soon as Consul
+// commits them, instead of polling the whole prefix on every refresh.
+type ResourceClientFactory struct {
+	Consul  *consulapi.Client
+	RootKey string
+}
+
+var _ factory.ResourceClientFactory = new(ResourceClientFactory)
+
+func (f *ResourceClientFactory) NewResourceClient(ctx context.Context, params factory.NewResourceClientParams) (clients.ResourceClient, error) {
+	versionedResource, ok := params.ResourceType.(resources.VersionedResource)
+	if !ok {
+		return nil, errors.Errorf("the consul storage client can only be used for resources which implement the resources.VersionedResource interface resources, received type %v", resources.Kind(params.ResourceType))
+	}
+	return NewResourceClient(f.Consul, f.RootKey, versionedResource), nil
+}
+
+// The solo-kit Consul client handles all operations but watches.
+type resourceClient struct {
+	*skconsul.ResourceClient
+	consul       *consulapi.Client
+	root         string
+	resourceType resources.VersionedResource
+}
+
+func NewResourceClient(client *consulapi.Client, rootKey string, resourceType resources.VersionedResource) clients.ResourceClient {
+	return &resourceClient{
+		ResourceClient: skconsul.NewResourceClient(client, rootKey, resourceType),
+		consul:         client,
+		root:           rootKey,
+		resourceType:   resourceType,
+	}
+}
+
+func (rc *resourceClient) Watch(namespace string, opts clients.WatchOpts) (<-chan resources.ResourceList, <-chan error, error) {
+	opts = opts.WithDefaults()
+	resourceDir := rc.resourceDir(namespace)
+	resourcesChan := make(chan resources.ResourceList)
+	errs := make(chan error)
+
+	go func() {
+		defer close(resourcesChan)
+		defer close(errs)
+
+		var kvPairs consulapi.KVPairs
+
+		// The first query returns immediately, which honors the contract of Watch functions to open with an initial read
+		consul.RunBlockingWatch(opts.Ctx, consul.BlockingWatchOpts{Name: "kv_" + strings.ToLower(rc.resourceType.GroupVersionKind().Kind)},
+			func(q *consulapi.QueryOptions) (*consulapi.QueryMeta, error) {
+				var (
+					queryMeta *consulapi.QueryMeta
+					err       error
+				)
+				kvPairs, queryMeta, err = rc.consul.KV().List(resourceDir, q)
+				return queryMeta, err
+			},
+			func() {
+				list, err := rc.toResourceList(kvPairs, opts.Selector)
+				if err != nil {
+					select {
+					case errs <- err:
+					case <-opts.Ctx.Done():
+					}
+					return
+				}
+				select {
+				case resourcesChan <- list:
+				case <-opts.Ctx.Done():
+				}
+			},
+			func(err error) {
+				select {
+				case errs <- errors.Wrapf(err, "getting kv-pairs list"):
+				case <-opts.Ctx.Done():
+				}
+			},
+		)
+	}()
+
+	return resourcesChan, errs, nil
+}
+
+func (rc *resourceClient) toResourceList(kvPairs consulapi.KVPairs, selector map[string]string) (resources.ResourceList, error) {
+	var resourceList resources.ResourceList
+	for _, kvPair := range kvPairs {
+		resource := rc.NewResource()
+		if err := protoutils.UnmarshalYAML(kvPair.Value, resource); err != nil {
+			return nil, errors.Wrapf(err, "reading KV into %v", rc.Kind())
+		}
+		resources.UpdateMetadata(resource, func(meta *core.Metadata) {
+			meta.ResourceVersion = fmt.Sprintf("%v", kvPair.ModifyIndex)
+		})
+		if labels.SelectorFromSet(selector).Matches(labels.Set(resource.GetMetadata().Labels)) {
+			resourceList = append(resourceList, resource)
+		}
+	}
+
+	sort.SliceStable(resourceList, func(i, j int) bool {
+		return resourceList[i].GetMetadata().Name < resourceList[j].GetMetadata().Name
+	})
+	return resourceList, nil
+}
+
+// Must match the layout of the solo-kit client; works with "" (NamespaceAll)
+func (rc *resourceClient) resourceDir(namespace string) string {
+	return strings.Join([]string{
+		rc.root,
+		rc.resourceType.GroupVersionKind().Group,
+		rc.resourceType.GroupVersionKind().Version,
+		rc.resourceType.GroupVersionKind().Kind,
+		namespace,
+	}, "/")
+}
@@ -0,0 +1,186 @@
+package consulkv_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	consulapi "github.com/hashicorp/consul/api"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/bootstrap/consulkv"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	skfactory "github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/utils/protoutils"
+)
+
+// Stands in for the KV store of a Consul agent, answering blocking queries
+type fakeKv struct {
+	server *httptest.Server
+
+	lock     sync.Mutex
+	index    uint64
+	pairs    map[string]*consulapi.KVPair
+	changed  chan struct{}
+	requests []string
+}
+
+func newFakeKv() *fakeKv {
+	kv := &fakeKv{index: 1, pairs: map[string]*consulapi.KVPair{}, changed: make(chan struct{})}
+	kv.server = httptest.NewServer(http.HandlerFunc(kv.list))
+	return kv
+}
+
+func (kv *fakeKv) put(key string, value []byte) {
+	kv.lock.Lock()
+	defer kv.lock.Unlock()
+	kv.index++
+	kv.pairs[key] = &consulapi.KVPair{Key: key, Value: value, ModifyIndex: kv.index}
+	close(kv.changed)
+	kv.changed = make(chan struct{})
+}
+
+func (kv *fakeKv) list(w http.ResponseWriter, r *http.Request) {
+	prefix := strings.TrimPrefix(r.URL.Path, "/v1/kv/")
+	waitIndex, _ := strconv.ParseUint(r.URL.Query().Get("index"), 10, 64)
+
+	kv.lock.Lock()
+	kv.requests = append(kv.requests, r.URL.RawQuery)
+	if waitIndex >= kv.index {
+		changed := kv.changed
+		kv.lock.Unlock()
+		select {
+		case <-changed:
+		case <-time.After(time.Second):
+		case <-r.Context().Done():
+			return
+		}
+		kv.lock.Lock()
+	}
+	defer kv.lock.Unlock()
+
+	var pairs consulapi.KVPairs
+	for key, pair := range kv.pairs {
+		if strings.HasPrefix(key, prefix) {
+			pairs = append(pairs, pair)
+		}
+	}
+	w.Header().Set("X-Consul-Index", strconv.FormatUint(kv.index, 10))
+	if len(pairs) == 0 {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(pairs)
+}
+
+func (kv *fakeKv) requestCount() int {
+	kv.lock.Lock()
+	defer kv.lock.Unlock()
+	return len(kv.requests)
+}
+
+var _ = Describe("Consul KV resource client", func() {
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+		kv     *fakeKv
+		client clients.ResourceClient
+	)
+
+	upstream := func(name string, port uint32) *v1.Upstream {
+		return &v1.Upstream{
+			Metadata: &core.Metadata{Name: name, Namespace: "gloo-system"},
+			UpstreamType: &v1.Upstream_Static{Static: &static.UpstreamSpec{
+				Hosts: []*static.Host{{Addr: "1.2.3.4", Port: port}},
+			}},
+		}
+	}
+
+	store := func(us *v1.Upstream) {
+		data, err := protoutils.MarshalYAML(us)
+		Expect(err).NotTo(HaveOccurred())
+		kv.put("gloo/gloo.solo.io/v1/Upstream/gloo-system/"+us.GetMetadata().GetName(), data)
+	}
+
+	names := func(list resources.ResourceList) []string {
+		var result []string
+		for _, res := range list {
+			result = append(result, res.GetMetadata().GetName())
+		}
+		return result
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		kv = newFakeKv()
+
+		cfg := consulapi.DefaultConfig()
+		cfg.Address = kv.server.URL
+		consulClient, err := consulapi.NewClient(cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		factory := &ResourceClientFactory{Consul: consulClient, RootKey: "gloo"}
+		client, err = factory.NewResourceClient(ctx, skfactory.NewResourceClientParams{ResourceType: &v1.Upstream{}})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		cancel()
+		kv.server.Close()
+	})
+
+	It("opens the watch with the current resources and delivers changes as they happen", func() {
+		store(upstream("us-1", 80))
+
+		resourcesChan, errs, err := client.Watch("gloo-system", clients.WatchOpts{Ctx: ctx, RefreshRate: time.Hour})
+		Expect(err).NotTo(HaveOccurred())
+
+		var list resources.ResourceList
+		Eventually(resourcesChan).Should(Receive(&list))
+		Expect(names(list)).To(Equal([]string{"us-1"}))
+
+		store(upstream("us-2", 8080))
+
+		Eventually(resourcesChan, 500*time.Millisecond).Should(Receive(&list))
+		Expect(names(list)).To(Equal([]string{"us-1", "us-2"}))
+		Expect(list[1].(*v1.Upstream).GetStatic().GetHosts()[0].GetPort()).To(BeEquivalentTo(8080))
+		Expect(list[1].GetMetadata().GetResourceVersion()).To(Equal("3"))
+
+		Consistently(errs).ShouldNot(Receive())
+	})
+
+	It("blocks on the last index instead of polling", func() {
+		store(upstream("us-1", 80))
+
+		resourcesChan, _, err := client.Watch("gloo-system", clients.WatchOpts{Ctx: ctx, RefreshRate: time.Millisecond})
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(resourcesChan).Should(Receive())
+
+		// The fake agent blocks queries for up to a second
+		Consistently(kv.requestCount, 500*time.Millisecond).Should(BeNumerically("<=", 2))
+		Consistently(resourcesChan).ShouldNot(Receive())
+	})
+
+	It("closes the channels when the watch is cancelled", func() {
+		resourcesChan, errs, err := client.Watch("gloo-system", clients.WatchOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+
+		var list resources.ResourceList
+		Eventually(resourcesChan).Should(Receive(&list))
+		Expect(list).To(BeEmpty())
+
+		cancel()
+		Eventually(resourcesChan).Should(BeClosed())
+		Eventually(errs).Should(BeClosed())
+	})
+})
@@ -18,6 +18,7 @@ import (
 	skkube "github.com/solo-io/solo-kit/pkg/api/v1/resources/common/kubernetes"
 	"google.golang.org/grpc"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 )
 
 type Opts struct {
@@ -32,6 +33,7 @@ type Opts struct {
 	AuthConfigs       factory.ResourceClientFactory
 	RateLimitConfigs  factory.ResourceClientFactory
 	KubeClient        kubernetes.Interface
+	KubeRestConfig    *rest.Config
 	Consul            Consul
 	WatchOpts         clients.WatchOpts
 	DevMode           bool
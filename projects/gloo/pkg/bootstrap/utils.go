@@ -13,6 +13,7 @@ import (
 	vaultapi "github.com/hashicorp/vault/api"
 	kubeconverters "github.com/solo-io/gloo/projects/gloo/pkg/api/converters/kube"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap/consulkv"
 	"github.com/solo-io/k8s-utils/kubeutils"
 	"github.com/solo-io/solo-kit/pkg/api/external/kubernetes/service"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
@@ -115,7 +116,7 @@ func ConfigFactoryForSettings(params ConfigFactoryParams, resourceCrd crd.Crd) (
 		if rootKey == "" {
 			rootKey = DefaultRootKey
 		}
-		return &factory.ConsulResourceClientFactory{
+		return &consulkv.ResourceClientFactory{
 			Consul:  consulClient,
 			RootKey: rootKey,
 		}, nil
@@ -239,7 +240,7 @@ func ArtifactFactoryForSettings(ctx context.Context,
 		if rootKey == "" {
 			rootKey = DefaultRootKey
 		}
-		return &factory.ConsulResourceClientFactory{
+		return &consulkv.ResourceClientFactory{
 			Consul:  consulClient,
 			RootKey: rootKey,
 		}, nil
@@ -2,6 +2,7 @@ package ec2
 
 import (
 	"context"
+	"strconv"
 	"strings"
 
 	"github.com/solo-io/go-utils/contextutils"
@@ -14,7 +15,11 @@ import (
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
 
-const InstanceIdAnnotationKey = "instanceId"
+const (
+	InstanceIdAnnotationKey = "instanceId"
+	// tag added by Auto Scaling to the instances it launches
+	AutoScalingGroupTagKey = "aws:autoscaling:groupName"
+)
 
 // In order to minimize calls to the AWS API, we group calls by credentials and apply tag filters locally.
 // This function groups upstreams by credentials, calls the AWS API, maps the instances to upstreams, and returns the
@@ -59,6 +64,8 @@ type credentialGroup struct {
 	instances []*ec2.Instance
 	// one filter map exists for each instance in order to support client-side filtering
 	filterMaps []FilterMap
+	// the health of the instances, only populated if an upstream in the group filters instances by health
+	health map[string]*InstanceHealth
 }
 
 // Initializes the credentialGroups
@@ -94,10 +101,26 @@ func getInstancesForCredentialGroups(ctx context.Context, lister Ec2InstanceList
 		}
 		credGroup.instances = instances
 		credGroup.filterMaps = generateFilterMaps(instances)
+		if requiresHealth(credGroup.upstreams) {
+			health, err := lister.ListHealthForCredentials(ctx, credGroup.credentialSpec, secrets)
+			if err != nil {
+				return err
+			}
+			credGroup.health = health
+		}
 	}
 	return nil
 }
 
+func requiresHealth(upstreams v1.UpstreamList) bool {
+	for _, upstream := range upstreams {
+		if upstream.GetAwsEc2().GetHealthCheckInstances() {
+			return true
+		}
+	}
+	return false
+}
+
 // applies filter logic equivalent to the tag filter logic used in AWS's DescribeInstances API
 // NOTE: assumes that upstreams are EC2 upstreams
 func filterInstancesForUpstream(ctx context.Context, upstream *v1.Upstream, credGroup *credentialGroup) []*ec2.Instance {
@@ -123,6 +146,18 @@ func filterInstancesForUpstream(ctx context.Context, upstream *v1.Upstream, cred
 				}
 			}
 		}
+		if matchesAll && len(upstream.GetAwsEc2().GetAutoScalingGroups()) > 0 {
+			matchesAll = false
+			for _, group := range upstream.GetAwsEc2().GetAutoScalingGroups() {
+				if fm[awsKeyCase(AutoScalingGroupTagKey)] == group {
+					matchesAll = true
+					break
+				}
+			}
+		}
+		if matchesAll && upstream.GetAwsEc2().GetHealthCheckInstances() {
+			matchesAll = credGroup.health[aws.StringValue(candidateInstance.InstanceId)].Healthy()
+		}
 		if matchesAll {
 			instances = append(instances, candidateInstance)
 			logger.Debugw("instance for upstream accepted", "upstream", upstream.Metadata.Ref().Key(), "instance-tags", candidateInstance.Tags, "instance-id", candidateInstance.InstanceId)
@@ -146,10 +181,7 @@ func upstreamInstanceToEndpoint(ctx context.Context, writeNamespace string, upst
 			zap.Any("upstream.usePublicIp", upstream.GetAwsEc2().GetPublicIp()))
 		return nil
 	}
-	port := upstream.GetAwsEc2().GetPort()
-	if port == 0 {
-		port = DefaultPort
-	}
+	port := instancePort(ctx, upstream.GetAwsEc2(), instance)
 	ref := upstream.Metadata.Ref()
 	// for easier debugging, add the instance id to the xds output
 	instanceInfo := make(map[string]string)
@@ -171,6 +203,26 @@ func upstreamInstanceToEndpoint(ctx context.Context, writeNamespace string, upst
 	return &endpoint
 }
 
+// returns the port from the instance's port tag if set, otherwise the upstream's port
+func instancePort(ctx context.Context, spec *glooec2.UpstreamSpec, instance *ec2.Instance) uint32 {
+	if portTag := spec.GetPortTag(); portTag != "" {
+		if value, ok := generateFilterMap(instance)[awsKeyCase(portTag)]; ok {
+			port, err := strconv.ParseUint(value, 10, 16)
+			if err == nil && port > 0 {
+				return uint32(port)
+			}
+			contextutils.LoggerFrom(ctx).Warnw("invalid port tag on instance, using upstream port",
+				zap.Any("instanceId", aws.StringValue(instance.InstanceId)),
+				zap.Any("portTag", portTag),
+				zap.Any("value", value))
+		}
+	}
+	if spec.GetPort() == 0 {
+		return DefaultPort
+	}
+	return spec.GetPort()
+}
+
 // a FilterMap is created for each EC2 instance so we can efficiently filter the instances associated with a given
 // upstream's filter spec
 // filter maps are generated from tag lists, the keys are the tag keys, the values are the tag values
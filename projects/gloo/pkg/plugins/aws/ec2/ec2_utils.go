@@ -8,6 +8,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	glooec2 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws/ec2"
@@ -15,21 +17,36 @@ import (
 )
 
 func GetEc2Client(cred *CredentialSpec, secrets v1.SecretList) (*ec2.EC2, error) {
+	sess, config, err := getSession(cred, secrets)
+	if err != nil {
+		return nil, err
+	}
+	return ec2.New(sess, config), nil
+}
+
+func GetAutoScalingClient(cred *CredentialSpec, secrets v1.SecretList) (*autoscaling.AutoScaling, error) {
+	sess, config, err := getSession(cred, secrets)
+	if err != nil {
+		return nil, err
+	}
+	return autoscaling.New(sess, config), nil
+}
+
+func getSession(cred *CredentialSpec, secrets v1.SecretList) (*session.Session, *aws.Config, error) {
 	regionConfig := &aws.Config{Region: aws.String(cred.Region())}
 	secretRef := cred.SecretRef()
 	sess, err := aws2.GetAwsSession(secretRef, secrets, regionConfig)
 	if err != nil {
 		if secretRef == nil {
-			return nil, CreateSessionFromEnvError(err)
+			return nil, nil, CreateSessionFromEnvError(err)
 		}
-		return nil, CreateSessionFromSecretError(err)
+		return nil, nil, CreateSessionFromSecretError(err)
 	}
+	config := &aws.Config{}
 	if cred.Arn() != "" {
-		cred := stscreds.NewCredentials(sess, cred.Arn())
-		config := &aws.Config{Credentials: cred}
-		return ec2.New(sess, config), nil
+		config.Credentials = stscreds.NewCredentials(sess, cred.Arn())
 	}
-	return ec2.New(sess), nil
+	return sess, config, nil
 }
 
 func GetInstancesFromDescription(desc *ec2.DescribeInstancesOutput) []*ec2.Instance {
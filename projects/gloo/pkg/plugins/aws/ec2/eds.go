@@ -13,6 +13,7 @@ import (
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/utils/prototime"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -37,6 +38,8 @@ type edsWatcher struct {
 	writeNamespace    string
 	ec2InstanceLister Ec2InstanceLister
 	secretNamespaces  []string
+	// maximum delay between retries when polling fails
+	maxBackoff time.Duration
 }
 
 func newEndpointsWatcher(watchCtx context.Context, writeNamespace string, upstreams v1.UpstreamList, secretClient v1.SecretClient, parentRefreshRate time.Duration) *edsWatcher {
@@ -60,14 +63,24 @@ func newEndpointsWatcher(watchCtx context.Context, writeNamespace string, upstre
 			namespaces = append(namespaces, ns)
 		}
 	}
+	awsOptions := settings.GetGloo().GetAwsOptions()
+	if pollingInterval := awsOptions.GetEc2PollingInterval(); pollingInterval != nil {
+		parentRefreshRate = prototime.DurationFromProto(pollingInterval)
+	}
+	refreshRate := getRefreshRate(parentRefreshRate)
+	maxBackoff := refreshRate
+	if pollingMaxBackoff := awsOptions.GetEc2PollingMaxBackoff(); pollingMaxBackoff != nil {
+		maxBackoff = prototime.DurationFromProto(pollingMaxBackoff)
+	}
 	return &edsWatcher{
 		upstreams:         upstreams,
 		watchContext:      watchCtx,
 		secretClient:      secretClient,
-		refreshRate:       getRefreshRate(parentRefreshRate),
+		refreshRate:       refreshRate,
 		writeNamespace:    writeNamespace,
 		ec2InstanceLister: NewEc2InstanceLister(),
 		secretNamespaces:  namespaces,
+		maxBackoff:        maxBackoff,
 	}
 }
 
@@ -87,27 +100,50 @@ func getRefreshRate(parentRefreshRate time.Duration) time.Duration {
 	return parentRefreshRate
 }
 
-func (c *edsWatcher) updateEndpointsList(endpointsChan chan v1.EndpointList, errs chan error) {
+// returns false if the endpoints could not be updated
+func (c *edsWatcher) updateEndpointsList(endpointsChan chan v1.EndpointList, errs chan error) bool {
 	var secrets v1.SecretList
 	for _, ns := range c.secretNamespaces {
 		nsSecrets, err := c.secretClient.List(ns, clients.ListOpts{Ctx: c.watchContext})
 		if err != nil {
-			errs <- err
-			return
+			c.sendError(errs, err)
+			return false
 		}
 		secrets = append(secrets, nsSecrets...)
 	}
 
 	allEndpoints, err := getLatestEndpoints(c.watchContext, c.ec2InstanceLister, secrets, c.writeNamespace, c.upstreams)
 	if err != nil {
-		errs <- err
-		return
+		c.sendError(errs, err)
+		return false
 	}
 	select {
 	case <-c.watchContext.Done():
-		return
 	case endpointsChan <- allEndpoints:
 	}
+	return true
+}
+
+func (c *edsWatcher) sendError(errs chan error, err error) {
+	select {
+	case <-c.watchContext.Done():
+	case errs <- err:
+	}
+}
+
+const initialBackoff = time.Second
+
+// returns the delay before the next poll: the refresh rate after a successful poll, or an exponentially
+// increasing backoff, capped by maxBackoff, after consecutive failures
+func (c *edsWatcher) nextPollDelay(succeeded bool, backoff time.Duration) (time.Duration, time.Duration) {
+	if succeeded {
+		return c.refreshRate, initialBackoff
+	}
+	delay := backoff
+	if c.maxBackoff > 0 && delay > c.maxBackoff {
+		delay = c.maxBackoff
+	}
+	return delay, backoff * 2
 }
 
 func (c *edsWatcher) poll() (<-chan v1.EndpointList, <-chan error, error) {
@@ -117,17 +153,15 @@ func (c *edsWatcher) poll() (<-chan v1.EndpointList, <-chan error, error) {
 		defer close(endpointsChan)
 		defer close(errs)
 
-		c.updateEndpointsList(endpointsChan, errs)
-		ticker := time.NewTicker(c.refreshRate)
-		defer ticker.Stop()
+		delay, backoff := c.nextPollDelay(c.updateEndpointsList(endpointsChan, errs), initialBackoff)
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
 
 		for {
 			select {
-			case _, ok := <-ticker.C:
-				if !ok {
-					return
-				}
-				c.updateEndpointsList(endpointsChan, errs)
+			case <-timer.C:
+				delay, backoff = c.nextPollDelay(c.updateEndpointsList(endpointsChan, errs), backoff)
+				timer.Reset(delay)
 			case <-c.watchContext.Done():
 				return
 			}
@@ -2,10 +2,12 @@ package ec2
 
 import (
 	"context"
+	"strings"
 
 	"github.com/rotisserie/eris"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/go-utils/contextutils"
@@ -16,6 +18,27 @@ import (
 // This allows us to easily mock the API in our tests.
 type Ec2InstanceLister interface {
 	ListForCredentials(ctx context.Context, cred *CredentialSpec, secrets v1.SecretList) ([]*ec2.Instance, error)
+	ListHealthForCredentials(ctx context.Context, cred *CredentialSpec, secrets v1.SecretList) (map[string]*InstanceHealth, error)
+}
+
+// InstanceHealth describes the health of an instance, keyed by instance id
+type InstanceHealth struct {
+	// true if both the instance and system status checks are ok
+	StatusChecksPassed bool
+	// the lifecycle state and health status of the instance in its Auto Scaling group, empty if not in a group
+	AutoScalingLifecycleState string
+	AutoScalingHealthStatus   string
+}
+
+func (h *InstanceHealth) Healthy() bool {
+	if h == nil || !h.StatusChecksPassed {
+		return false
+	}
+	if h.AutoScalingLifecycleState == "" {
+		return true
+	}
+	return h.AutoScalingLifecycleState == autoscaling.LifecycleStateInService &&
+		strings.EqualFold(h.AutoScalingHealthStatus, "Healthy")
 }
 
 type ec2InstanceLister struct {
@@ -62,6 +85,53 @@ func (c *ec2InstanceLister) ListWithClient(ctx context.Context, svc *ec2.EC2) ([
 	return result, nil
 }
 
+func (c *ec2InstanceLister) ListHealthForCredentials(ctx context.Context, cred *CredentialSpec, secrets v1.SecretList) (map[string]*InstanceHealth, error) {
+	svc, err := GetEc2Client(cred, secrets)
+	if err != nil {
+		return nil, GetClientError(err)
+	}
+	asgSvc, err := GetAutoScalingClient(cred, secrets)
+	if err != nil {
+		return nil, GetClientError(err)
+	}
+	return c.ListHealthWithClients(ctx, svc, asgSvc)
+}
+
+func (c *ec2InstanceLister) ListHealthWithClients(ctx context.Context, svc *ec2.EC2, asgSvc *autoscaling.AutoScaling) (map[string]*InstanceHealth, error) {
+	result := make(map[string]*InstanceHealth)
+	// only running instances are returned, which are the only ones we route to
+	err := svc.DescribeInstanceStatusPagesWithContext(ctx, &ec2.DescribeInstanceStatusInput{}, func(r *ec2.DescribeInstanceStatusOutput, more bool) bool {
+		for _, status := range r.InstanceStatuses {
+			result[aws.StringValue(status.InstanceId)] = &InstanceHealth{
+				StatusChecksPassed: aws.StringValue(status.InstanceStatus.Status) == ec2.SummaryStatusOk &&
+					aws.StringValue(status.SystemStatus.Status) == ec2.SummaryStatusOk,
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, DescribeInstanceStatusError(err)
+	}
+
+	err = asgSvc.DescribeAutoScalingInstancesPagesWithContext(ctx, &autoscaling.DescribeAutoScalingInstancesInput{}, func(r *autoscaling.DescribeAutoScalingInstancesOutput, more bool) bool {
+		for _, instance := range r.AutoScalingInstances {
+			health, ok := result[aws.StringValue(instance.InstanceId)]
+			if !ok {
+				continue
+			}
+			health.AutoScalingLifecycleState = aws.StringValue(instance.LifecycleState)
+			health.AutoScalingHealthStatus = aws.StringValue(instance.HealthStatus)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, DescribeAutoScalingInstancesError(err)
+	}
+
+	contextutils.LoggerFrom(ctx).Debugw("ec2Upstream instance health", zap.Any("value", result))
+	return result, nil
+}
+
 var (
 	GetClientError = func(err error) error {
 		return eris.Wrapf(err, "unable to get aws client")
@@ -70,4 +140,12 @@ var (
 	DescribeInstancesError = func(err error) error {
 		return eris.Wrapf(err, "unable to describe instances")
 	}
+
+	DescribeInstanceStatusError = func(err error) error {
+		return eris.Wrapf(err, "unable to describe instance status")
+	}
+
+	DescribeAutoScalingInstancesError = func(err error) error {
+		return eris.Wrapf(err, "unable to describe auto scaling instances")
+	}
 )
@@ -0,0 +1,97 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// responses of a fake EC2 and Auto Scaling API, keyed by action
+var fakeAwsResponses = map[string]string{
+	"DescribeInstances": `<DescribeInstancesResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <requestId>req</requestId>
+  <reservationSet><item><reservationId>r-1</reservationId><instancesSet>
+    <item><instanceId>i-1</instanceId><privateIpAddress>10.0.0.1</privateIpAddress>
+      <tagSet><item><key>aws:autoscaling:groupName</key><value>web</value></item></tagSet></item>
+    <item><instanceId>i-2</instanceId><privateIpAddress>10.0.0.2</privateIpAddress></item>
+    <item><instanceId>i-3</instanceId></item>
+  </instancesSet></item></reservationSet>
+</DescribeInstancesResponse>`,
+	"DescribeInstanceStatus": `<DescribeInstanceStatusResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <requestId>req</requestId>
+  <instanceStatusSet>
+    <item><instanceId>i-1</instanceId><instanceStatus><status>ok</status></instanceStatus><systemStatus><status>ok</status></systemStatus></item>
+    <item><instanceId>i-2</instanceId><instanceStatus><status>impaired</status></instanceStatus><systemStatus><status>ok</status></systemStatus></item>
+  </instanceStatusSet>
+</DescribeInstanceStatusResponse>`,
+	"DescribeAutoScalingInstances": `<DescribeAutoScalingInstancesResponse xmlns="http://autoscaling.amazonaws.com/doc/2011-01-01/">
+  <DescribeAutoScalingInstancesResult><AutoScalingInstances>
+    <member><InstanceId>i-1</InstanceId><AutoScalingGroupName>web</AutoScalingGroupName><LifecycleState>InService</LifecycleState><HealthStatus>HEALTHY</HealthStatus></member>
+  </AutoScalingInstances></DescribeAutoScalingInstancesResult>
+  <ResponseMetadata><RequestId>req</RequestId></ResponseMetadata>
+</DescribeAutoScalingInstancesResponse>`,
+}
+
+var _ = Describe("Instance lister", func() {
+
+	var (
+		ctx    = context.Background()
+		server *httptest.Server
+		ec2Svc *ec2.EC2
+		asgSvc *autoscaling.AutoScaling
+	)
+
+	BeforeEach(func() {
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.ParseForm()).NotTo(HaveOccurred())
+			response, ok := fakeAwsResponses[r.Form.Get("Action")]
+			if !ok {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			fmt.Fprint(w, response)
+		}))
+		sess := session.Must(session.NewSession(&aws.Config{
+			Region:      aws.String("us-east-1"),
+			Endpoint:    aws.String(server.URL),
+			Credentials: credentials.NewStaticCredentials("access", "secret", ""),
+		}))
+		ec2Svc = ec2.New(sess)
+		asgSvc = autoscaling.New(sess)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("lists instances with an ip address", func() {
+		instances, err := NewEc2InstanceLister().ListWithClient(ctx, ec2Svc)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(instances).To(HaveLen(2))
+		Expect(aws.StringValue(instances[0].InstanceId)).To(Equal("i-1"))
+		Expect(aws.StringValue(instances[0].Tags[0].Value)).To(Equal("web"))
+	})
+
+	It("lists instance health", func() {
+		health, err := NewEc2InstanceLister().ListHealthWithClients(ctx, ec2Svc, asgSvc)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(health).To(HaveLen(2))
+		Expect(health["i-1"]).To(Equal(&InstanceHealth{
+			StatusChecksPassed:        true,
+			AutoScalingLifecycleState: "InService",
+			AutoScalingHealthStatus:   "HEALTHY",
+		}))
+		Expect(health["i-1"].Healthy()).To(BeTrue())
+		Expect(health["i-2"].Healthy()).To(BeFalse())
+		Expect(health["i-3"].Healthy()).To(BeFalse())
+	})
+})
@@ -75,6 +75,91 @@ var _ = Describe("polling", func() {
 			},
 		}})
 	})
+
+	Context("auto scaling groups, health and port tags", func() {
+
+		var (
+			lister   *mockEc2InstanceLister
+			upstream *v1.Upstream
+		)
+
+		instance := func(id, ip, asg, port string) *ec2.Instance {
+			inst := &ec2.Instance{
+				InstanceId:       aws.String(id),
+				PrivateIpAddress: aws.String(ip),
+			}
+			if asg != "" {
+				inst.Tags = append(inst.Tags, &ec2.Tag{Key: aws.String(AutoScalingGroupTagKey), Value: aws.String(asg)})
+			}
+			if port != "" {
+				inst.Tags = append(inst.Tags, &ec2.Tag{Key: aws.String("Gloo-Port"), Value: aws.String(port)})
+			}
+			return inst
+		}
+
+		BeforeEach(func() {
+			upstream = &v1.Upstream{
+				UpstreamType: &v1.Upstream_AwsEc2{
+					AwsEc2: &glooec2.UpstreamSpec{
+						Region:    "us-east-1",
+						SecretRef: testSecretRef1,
+						Port:      testPort1,
+					},
+				},
+				Metadata: &core.Metadata{Name: "asg", Namespace: "default"},
+			}
+			cred := NewCredentialSpecFromEc2UpstreamSpec(upstream.GetAwsEc2())
+			lister = newMockEc2InstanceLister(mockListerResponses{cred.GetKey(): {
+				instance("i-1", "10.0.0.1", "web", "9090"),
+				instance("i-2", "10.0.0.2", "web", "not-a-port"),
+				instance("i-3", "10.0.0.3", "other", ""),
+				instance("i-4", "10.0.0.4", "", ""),
+			}})
+			lister.health = map[string]*InstanceHealth{
+				"i-1": {StatusChecksPassed: true, AutoScalingLifecycleState: "InService", AutoScalingHealthStatus: "HEALTHY"},
+				"i-2": {StatusChecksPassed: true, AutoScalingLifecycleState: "Terminating", AutoScalingHealthStatus: "HEALTHY"},
+				"i-3": {StatusChecksPassed: true, AutoScalingLifecycleState: "InService", AutoScalingHealthStatus: "HEALTHY"},
+				"i-4": {StatusChecksPassed: false},
+			}
+		})
+
+		addresses := func(endpoints v1.EndpointList) map[string]uint32 {
+			result := map[string]uint32{}
+			for _, ep := range endpoints {
+				result[ep.Address] = ep.Port
+			}
+			return result
+		}
+
+		It("selects instances by auto scaling group and port tag", func() {
+			upstream.GetAwsEc2().AutoScalingGroups = []string{"web"}
+			upstream.GetAwsEc2().PortTag = "gloo-port"
+			endpoints, err := getLatestEndpoints(ctx, lister, nil, writeNamespace, v1.UpstreamList{upstream})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(addresses(endpoints)).To(Equal(map[string]uint32{"10.0.0.1": 9090, "10.0.0.2": testPort1}))
+		})
+
+		It("filters out unhealthy instances", func() {
+			upstream.GetAwsEc2().HealthCheckInstances = true
+			endpoints, err := getLatestEndpoints(ctx, lister, nil, writeNamespace, v1.UpstreamList{upstream})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(addresses(endpoints)).To(Equal(map[string]uint32{"10.0.0.1": testPort1, "10.0.0.3": testPort1}))
+		})
+	})
+
+	It("backs off exponentially when polling fails", func() {
+		epw = &edsWatcher{refreshRate: time.Minute, maxBackoff: 5 * time.Second}
+		delay, backoff := epw.nextPollDelay(false, initialBackoff)
+		Expect(delay).To(Equal(time.Second))
+		delay, backoff = epw.nextPollDelay(false, backoff)
+		Expect(delay).To(Equal(2 * time.Second))
+		delay, backoff = epw.nextPollDelay(false, backoff)
+		delay, backoff = epw.nextPollDelay(false, backoff)
+		Expect(delay).To(Equal(5 * time.Second))
+		delay, backoff = epw.nextPollDelay(true, backoff)
+		Expect(delay).To(Equal(time.Minute))
+		Expect(backoff).To(Equal(initialBackoff))
+	})
 })
 
 func matchPollResponse(epw *edsWatcher, expectedList v1.EndpointList) {
@@ -130,6 +215,7 @@ func testEndpointsWatcher(
 type mockListerResponses map[CredentialKey][]*ec2.Instance
 type mockEc2InstanceLister struct {
 	responses mockListerResponses
+	health    map[string]*InstanceHealth
 }
 
 func newMockEc2InstanceLister(responses mockListerResponses) *mockEc2InstanceLister {
@@ -147,6 +233,10 @@ func (m *mockEc2InstanceLister) ListForCredentials(ctx context.Context, cred *Cr
 	return v, nil
 }
 
+func (m *mockEc2InstanceLister) ListHealthForCredentials(ctx context.Context, cred *CredentialSpec, secrets v1.SecretList) (map[string]*InstanceHealth, error) {
+	return m.health, nil
+}
+
 func getSecretClient(ctx context.Context) v1.SecretClient {
 	config := &rest.Config{}
 	mc := memory.NewInMemoryResourceCache()
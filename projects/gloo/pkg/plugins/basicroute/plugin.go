@@ -2,10 +2,8 @@ package basicroute
 
 import (
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
-	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/solo-io/gloo/pkg/utils/regexutils"
-	v32 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/matcher/v3"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/protocol_upgrade"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
@@ -93,7 +91,7 @@ func applyRegexRewrite(params plugins.RouteParams, in *v1.Route, out *envoy_conf
 		return errors.Errorf("internal error: route %v specified a regex, but output Envoy object "+
 			"had nil route", in.Action)
 	}
-	routeAction.Route.RegexRewrite = convertRegexMatchAndSubstitute(params, in.Options.RegexRewrite)
+	routeAction.Route.RegexRewrite = regexutils.ConvertRegexMatchAndSubstitute(params.Ctx, in.Options.RegexRewrite)
 	return nil
 }
 
@@ -186,6 +184,28 @@ func applyUpgrades(in *v1.Route, out *envoy_config_route_v3.Route) error {
 				UpgradeType: upgradeconfig.WebSocketUpgradeType,
 				Enabled:     config.GetWebsocket().GetEnabled(),
 			}
+		case *protocol_upgrade.ProtocolUpgradeConfig_Named:
+			routeAction.Route.UpgradeConfigs[i] = &envoy_config_route_v3.RouteAction_UpgradeConfig{
+				UpgradeType: config.GetNamed().GetName(),
+				Enabled:     config.GetNamed().GetEnabled(),
+			}
+		case *protocol_upgrade.ProtocolUpgradeConfig_Connect:
+			routeAction.Route.UpgradeConfigs[i] = &envoy_config_route_v3.RouteAction_UpgradeConfig{
+				UpgradeType: upgradeconfig.ConnectUpgradeType,
+				Enabled:     config.GetConnect().GetEnabled(),
+			}
+			if terminate := config.GetConnect().GetTerminate(); terminate != nil {
+				routeAction.Route.UpgradeConfigs[i].ConnectConfig = &envoy_config_route_v3.RouteAction_UpgradeConfig_ConnectConfig{
+					AllowPost: terminate.GetAllowPost(),
+				}
+			}
+			// CONNECT requests carry an authority instead of a path, so they never match path matchers
+			if out.GetMatch() == nil {
+				out.Match = &envoy_config_route_v3.RouteMatch{}
+			}
+			out.Match.PathSpecifier = &envoy_config_route_v3.RouteMatch_ConnectMatcher_{
+				ConnectMatcher: &envoy_config_route_v3.RouteMatch_ConnectMatcher{},
+			}
 		default:
 			return errors.Errorf("unimplemented upgrade type: %T", upgradeType)
 		}
@@ -215,23 +235,3 @@ func convertPolicy(policy *retries.RetryPolicy) *envoy_config_route_v3.RetryPoli
 		PerTryTimeout: policy.GetPerTryTimeout(),
 	}
 }
-
-func convertRegexMatchAndSubstitute(params plugins.RouteParams, in *v32.RegexMatchAndSubstitute) *envoy_type_matcher_v3.RegexMatchAndSubstitute {
-	if in == nil {
-		return nil
-	}
-
-	out := &envoy_type_matcher_v3.RegexMatchAndSubstitute{
-		Pattern:      regexutils.NewRegex(params.Ctx, in.Pattern.Regex),
-		Substitution: in.Substitution,
-	}
-	switch inET := in.Pattern.EngineType.(type) {
-	case *v32.RegexMatcher_GoogleRe2:
-		outET := out.Pattern.EngineType.(*envoy_type_matcher_v3.RegexMatcher_GoogleRe2)
-		if inET.GoogleRe2.MaxProgramSize != nil && (outET.GoogleRe2.MaxProgramSize == nil || inET.GoogleRe2.MaxProgramSize.Value < outET.GoogleRe2.MaxProgramSize.Value) {
-			out.Pattern = regexutils.NewRegexWithProgramSize(in.Pattern.Regex, &inET.GoogleRe2.MaxProgramSize.Value)
-		}
-	}
-
-	return out
-}
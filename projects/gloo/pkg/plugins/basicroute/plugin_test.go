@@ -451,4 +451,85 @@ var _ = Describe("upgrades", func() {
 
 		Expect(err).To(MatchError(ContainSubstring("upgrade config websocket is not unique")))
 	})
+	It("configures named and CONNECT upgrades", func() {
+		p := NewPlugin()
+
+		routeAction := &envoy_config_route_v3.RouteAction{}
+
+		out := &envoy_config_route_v3.Route{
+			Match: &envoy_config_route_v3.RouteMatch{
+				PathSpecifier: &envoy_config_route_v3.RouteMatch_Prefix{Prefix: "/"},
+			},
+			Action: &envoy_config_route_v3.Route_Route{
+				Route: routeAction,
+			},
+		}
+
+		err := p.ProcessRoute(plugins.RouteParams{}, &v1.Route{
+			Options: &v1.RouteOptions{
+				Upgrades: []*protocol_upgrade.ProtocolUpgradeConfig{
+					{
+						UpgradeType: &protocol_upgrade.ProtocolUpgradeConfig_Named{
+							Named: &protocol_upgrade.ProtocolUpgradeConfig_NamedProtocolUpgradeSpec{
+								Name: "spdy/3.1",
+							},
+						},
+					},
+					{
+						UpgradeType: &protocol_upgrade.ProtocolUpgradeConfig_Connect{
+							Connect: &protocol_upgrade.ProtocolUpgradeConfig_ConnectSpec{
+								Terminate: &protocol_upgrade.ProtocolUpgradeConfig_ConnectSpec_Termination{
+									AllowPost: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		}, out)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(routeAction.GetUpgradeConfigs()).To(Equal([]*envoy_config_route_v3.RouteAction_UpgradeConfig{
+			{
+				UpgradeType: "spdy/3.1",
+			},
+			{
+				UpgradeType: "CONNECT",
+				ConnectConfig: &envoy_config_route_v3.RouteAction_UpgradeConfig_ConnectConfig{
+					AllowPost: true,
+				},
+			},
+		}))
+		Expect(out.GetMatch().GetConnectMatcher()).NotTo(BeNil())
+	})
+	It("fails on a named upgrade duplicating websocket", func() {
+		p := NewPlugin()
+
+		out := &envoy_config_route_v3.Route{
+			Action: &envoy_config_route_v3.Route_Route{
+				Route: &envoy_config_route_v3.RouteAction{},
+			},
+		}
+
+		err := p.ProcessRoute(plugins.RouteParams{}, &v1.Route{
+			Options: &v1.RouteOptions{
+				Upgrades: []*protocol_upgrade.ProtocolUpgradeConfig{
+					{
+						UpgradeType: &protocol_upgrade.ProtocolUpgradeConfig_Websocket{
+							Websocket: &protocol_upgrade.ProtocolUpgradeConfig_ProtocolUpgradeSpec{},
+						},
+					},
+					{
+						UpgradeType: &protocol_upgrade.ProtocolUpgradeConfig_Named{
+							Named: &protocol_upgrade.ProtocolUpgradeConfig_NamedProtocolUpgradeSpec{
+								Name: "WebSocket",
+							},
+						},
+					},
+				},
+			},
+		}, out)
+
+		Expect(err).To(MatchError(ContainSubstring("upgrade config WebSocket is not unique")))
+	})
 })
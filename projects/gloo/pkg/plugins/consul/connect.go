@@ -0,0 +1,257 @@
+package consul
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	consulapi "github.com/hashicorp/consul/api"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/utils/regexutils"
+	consulplugin "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/consul"
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/go-utils/hashutils"
+)
+
+// The Consul service identity Gloo requests Connect leaf certificates for if none is configured in the settings
+const DefaultConnectServiceName = "gloo"
+
+// Endpoints of Connect-enabled services are labeled with a hash of the Connect certificates, so that a rotation of
+// the leaf certificate or the CA roots changes the endpoints and triggers a translation with the new certificates.
+const ConnectCertificatesLabel = "consul.gloo.solo.io/connect-certificates"
+
+var (
+	NoActiveConnectCARootsErr = eris.New("the Consul Connect CA did not return any root certificates")
+
+	ConnectCertificatesErr = func(err error) error {
+		return eris.Wrap(err, "fetching Consul Connect certificates")
+	}
+)
+
+// The certificates Gloo uses to establish mTLS connections with Connect-enabled services
+type connectCertificates struct {
+	trustDomain string
+	rootsPem    string
+	leaf        *consulapi.LeafCert
+}
+
+// Returns the namespace and partition of the Consul service backing the given upstream.
+// Empty fields default to the values in the Consul settings.
+func (p *plugin) upstreamScope(spec *consulplugin.UpstreamSpec) consul.Scope {
+	scope := consul.Scope{
+		Namespace: spec.GetNamespace(),
+		Partition: spec.GetPartition(),
+	}
+	if scope.Namespace == "" {
+		scope.Namespace = p.settings.GetConsul().GetNamespace()
+	}
+	if scope.Partition == "" {
+		scope.Partition = p.settings.GetConsul().GetPartition()
+	}
+	return scope
+}
+
+// Scopes the query to the namespace and partition of the upstream if it overrides the ones in the settings.
+// The settings themselves are applied by the Consul client.
+func scopeQuery(ctx context.Context, q *consulapi.QueryOptions, spec *consulplugin.UpstreamSpec) *consulapi.QueryOptions {
+	if spec.GetNamespace() == "" && spec.GetPartition() == "" {
+		return q
+	}
+	return q.WithContext(consul.WithScope(ctx, consul.Scope{
+		Namespace: spec.GetNamespace(),
+		Partition: spec.GetPartition(),
+	}))
+}
+
+// Fetches the Connect CA roots and the leaf certificate for Gloo's own service identity from the local agent.
+// The agent caches both and keeps the leaf certificate rotated, so they are only fetched once per translation.
+// Translations are triggered by rotations through the endpoints of Connect-enabled services, see ConnectCertificatesLabel.
+func (p *plugin) getConnectCertificates() (*connectCertificates, error) {
+	if p.connectCerts != nil {
+		return p.connectCerts, nil
+	}
+
+	queryOpts := (&consulapi.QueryOptions{}).WithContext(p.ctx)
+
+	roots, _, err := p.client.ConnectCARoots(queryOpts)
+	if err != nil {
+		return nil, ConnectCertificatesErr(err)
+	}
+	var rootsPem []string
+	for _, root := range roots.Roots {
+		// Trust all the returned roots, so connections keep working while the CA is being rotated
+		rootsPem = append(rootsPem, strings.TrimSpace(root.RootCertPEM))
+	}
+	if len(rootsPem) == 0 {
+		return nil, ConnectCertificatesErr(NoActiveConnectCARootsErr)
+	}
+
+	leaf, _, err := p.client.ConnectCALeaf(p.connectServiceName(), queryOpts)
+	if err != nil {
+		return nil, ConnectCertificatesErr(err)
+	}
+
+	p.connectCerts = &connectCertificates{
+		trustDomain: roots.TrustDomain,
+		rootsPem:    strings.Join(rootsPem, "\n") + "\n",
+		leaf:        leaf,
+	}
+	return p.connectCerts, nil
+}
+
+func (p *plugin) connectServiceName() string {
+	if serviceName := p.settings.GetConsul().GetConnectServiceName(); serviceName != "" {
+		return serviceName
+	}
+	return DefaultConnectServiceName
+}
+
+// Watches Gloo's leaf certificate and the Connect CA roots with blocking queries until the context is cancelled.
+// Whenever either of them changes, e.g. because the agent rotated the leaf certificate before it expires, a hash
+// identifying the current certificates is sent on the returned channel.
+func (p *plugin) watchConnectCertificates(ctx context.Context, wg *sync.WaitGroup, errChan chan<- error) <-chan string {
+	var (
+		lock       sync.Mutex
+		leafSerial string
+		rootIds    []string
+	)
+	versions := make(chan string)
+	publish := func() {
+		lock.Lock()
+		defer lock.Unlock()
+		// wait for both watches to return their first result
+		if leafSerial == "" || rootIds == nil {
+			return
+		}
+		version := strconv.FormatUint(hashutils.MustHash(append([]string{leafSerial}, rootIds...)), 16)
+		select {
+		case versions <- version:
+		case <-ctx.Done():
+		}
+	}
+	onError := func(err error) {
+		select {
+		case errChan <- ConnectCertificatesErr(err):
+		case <-ctx.Done():
+		}
+	}
+
+	serviceName := p.connectServiceName()
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		consul.RunBlockingWatch(ctx, consul.BlockingWatchOpts{Name: "connect-leaf"}, func(q *consulapi.QueryOptions) (*consulapi.QueryMeta, error) {
+			leaf, queryMeta, err := p.client.ConnectCALeaf(serviceName, q)
+			if err != nil {
+				return nil, err
+			}
+			lock.Lock()
+			leafSerial = leaf.SerialNumber
+			lock.Unlock()
+			return queryMeta, nil
+		}, publish, onError)
+	}()
+	go func() {
+		defer wg.Done()
+		consul.RunBlockingWatch(ctx, consul.BlockingWatchOpts{Name: "connect-roots"}, func(q *consulapi.QueryOptions) (*consulapi.QueryMeta, error) {
+			roots, queryMeta, err := p.client.ConnectCARoots(q)
+			if err != nil {
+				return nil, err
+			}
+			ids := []string{}
+			for _, root := range roots.Roots {
+				ids = append(ids, root.ID)
+			}
+			sort.Strings(ids)
+			lock.Lock()
+			rootIds = ids
+			lock.Unlock()
+			return queryMeta, nil
+		}, publish, onError)
+	}()
+	return versions
+}
+
+// Builds the transport socket Envoy uses to connect to the sidecar proxies of a Connect-enabled service.
+// Gloo presents its leaf certificate and only accepts the SPIFFE identity of the target service.
+func (p *plugin) connectTransportSocket(spec *consulplugin.UpstreamSpec) (*envoy_config_core_v3.TransportSocket, error) {
+	certs, err := p.getConnectCertificates()
+	if err != nil {
+		return nil, err
+	}
+
+	tlsContext := &envoyauth.UpstreamTlsContext{
+		CommonTlsContext: &envoyauth.CommonTlsContext{
+			TlsCertificates: []*envoyauth.TlsCertificate{{
+				CertificateChain: inlineDataSource(certs.leaf.CertPEM),
+				PrivateKey:       inlineDataSource(certs.leaf.PrivateKeyPEM),
+			}},
+			ValidationContextType: &envoyauth.CommonTlsContext_ValidationContext{
+				ValidationContext: &envoyauth.CertificateValidationContext{
+					TrustedCa: inlineDataSource(certs.rootsPem),
+					MatchSubjectAltNames: []*envoy_type_matcher_v3.StringMatcher{{
+						MatchPattern: &envoy_type_matcher_v3.StringMatcher_SafeRegex{
+							SafeRegex: regexutils.NewRegexFromSettings(p.settings, p.spiffeIdRegex(certs.trustDomain, spec)),
+						},
+					}},
+				},
+			},
+		},
+	}
+
+	return &envoy_config_core_v3.TransportSocket{
+		Name:       wellknown.TransportSocketTls,
+		ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{TypedConfig: utils.MustMessageToAny(tlsContext)},
+	}, nil
+}
+
+// Matches the SPIFFE IDs Consul issues to the instances of the service, i.e.
+// spiffe://<trust domain>[/ap/<partition>]/ns/<namespace>/dc/<data center>/svc/<service>
+func (p *plugin) spiffeIdRegex(trustDomain string, spec *consulplugin.UpstreamSpec) string {
+	scope := p.upstreamScope(spec)
+
+	namespace := scope.Namespace
+	if namespace == "" {
+		namespace = consul.DefaultScopeName
+	}
+
+	// The partition only appears in the SPIFFE ID if it is not the default one
+	var partition string
+	if scope.Partition != "" && scope.Partition != consul.DefaultScopeName {
+		partition = "/ap/" + regexp.QuoteMeta(scope.Partition)
+	}
+
+	dataCenter := "[^/]+"
+	if len(spec.GetDataCenters()) > 0 {
+		var dataCenters []string
+		for _, dc := range spec.GetDataCenters() {
+			dataCenters = append(dataCenters, regexp.QuoteMeta(dc))
+		}
+		dataCenter = "(" + strings.Join(dataCenters, "|") + ")"
+	}
+
+	return fmt.Sprintf("^spiffe://%s%s/ns/%s/dc/%s/svc/%s$",
+		regexp.QuoteMeta(trustDomain),
+		partition,
+		regexp.QuoteMeta(namespace),
+		dataCenter,
+		regexp.QuoteMeta(spec.GetServiceName()),
+	)
+}
+
+func inlineDataSource(s string) *envoy_config_core_v3.DataSource {
+	return &envoy_config_core_v3.DataSource{
+		Specifier: &envoy_config_core_v3.DataSource_InlineString{
+			InlineString: s,
+		},
+	}
+}
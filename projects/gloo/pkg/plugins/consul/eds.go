@@ -13,6 +13,7 @@ import (
 	"github.com/rotisserie/eris"
 	"github.com/solo-io/gloo/projects/gloo/constants"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	consulplugin "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/consul"
 	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/go-utils/errutils"
@@ -23,35 +24,100 @@ import (
 	"golang.org/x/sync/errgroup"
 )
 
+// The services of a Consul namespace and partition, as returned by the services watch for that scope
+type scopedServiceMeta struct {
+	scope    consul.Scope
+	services []*consul.ServiceMeta
+}
+
 // Starts a watch on the Consul service metadata endpoint for all the services associated with the tracked upstreams.
+// Services are watched once for each namespace and partition the tracked upstreams belong to.
 // Whenever it detects an update to said services, it fetches the complete specs for the tracked services,
 // converts them to endpoints, and sends the result on the returned channel.
+// If any of the tracked upstreams is Connect-enabled, the Connect certificates are watched as well, and the endpoints
+// are sent again whenever the certificates are rotated.
 func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.UpstreamList, opts clients.WatchOpts) (<-chan v1.EndpointList, <-chan error, error) {
 
-	// Filter out non-consul upstreams
-	trackedServiceToUpstreams := make(map[string][]*v1.Upstream)
-	var previousSpecs []*consulapi.CatalogService
-	var previousHash uint64
+	// Filter out non-consul upstreams. Services with the same name in different namespaces or partitions are distinct,
+	// so upstreams and specs are grouped by scope first and by service name second.
+	trackedServiceToUpstreams := make(map[consul.Scope]map[string][]*v1.Upstream)
+	// Upstreams generated for the same service share the namespace, partition and Connect settings
+	trackedServiceToSpec := make(map[consul.Scope]map[string]*consulplugin.UpstreamSpec)
 	for _, us := range upstreamsToTrack {
 		if consulUsSpec := us.GetConsul(); consulUsSpec != nil {
+			scope := p.upstreamScope(consulUsSpec)
+			if _, ok := trackedServiceToSpec[scope]; !ok {
+				trackedServiceToUpstreams[scope] = make(map[string][]*v1.Upstream)
+				trackedServiceToSpec[scope] = make(map[string]*consulplugin.UpstreamSpec)
+			}
 			// We generate one upstream for every Consul service name, so this should never happen.
-			trackedServiceToUpstreams[consulUsSpec.ServiceName] = append(trackedServiceToUpstreams[consulUsSpec.ServiceName], us)
+			trackedServiceToUpstreams[scope][consulUsSpec.ServiceName] = append(trackedServiceToUpstreams[scope][consulUsSpec.ServiceName], us)
+			if _, ok := trackedServiceToSpec[scope][consulUsSpec.ServiceName]; !ok {
+				trackedServiceToSpec[scope][consulUsSpec.ServiceName] = consulUsSpec
+			}
 		}
 	}
+	// Always watch the scope from the settings, so an (empty) initial list of endpoints is sent even without upstreams
+	if defaultScope := p.upstreamScope(nil); trackedServiceToSpec[defaultScope] == nil {
+		trackedServiceToSpec[defaultScope] = make(map[string]*consulplugin.UpstreamSpec)
+	}
 
 	dataCenters, err := p.client.DataCenters()
 	if err != nil {
 		return nil, nil, err
 	}
 
-	serviceMetaChan, servicesWatchErrChan := p.client.WatchServices(opts.Ctx, dataCenters)
-
 	errChan := make(chan error)
-	var wg sync.WaitGroup
-	wg.Add(1)
+	serviceMetaChan := make(chan *scopedServiceMeta)
+	var wg, scopesWg sync.WaitGroup
+
+	connectEnabled := false
+	for _, specs := range trackedServiceToSpec {
+		for _, spec := range specs {
+			connectEnabled = connectEnabled || spec.GetConnectEnabled()
+		}
+	}
+	// Stays nil, i.e. never ready, if there are no Connect-enabled upstreams
+	var connectCertsChan <-chan string
+	if connectEnabled {
+		connectCertsChan = p.watchConnectCertificates(opts.Ctx, &wg, errChan)
+	}
+
+	for scope := range trackedServiceToSpec {
+		// Copy before passing to goroutines!
+		scope := scope
+
+		scopeServiceMetaChan, servicesWatchErrChan := p.client.WatchServices(consul.WithScope(opts.Ctx, scope), dataCenters)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errutils.AggregateErrs(opts.Ctx, errChan, servicesWatchErrChan, "consul eds")
+		}()
+
+		scopesWg.Add(1)
+		go func() {
+			defer scopesWg.Done()
+			for {
+				select {
+				case services, ok := <-scopeServiceMetaChan:
+					if !ok {
+						return
+					}
+					select {
+					case serviceMetaChan <- &scopedServiceMeta{scope: scope, services: services}:
+					case <-opts.Ctx.Done():
+						return
+					}
+				case <-opts.Ctx.Done():
+					return
+				}
+			}
+		}()
+	}
 	go func() {
-		defer wg.Done()
-		errutils.AggregateErrs(opts.Ctx, errChan, servicesWatchErrChan, "consul eds")
+		scopesWg.Wait()
+		close(serviceMetaChan)
 	}()
 
 	endpointsChan := make(chan v1.EndpointList)
@@ -60,14 +126,21 @@ func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.Upstr
 		defer close(endpointsChan)
 		defer wg.Done()
 
-		// Create a new context for each loop, cancel it before each loop
-		var cancel context.CancelFunc = func() {}
-		// Use closure to allow cancel function to be updated as context changes
-		defer func() { cancel() }()
+		// Create a new context for each scope and loop, cancel it before refreshing the specs of the scope again
+		cancels := make(map[consul.Scope]context.CancelFunc)
+		defer func() {
+			for _, cancel := range cancels {
+				cancel()
+			}
+		}()
 
 		timer := time.NewTicker(DefaultDnsPollingInterval)
 		defer timer.Stop()
 
+		previousSpecs := make(map[consul.Scope][]*consulapi.CatalogService)
+		var previousHash uint64
+		var connectCertsVersion string
+
 		publishEndpoints := func(endpoints v1.EndpointList) bool {
 			if opts.Ctx.Err() != nil {
 				return false
@@ -88,25 +161,41 @@ func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.Upstr
 				}
 
 				// Cancel any running requests from previous iteration and set new context/cancel
-				cancel()
-				ctx, newCancel := context.WithCancel(opts.Ctx)
-				cancel = newCancel
+				if cancel, ok := cancels[serviceMeta.scope]; ok {
+					cancel()
+				}
+				ctx, newCancel := context.WithCancel(consul.WithScope(opts.Ctx, serviceMeta.scope))
+				cancels[serviceMeta.scope] = newCancel
 
 				// Here is where the specs are produced; each resulting spec is a grouping of serviceInstances (aka endpoints)
 				// associated with a single consul service on one datacenter.
-				specs := refreshSpecs(ctx, p.client, serviceMeta, errChan)
-				endpoints := buildEndpointsFromSpecs(opts.Ctx, writeNamespace, p.resolver, specs, trackedServiceToUpstreams, p.previousDnsResolutions)
+				previousSpecs[serviceMeta.scope] = refreshSpecs(ctx, p.client, serviceMeta.services, trackedServiceToSpec[serviceMeta.scope], errChan)
+				endpoints := p.buildScopedEndpoints(opts.Ctx, writeNamespace, previousSpecs, trackedServiceToUpstreams, trackedServiceToSpec, connectCertsVersion)
 
 				previousHash = hashutils.MustHash(endpoints)
-				previousSpecs = specs
 
 				if !publishEndpoints(endpoints) {
 					return
 				}
 
+			case version := <-connectCertsChan:
+				// Relabel the endpoints of Connect-enabled services so the rotated certificates get translated
+				connectCertsVersion = version
+				endpoints := p.buildScopedEndpoints(opts.Ctx, writeNamespace, previousSpecs, trackedServiceToUpstreams, trackedServiceToSpec, connectCertsVersion)
+
+				currentHash := hashutils.MustHash(endpoints)
+				if previousHash == currentHash {
+					continue
+				}
+
+				previousHash = currentHash
+				if !publishEndpoints(endpoints) {
+					return
+				}
+
 			case <-timer.C:
 				// Poll to ensure any DNS updates get picked up in endpoints for EDS
-				endpoints := buildEndpointsFromSpecs(opts.Ctx, writeNamespace, p.resolver, previousSpecs, trackedServiceToUpstreams, p.previousDnsResolutions)
+				endpoints := p.buildScopedEndpoints(opts.Ctx, writeNamespace, previousSpecs, trackedServiceToUpstreams, trackedServiceToSpec, connectCertsVersion)
 
 				currentHash := hashutils.MustHash(endpoints)
 				if previousHash == currentHash {
@@ -131,9 +220,51 @@ func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.Upstr
 	return endpointsChan, errChan, nil
 }
 
+// Builds the endpoints for the specs of every scope, matching each spec only with the upstreams in its own scope.
+// The endpoints of Connect-enabled services are labeled with the version of the Connect certificates.
+func (p *plugin) buildScopedEndpoints(
+	ctx context.Context,
+	writeNamespace string,
+	specsByScope map[consul.Scope][]*consulapi.CatalogService,
+	trackedServiceToUpstreams map[consul.Scope]map[string][]*v1.Upstream,
+	trackedServiceToSpec map[consul.Scope]map[string]*consulplugin.UpstreamSpec,
+	connectCertsVersion string,
+) v1.EndpointList {
+	var endpoints v1.EndpointList
+	for scope, specs := range specsByScope {
+		var connectSpecs, plainSpecs []*consulapi.CatalogService
+		for _, spec := range specs {
+			if connectCertsVersion != "" && trackedServiceToSpec[scope][spec.ServiceName].GetConnectEnabled() {
+				connectSpecs = append(connectSpecs, spec)
+			} else {
+				plainSpecs = append(plainSpecs, spec)
+			}
+		}
+		endpoints = append(endpoints, buildEndpointsFromSpecs(ctx, writeNamespace, p.resolver, plainSpecs, trackedServiceToUpstreams[scope], p.previousDnsResolutions)...)
+		for _, endpoint := range buildEndpointsFromSpecs(ctx, writeNamespace, p.resolver, connectSpecs, trackedServiceToUpstreams[scope], p.previousDnsResolutions) {
+			endpoint.Metadata.Labels[ConnectCertificatesLabel] = connectCertsVersion
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+
+	// Sort by name in ascending order for idempotency
+	sort.SliceStable(endpoints, func(i, j int) bool {
+		return endpoints[i].Metadata.Name < endpoints[j].Metadata.Name
+	})
+	return endpoints
+}
+
 // For each service AND data center combination, return a CatalogService that contains a list of all service instances
 // belonging to that service within that datacenter.
-func refreshSpecs(ctx context.Context, client consul.ConsulWatcher, serviceMeta []*consul.ServiceMeta, errChan chan error) []*consulapi.CatalogService {
+// Services are queried in the namespace and partition of their upstream spec. For Connect-enabled services the
+// instances are the sidecar proxies of the service.
+func refreshSpecs(
+	ctx context.Context,
+	client consul.ConsulWatcher,
+	serviceMeta []*consul.ServiceMeta,
+	serviceSpecs map[string]*consulplugin.UpstreamSpec,
+	errChan chan error,
+) []*consulapi.CatalogService {
 	logger := contextutils.LoggerFrom(contextutils.WithLogger(ctx, "consul_eds"))
 
 	specs := newSpecCollector()
@@ -150,8 +281,18 @@ func refreshSpecs(ctx context.Context, client consul.ConsulWatcher, serviceMeta
 			// Get complete spec for each service in parallel
 			eg.Go(func() error {
 				queryOpts := &consulapi.QueryOptions{Datacenter: dcName, RequireConsistent: true}
-
-				services, _, err := client.Service(svc.Name, "", queryOpts.WithContext(ctx))
+				spec := serviceSpecs[svc.Name]
+
+				var (
+					services []*consulapi.CatalogService
+					err      error
+				)
+				if spec.GetConnectEnabled() {
+					services, _, err = client.Connect(svc.Name, "", scopeQuery(ctx, queryOpts.WithContext(ctx), spec))
+					services = toDestinationServices(svc.Name, services)
+				} else {
+					services, _, err = client.Service(svc.Name, "", scopeQuery(ctx, queryOpts.WithContext(ctx), spec))
+				}
 				if err != nil {
 					return err
 				}
@@ -177,6 +318,18 @@ func refreshSpecs(ctx context.Context, client consul.ConsulWatcher, serviceMeta
 	return specs.Get()
 }
 
+// Connect sidecar proxies are registered under their own service name.
+// Rename them after the service they front so they are matched with the upstreams of that service.
+func toDestinationServices(serviceName string, proxies []*consulapi.CatalogService) []*consulapi.CatalogService {
+	var services []*consulapi.CatalogService
+	for _, proxy := range proxies {
+		service := *proxy
+		service.ServiceName = serviceName
+		services = append(services, &service)
+	}
+	return services
+}
+
 // build gloo endpoints out of consul catalog services and gloo upstreams
 // trackedServiceToUpstreams is a map from consul service names to a list of gloo upstreams associated with it.
 // Each spec is a grouping of serviceInstances (aka endpoints) associated with a single consul service on one datacenter.
@@ -6,10 +6,15 @@ import (
 	"net"
 	"sort"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	mock_consul2 "github.com/solo-io/gloo/projects/gloo/pkg/plugins/consul/mocks"
 	proto_matchers "github.com/solo-io/solo-kit/test/matchers"
 
@@ -475,6 +480,195 @@ var _ = Describe("Consul EDS", func() {
 
 	})
 
+	Describe("endpoints watch - namespaces", func() {
+
+		var (
+			ctx               context.Context
+			cancel            context.CancelFunc
+			consulWatcherMock *mock_consul.MockConsulWatcher
+
+			dc1         = "dc-1"
+			dataCenters = []string{dc1}
+			svc1        = "svc-1"
+
+			teamA = consul.Scope{Namespace: "team-a"}
+			teamB = consul.Scope{Namespace: "team-b"}
+
+			serviceMetaProducers map[consul.Scope]chan []*consul.ServiceMeta
+			errorProducer        chan error
+		)
+
+		namespacedUpstream := func(scope consul.Scope) *v1.Upstream {
+			us := createTestUpstream(svc1+"-"+scope.Namespace, svc1, nil, dataCenters)
+			us.GetConsul().Namespace = scope.Namespace
+			return us
+		}
+
+		BeforeEach(func() {
+			ctx, cancel = context.WithCancel(context.Background())
+
+			serviceMetaProducers = map[consul.Scope]chan []*consul.ServiceMeta{
+				teamA: make(chan []*consul.ServiceMeta),
+				teamB: make(chan []*consul.ServiceMeta),
+				{}:    make(chan []*consul.ServiceMeta),
+			}
+			errorProducer = make(chan error)
+
+			addresses := map[consul.Scope]string{teamA: "1.1.0.1", teamB: "1.1.0.2"}
+
+			consulWatcherMock = mock_consul.NewMockConsulWatcher(ctrl)
+			consulWatcherMock.EXPECT().DataCenters().Return(dataCenters, nil).Times(1)
+			consulWatcherMock.EXPECT().WatchServices(gomock.Any(), dataCenters).DoAndReturn(
+				func(ctx context.Context, _ []string) (<-chan []*consul.ServiceMeta, <-chan error) {
+					return serviceMetaProducers[consul.ScopeFromContext(ctx)], errorProducer
+				}).Times(3) // once for each namespace and once for the one in the settings
+			consulWatcherMock.EXPECT().Service(svc1, "", gomock.Any()).DoAndReturn(
+				func(_, _ string, q *consulapi.QueryOptions) ([]*consulapi.CatalogService, *consulapi.QueryMeta, error) {
+					scope := consul.ScopeFromContext(q.Context())
+					return []*consulapi.CatalogService{
+						createTestService(addresses[scope], dc1, svc1, "a", nil, 1234, 100),
+					}, nil, nil
+				}).Times(2) // once for each namespace
+		})
+
+		AfterEach(func() {
+			cancel()
+		})
+
+		It("distinguishes services with the same name in different namespaces", func() {
+			eds := NewPlugin(consulWatcherMock, nil, nil)
+
+			upstreamsToTrack := v1.UpstreamList{namespacedUpstream(teamA), namespacedUpstream(teamB)}
+			endpointsChan, _, err := eds.WatchEndpoints(writeNamespace, upstreamsToTrack, clients.WatchOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+
+			serviceMeta := []*consul.ServiceMeta{{Name: svc1, DataCenters: dataCenters}}
+			serviceMetaProducers[teamA] <- serviceMeta
+			Eventually(endpointsChan).Should(Receive(HaveLen(1)))
+			serviceMetaProducers[teamB] <- serviceMeta
+
+			var endpoints v1.EndpointList
+			Eventually(endpointsChan).Should(Receive(&endpoints))
+			Expect(endpoints).To(HaveLen(2))
+			Expect(endpoints[0].GetAddress()).To(Equal("1.1.0.1"))
+			Expect(endpoints[0].GetUpstreams()).To(ConsistOf(upstreamsToTrack[0].GetMetadata().Ref()))
+			Expect(endpoints[1].GetAddress()).To(Equal("1.1.0.2"))
+			Expect(endpoints[1].GetUpstreams()).To(ConsistOf(upstreamsToTrack[1].GetMetadata().Ref()))
+		})
+	})
+
+	Describe("endpoints watch - connect certificates", func() {
+
+		var (
+			ctx               context.Context
+			cancel            context.CancelFunc
+			consulWatcherMock *mock_consul.MockConsulWatcher
+
+			dc1         = "dc-1"
+			dataCenters = []string{dc1}
+			svc1        = "svc-1"
+
+			serviceMetaProducer chan []*consul.ServiceMeta
+			leafProducer        chan *consulapi.LeafCert
+		)
+
+		BeforeEach(func() {
+			ctx, cancel = context.WithCancel(context.Background())
+
+			serviceMetaProducer = make(chan []*consul.ServiceMeta)
+			leafProducer = make(chan *consulapi.LeafCert)
+
+			consulWatcherMock = mock_consul.NewMockConsulWatcher(ctrl)
+			consulWatcherMock.EXPECT().DataCenters().Return(dataCenters, nil).Times(1)
+			consulWatcherMock.EXPECT().WatchServices(gomock.Any(), dataCenters).Return(serviceMetaProducer, make(chan error)).Times(1)
+			consulWatcherMock.EXPECT().Connect(svc1, "", gomock.Any()).Return([]*consulapi.CatalogService{
+				createTestService("1.1.0.1", dc1, svc1+"-sidecar-proxy", "a", nil, 21000, 100),
+			}, nil, nil).AnyTimes()
+
+			// Like the agent, answer blocking queries once the index moved past the one of the caller
+			consulWatcherMock.EXPECT().ConnectCARoots(gomock.Any()).DoAndReturn(
+				func(q *consulapi.QueryOptions) (*consulapi.CARootList, *consulapi.QueryMeta, error) {
+					if q.WaitIndex >= 1 {
+						<-q.Context().Done()
+						return nil, nil, q.Context().Err()
+					}
+					return &consulapi.CARootList{
+						Roots: []*consulapi.CARoot{{ID: "root", RootCertPEM: "root-pem", Active: true}},
+					}, &consulapi.QueryMeta{LastIndex: 1}, nil
+				}).AnyTimes()
+			var (
+				lock        sync.Mutex
+				currentLeaf *consulapi.LeafCert
+			)
+			consulWatcherMock.EXPECT().ConnectCALeaf(DefaultConnectServiceName, gomock.Any()).DoAndReturn(
+				func(_ string, q *consulapi.QueryOptions) (*consulapi.LeafCert, *consulapi.QueryMeta, error) {
+					lock.Lock()
+					leaf := currentLeaf
+					lock.Unlock()
+					if leaf == nil || q.WaitIndex >= leaf.ModifyIndex {
+						select {
+						case leaf = <-leafProducer:
+						case <-q.Context().Done():
+							return nil, nil, q.Context().Err()
+						}
+						lock.Lock()
+						currentLeaf = leaf
+						lock.Unlock()
+					}
+					return leaf, &consulapi.QueryMeta{LastIndex: leaf.ModifyIndex}, nil
+				}).AnyTimes()
+		})
+
+		AfterEach(func() {
+			cancel()
+		})
+
+		It("resyncs the endpoints of Connect-enabled services when the leaf certificate is rotated", func() {
+			plug := NewPlugin(consulWatcherMock, nil, nil)
+			err := plug.Init(plugins.InitParams{Ctx: ctx, Settings: &v1.Settings{}})
+			Expect(err).NotTo(HaveOccurred())
+
+			us := createTestUpstream(svc1, svc1, nil, dataCenters)
+			us.GetConsul().ConnectEnabled = true
+			endpointsChan, _, err := plug.WatchEndpoints(writeNamespace, v1.UpstreamList{us}, clients.WatchOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+
+			var certsVersion string
+			latestCertsVersion := func() string {
+				select {
+				case endpoints := <-endpointsChan:
+					if len(endpoints) == 1 {
+						certsVersion = endpoints[0].GetMetadata().GetLabels()[ConnectCertificatesLabel]
+					}
+				default:
+				}
+				return certsVersion
+			}
+
+			leafProducer <- &consulapi.LeafCert{SerialNumber: "1", CertPEM: "leaf-1-pem", ModifyIndex: 1}
+			serviceMetaProducer <- []*consul.ServiceMeta{{Name: svc1, DataCenters: dataCenters}}
+			Eventually(latestCertsVersion).ShouldNot(BeEmpty())
+			initialVersion := certsVersion
+
+			leafProducer <- &consulapi.LeafCert{SerialNumber: "2", CertPEM: "leaf-2-pem", ModifyIndex: 2}
+			Eventually(latestCertsVersion).ShouldNot(Equal(initialVersion))
+
+			// The new endpoints trigger a translation, which picks up the rotated certificate
+			err = plug.Init(plugins.InitParams{Ctx: ctx, Settings: &v1.Settings{}})
+			Expect(err).NotTo(HaveOccurred())
+			out := &envoy_config_cluster_v3.Cluster{}
+			err = plug.ProcessUpstream(plugins.Params{}, us, out)
+			Expect(err).NotTo(HaveOccurred())
+
+			var tlsContext envoyauth.UpstreamTlsContext
+			err = ptypes.UnmarshalAny(out.GetTransportSocket().GetTypedConfig(), &tlsContext)
+			Expect(err).NotTo(HaveOccurred())
+			certs := tlsContext.GetCommonTlsContext().GetTlsCertificates()
+			Expect(certs).To(HaveLen(1))
+			Expect(certs[0].GetCertificateChain().GetInlineString()).To(Equal("leaf-2-pem"))
+		})
+	})
+
 	Describe("unit tests", func() {
 		It("generates unique endpoint names", func() {
 
@@ -36,12 +36,14 @@ var (
 )
 
 type plugin struct {
+	ctx                             context.Context
 	client                          consul.ConsulWatcher
 	resolver                        DnsResolver
 	dnsPollingInterval              time.Duration
 	consulUpstreamDiscoverySettings *v1.Settings_ConsulUpstreamDiscoveryConfiguration
 	settings                        *v1.Settings
 	previousDnsResolutions          map[string][]string
+	connectCerts                    *connectCertificates
 }
 
 func (p *plugin) Resolve(u *v1.Upstream) (*url.URL, error) {
@@ -58,7 +60,8 @@ func (p *plugin) Resolve(u *v1.Upstream) (*url.URL, error) {
 		dc = spec.DataCenters[0]
 	}
 
-	instances, _, err := p.client.Service(spec.ServiceName, "", &api.QueryOptions{Datacenter: dc, RequireConsistent: true})
+	queryOpts := scopeQuery(p.ctx, (&api.QueryOptions{Datacenter: dc, RequireConsistent: true}).WithContext(p.ctx), spec)
+	instances, _, err := p.client.Service(spec.ServiceName, "", queryOpts)
 	if err != nil {
 		return nil, eris.Wrapf(err, "getting service from catalog")
 	}
@@ -87,7 +90,7 @@ func (p *plugin) Resolve(u *v1.Upstream) (*url.URL, error) {
 		antiInstanceMatch := len(spec.InstanceBlacklistTags) == 0 || mutuallyExclusiveTags(spec.InstanceBlacklistTags, inst.ServiceTags)
 
 		if instanceMatch && antiInstanceMatch {
-			ipAddresses, err := getIpAddresses(context.TODO(), inst.ServiceAddress, p.resolver)
+			ipAddresses, err := getIpAddresses(p.ctx, inst.ServiceAddress, p.resolver)
 			if err != nil {
 				return nil, err
 			}
@@ -109,11 +112,17 @@ func NewPlugin(client consul.ConsulWatcher, resolver DnsResolver, dnsPollingInte
 		pollingInterval = *dnsPollingInterval
 	}
 	previousDnsResolutions := make(map[string][]string)
-	return &plugin{client: client, resolver: resolver, dnsPollingInterval: pollingInterval, previousDnsResolutions: previousDnsResolutions}
+	return &plugin{ctx: context.Background(), client: client, resolver: resolver, dnsPollingInterval: pollingInterval, previousDnsResolutions: previousDnsResolutions}
 }
 
 func (p *plugin) Init(params plugins.InitParams) error {
+	p.ctx = params.Ctx
+	if p.ctx == nil {
+		p.ctx = context.Background()
+	}
 	p.settings = params.Settings
+	// Fetch fresh Connect certificates for each translation
+	p.connectCerts = nil
 	p.consulUpstreamDiscoverySettings = params.Settings.ConsulDiscovery
 	if p.consulUpstreamDiscoverySettings == nil {
 		p.consulUpstreamDiscoverySettings = &v1.Settings_ConsulUpstreamDiscoveryConfiguration{UseTlsTagging: false}
@@ -136,7 +145,7 @@ func (p *plugin) Init(params plugins.InitParams) error {
 }
 
 func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoy_config_cluster_v3.Cluster) error {
-	_, ok := in.UpstreamType.(*v1.Upstream_Consul)
+	consulSpec, ok := in.UpstreamType.(*v1.Upstream_Consul)
 	if !ok {
 		return nil
 	}
@@ -144,6 +153,15 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 	// consul upstreams use EDS
 	xds.SetEdsOnCluster(out, p.settings)
 
+	// secure connections to Connect-enabled services with mTLS, unless the upstream brings its own TLS configuration
+	if consulSpec.Consul.GetConnectEnabled() && in.GetSslConfig() == nil {
+		transportSocket, err := p.connectTransportSocket(consulSpec.Consul)
+		if err != nil {
+			return err
+		}
+		out.TransportSocket = transportSocket
+	}
+
 	return nil
 }
 
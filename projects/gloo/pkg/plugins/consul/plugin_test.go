@@ -1,8 +1,17 @@
 package consul
 
 import (
+	"context"
 	"net"
 	"net/url"
+	"regexp"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/ptypes"
+	consulplugin "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/consul"
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
 
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
@@ -43,7 +52,7 @@ var _ = Describe("Resolve", func() {
 
 		us := createTestFilteredUpstream(svcName, svcName, nil, []string{tag}, []string{dc})
 
-		queryOpts := &consulapi.QueryOptions{Datacenter: dc, RequireConsistent: true}
+		queryOpts := (&consulapi.QueryOptions{Datacenter: dc, RequireConsistent: true}).WithContext(context.Background())
 
 		consulWatcherMock.EXPECT().Service(svcName, "", queryOpts).Return([]*consulapi.CatalogService{
 			{
@@ -80,7 +89,7 @@ var _ = Describe("Resolve", func() {
 
 		us := createTestFilteredUpstream(svcName, svcName, nil, []string{tag}, []string{dc})
 
-		queryOpts := &consulapi.QueryOptions{Datacenter: dc, RequireConsistent: true}
+		queryOpts := (&consulapi.QueryOptions{Datacenter: dc, RequireConsistent: true}).WithContext(context.Background())
 
 		consulWatcherMock.EXPECT().Service(svcName, "", queryOpts).Return([]*consulapi.CatalogService{
 			{
@@ -109,7 +118,7 @@ var _ = Describe("Resolve", func() {
 
 		us := createTestFilteredUpstream(svcName, svcName, nil, nil, []string{dc})
 
-		queryOpts := &consulapi.QueryOptions{Datacenter: dc, RequireConsistent: true}
+		queryOpts := (&consulapi.QueryOptions{Datacenter: dc, RequireConsistent: true}).WithContext(context.Background())
 
 		consulWatcherMock.EXPECT().Service(svcName, "", queryOpts).Return([]*consulapi.CatalogService{
 			{
@@ -181,3 +190,172 @@ var _ = Describe("Resolve", func() {
 		Expect(err.Error()).To(Equal(ConsulTlsInputError(rootCa.String()).Error()))
 	})
 })
+
+var _ = Describe("Connect", func() {
+	var (
+		ctrl              *gomock.Controller
+		consulWatcherMock *mock_consul.MockConsulWatcher
+		plug              *plugin
+		settings          *v1.Settings
+		us                *v1.Upstream
+	)
+
+	const trustDomain = "11111111-2222-3333-4444-555555555555.consul"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(T)
+		consulWatcherMock = mock_consul.NewMockConsulWatcher(ctrl)
+		plug = NewPlugin(consulWatcherMock, nil, nil)
+		settings = &v1.Settings{Consul: &v1.Settings_ConsulConfiguration{}}
+
+		us = createTestFilteredUpstream("my-svc", "my-svc", nil, nil, []string{"dc1", "dc2"})
+		us.GetConsul().ConnectEnabled = true
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	expectCertificates := func(leafService string) {
+		consulWatcherMock.EXPECT().ConnectCARoots(gomock.Any()).Return(&consulapi.CARootList{
+			TrustDomain: trustDomain,
+			Roots: []*consulapi.CARoot{
+				{ID: "old", RootCertPEM: "old-root-pem\n"},
+				{ID: "new", RootCertPEM: "new-root-pem", Active: true},
+			},
+		}, nil, nil).Times(1)
+		consulWatcherMock.EXPECT().ConnectCALeaf(leafService, gomock.Any()).Return(&consulapi.LeafCert{
+			CertPEM:       "leaf-cert-pem",
+			PrivateKeyPEM: "leaf-key-pem",
+		}, nil, nil).Times(1)
+	}
+
+	processUpstream := func(upstreams ...*v1.Upstream) []*envoy_config_cluster_v3.Cluster {
+		err := plug.Init(plugins.InitParams{Ctx: context.TODO(), Settings: settings})
+		Expect(err).NotTo(HaveOccurred())
+
+		var clusters []*envoy_config_cluster_v3.Cluster
+		for _, upstream := range upstreams {
+			out := &envoy_config_cluster_v3.Cluster{}
+			err = plug.ProcessUpstream(plugins.Params{}, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			clusters = append(clusters, out)
+		}
+		return clusters
+	}
+
+	tlsContext := func(out *envoy_config_cluster_v3.Cluster) *envoyauth.UpstreamTlsContext {
+		Expect(out.GetTransportSocket().GetName()).To(Equal(wellknown.TransportSocketTls))
+		var tlsContext envoyauth.UpstreamTlsContext
+		err := ptypes.UnmarshalAny(out.GetTransportSocket().GetTypedConfig(), &tlsContext)
+		Expect(err).NotTo(HaveOccurred())
+		return &tlsContext
+	}
+
+	sanRegex := func(tlsContext *envoyauth.UpstreamTlsContext) string {
+		matchers := tlsContext.GetCommonTlsContext().GetValidationContext().GetMatchSubjectAltNames()
+		Expect(matchers).To(HaveLen(1))
+		return matchers[0].GetSafeRegex().GetRegex()
+	}
+
+	It("configures mTLS with the Connect certificates", func() {
+		expectCertificates(DefaultConnectServiceName)
+
+		clusters := processUpstream(us)
+
+		tlsContext := tlsContext(clusters[0])
+		certs := tlsContext.GetCommonTlsContext().GetTlsCertificates()
+		Expect(certs).To(HaveLen(1))
+		Expect(certs[0].GetCertificateChain().GetInlineString()).To(Equal("leaf-cert-pem"))
+		Expect(certs[0].GetPrivateKey().GetInlineString()).To(Equal("leaf-key-pem"))
+		Expect(tlsContext.GetCommonTlsContext().GetValidationContext().GetTrustedCa().GetInlineString()).To(Equal("old-root-pem\nnew-root-pem\n"))
+
+		regex := regexp.MustCompile(sanRegex(tlsContext))
+		Expect(regex.MatchString("spiffe://" + trustDomain + "/ns/default/dc/dc2/svc/my-svc")).To(BeTrue())
+		Expect(regex.MatchString("spiffe://" + trustDomain + "/ns/default/dc/dc3/svc/my-svc")).To(BeFalse())
+		Expect(regex.MatchString("spiffe://" + trustDomain + "/ns/default/dc/dc1/svc/other-svc")).To(BeFalse())
+		Expect(regex.MatchString("spiffe://" + trustDomain + "/ns/team-a/dc/dc1/svc/my-svc")).To(BeFalse())
+	})
+
+	It("fetches the certificates only once per translation", func() {
+		expectCertificates(DefaultConnectServiceName)
+
+		other := createTestFilteredUpstream("other-svc", "other-svc", nil, nil, nil)
+		other.GetConsul().ConnectEnabled = true
+		clusters := processUpstream(us, other)
+
+		Expect(regexp.MustCompile(sanRegex(tlsContext(clusters[1]))).MatchString("spiffe://" + trustDomain + "/ns/default/dc/any/svc/other-svc")).To(BeTrue())
+	})
+
+	It("uses the configured service identity, namespace and partition", func() {
+		settings.Consul.ConnectServiceName = "gateway"
+		settings.Consul.Namespace = "team-a"
+		settings.Consul.Partition = "part-a"
+		expectCertificates("gateway")
+
+		clusters := processUpstream(us)
+
+		regex := regexp.MustCompile(sanRegex(tlsContext(clusters[0])))
+		Expect(regex.MatchString("spiffe://" + trustDomain + "/ap/part-a/ns/team-a/dc/dc1/svc/my-svc")).To(BeTrue())
+		Expect(regex.MatchString("spiffe://" + trustDomain + "/ns/team-a/dc/dc1/svc/my-svc")).To(BeFalse())
+	})
+
+	It("lets the upstream override the namespace and partition", func() {
+		settings.Consul.Namespace = "team-a"
+		settings.Consul.Partition = "part-a"
+		us.GetConsul().Namespace = "team-b"
+		us.GetConsul().Partition = "default"
+		expectCertificates(DefaultConnectServiceName)
+
+		clusters := processUpstream(us)
+
+		regex := regexp.MustCompile(sanRegex(tlsContext(clusters[0])))
+		Expect(regex.MatchString("spiffe://" + trustDomain + "/ns/team-b/dc/dc1/svc/my-svc")).To(BeTrue())
+	})
+
+	It("does not override the ssl config of the upstream", func() {
+		us.SslConfig = &v1.UpstreamSslConfig{Sni: "my-svc"}
+
+		clusters := processUpstream(us)
+
+		Expect(clusters[0].GetTransportSocket()).To(BeNil())
+	})
+
+	It("does not configure mTLS for services that are not Connect-enabled", func() {
+		us.GetConsul().ConnectEnabled = false
+
+		clusters := processUpstream(us)
+
+		Expect(clusters[0].GetTransportSocket()).To(BeNil())
+	})
+
+	It("returns an error if the CA has no roots", func() {
+		consulWatcherMock.EXPECT().ConnectCARoots(gomock.Any()).Return(&consulapi.CARootList{TrustDomain: trustDomain}, nil, nil).Times(1)
+
+		err := plug.Init(plugins.InitParams{Ctx: context.TODO(), Settings: settings})
+		Expect(err).NotTo(HaveOccurred())
+		err = plug.ProcessUpstream(plugins.Params{}, us, &envoy_config_cluster_v3.Cluster{})
+		Expect(err).To(MatchError(ConnectCertificatesErr(NoActiveConnectCARootsErr).Error()))
+	})
+
+	It("queries the sidecar proxies of Connect-enabled services in the namespace of the upstream", func() {
+		us.GetConsul().Namespace = "team-b"
+		serviceMeta := []*consul.ServiceMeta{{Name: "my-svc", DataCenters: []string{"dc1"}}}
+
+		consulWatcherMock.EXPECT().Connect("my-svc", "", gomock.Any()).DoAndReturn(
+			func(_, _ string, q *consulapi.QueryOptions) ([]*consulapi.CatalogService, *consulapi.QueryMeta, error) {
+				Expect(q.Datacenter).To(Equal("dc1"))
+				Expect(consul.ScopeFromContext(q.Context())).To(Equal(consul.Scope{Namespace: "team-b"}))
+				return []*consulapi.CatalogService{
+					createTestService("1.2.3.4", "dc1", "my-svc-sidecar-proxy", "proxy-1", nil, 21000, 1),
+				}, nil, nil
+			}).Times(1)
+
+		specs := refreshSpecs(context.TODO(), consulWatcherMock, serviceMeta, map[string]*consulplugin.UpstreamSpec{"my-svc": us.GetConsul()}, make(chan error, 1))
+
+		Expect(specs).To(HaveLen(1))
+		Expect(specs[0].ServiceName).To(Equal("my-svc"))
+		Expect(specs[0].ServiceID).To(Equal("proxy-1"))
+		Expect(specs[0].ServicePort).To(Equal(21000))
+	})
+})
@@ -0,0 +1,286 @@
+package grpc
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	errors "github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	glooplugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
+	grpcapi "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc"
+	"google.golang.org/genproto/googleapis/api/annotations"
+)
+
+var (
+	NotGrpcUpstreamErr = func(ref fmt.Stringer) error {
+		return errors.Errorf("%v does not have a grpc service spec", ref)
+	}
+	InvalidPathTemplateErr = func(template string) error {
+		return errors.Errorf("invalid google.api.http path template %q", template)
+	}
+)
+
+// Generates a route for every google.api.http binding of the functions of a gRPC upstream, so that the functions
+// can be published as a REST API without writing a route per function. The routes match the path template and the
+// method of the binding; the gRPC-JSON transcoder maps path variables, query parameters and the body to the request.
+func HttpRuleRoutes(upstream *v1.Upstream) ([]*v1.Route, error) {
+	upstreamType, ok := upstream.GetUpstreamType().(v1.ServiceSpecGetter)
+	if !ok {
+		return nil, NotGrpcUpstreamErr(upstream.GetMetadata().Ref())
+	}
+	grpcWrapper, ok := upstreamType.GetServiceSpec().GetPluginType().(*glooplugins.ServiceSpec_Grpc)
+	if !ok || grpcWrapper.Grpc == nil {
+		return nil, NotGrpcUpstreamErr(upstream.GetMetadata().Ref())
+	}
+	descriptors, err := convertProto(grpcWrapper.Grpc.GetDescriptors())
+	if err != nil {
+		return nil, errors.Wrapf(err, "parsing grpc spec as a proto descriptor set")
+	}
+
+	var routes []*v1.Route
+	for _, svc := range grpcWrapper.Grpc.GetGrpcServices() {
+		for _, method := range serviceMethods(svc, descriptors) {
+			rule := httpRule(method)
+			if rule == nil {
+				continue
+			}
+			fullServiceName := genFullServiceName(svc.GetPackageName(), svc.GetServiceName())
+			for i, binding := range httpRuleBindings(rule) {
+				matcher, err := httpRuleMatcher(binding)
+				if err != nil {
+					return nil, errors.Wrapf(err, "generating route for %s.%s", fullServiceName, method.GetName())
+				}
+				name := fullServiceName + "." + method.GetName()
+				if i > 0 {
+					name = fmt.Sprintf("%s-%d", name, i)
+				}
+				routes = append(routes, &v1.Route{
+					Name:     name,
+					Matchers: []*matchers.Matcher{matcher},
+					Action: &v1.Route_RouteAction{
+						RouteAction: &v1.RouteAction{
+							Destination: &v1.RouteAction_Single{
+								Single: &v1.Destination{
+									DestinationType: &v1.Destination_Upstream{
+										Upstream: upstream.GetMetadata().Ref(),
+									},
+									DestinationSpec: &v1.DestinationSpec{
+										DestinationType: &v1.DestinationSpec_Grpc{
+											Grpc: &grpcapi.DestinationSpec{
+												Package:           svc.GetPackageName(),
+												Service:           svc.GetServiceName(),
+												Function:          method.GetName(),
+												UseHttpAnnotation: true,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				})
+			}
+		}
+	}
+	return routes, nil
+}
+
+func serviceMethods(svc *grpcapi.ServiceSpec_GrpcService, set *descriptor.FileDescriptorSet) []*descriptor.MethodDescriptorProto {
+	for _, file := range set.GetFile() {
+		if file.GetPackage() != svc.GetPackageName() {
+			continue
+		}
+		for _, service := range file.GetService() {
+			if service.GetName() == svc.GetServiceName() {
+				return service.GetMethod()
+			}
+		}
+	}
+	return nil
+}
+
+// Returns the google.api.http annotation of the method, if it has one
+func httpRule(method *descriptor.MethodDescriptorProto) *annotations.HttpRule {
+	if method.GetOptions() == nil || !proto.HasExtension(method.GetOptions(), annotations.E_Http) {
+		return nil
+	}
+	ext, err := proto.GetExtension(method.GetOptions(), annotations.E_Http)
+	if err != nil {
+		return nil
+	}
+	rule, _ := ext.(*annotations.HttpRule)
+	return rule
+}
+
+// Flattens a rule and its additional bindings, which may not be nested.
+func httpRuleBindings(rule *annotations.HttpRule) []*annotations.HttpRule {
+	primary := proto.Clone(rule).(*annotations.HttpRule)
+	primary.AdditionalBindings = nil
+	bindings := []*annotations.HttpRule{primary}
+	for _, binding := range rule.GetAdditionalBindings() {
+		binding = proto.Clone(binding).(*annotations.HttpRule)
+		binding.AdditionalBindings = nil
+		bindings = append(bindings, binding)
+	}
+	return bindings
+}
+
+func httpRuleMatcher(rule *annotations.HttpRule) (*matchers.Matcher, error) {
+	var method, template string
+	switch pattern := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		method, template = "GET", pattern.Get
+	case *annotations.HttpRule_Put:
+		method, template = "PUT", pattern.Put
+	case *annotations.HttpRule_Post:
+		method, template = "POST", pattern.Post
+	case *annotations.HttpRule_Delete:
+		method, template = "DELETE", pattern.Delete
+	case *annotations.HttpRule_Patch:
+		method, template = "PATCH", pattern.Patch
+	case *annotations.HttpRule_Custom:
+		method, template = pattern.Custom.GetKind(), pattern.Custom.GetPath()
+	default:
+		return nil, errors.Errorf("google.api.http rule without a pattern")
+	}
+
+	regex, literal, err := pathTemplateRegex(template)
+	if err != nil {
+		return nil, err
+	}
+	matcher := &matchers.Matcher{Methods: []string{method}}
+	if literal {
+		matcher.PathSpecifier = &matchers.Matcher_Exact{Exact: template}
+	} else {
+		matcher.PathSpecifier = &matchers.Matcher_Regex{Regex: regex}
+	}
+	return matcher, nil
+}
+
+// Converts a google.api.http path template to a regex matching the paths it describes, i.e.:
+//
+//  Template = "/" Segments [ Verb ] ;
+//  Segments = Segment { "/" Segment } ;
+//  Segment  = "*" | "**" | LITERAL | Variable ;
+//  Variable = "{" FieldPath [ "=" Segments ] "}" ;
+//  Verb     = ":" LITERAL ;
+//
+// Also returns whether the template is a literal path.
+func pathTemplateRegex(template string) (string, bool, error) {
+	if !strings.HasPrefix(template, "/") {
+		return "", false, InvalidPathTemplateErr(template)
+	}
+	path, verb := splitVerb(template[1:])
+	if path == "" {
+		return "/", verb == "", nil
+	}
+	segments, err := splitSegments(path)
+	if err != nil {
+		return "", false, InvalidPathTemplateErr(template)
+	}
+	regex, literal, err := segmentsRegex(segments, true)
+	if err != nil {
+		return "", false, InvalidPathTemplateErr(template)
+	}
+	if verb != "" {
+		regex += regexp.QuoteMeta(":" + verb)
+	}
+	return "/" + regex, literal, nil
+}
+
+// The verb is the part after the last colon that is outside of a variable and after the last slash.
+func splitVerb(path string) (string, string) {
+	depth := 0
+	for i := len(path) - 1; i >= 0; i-- {
+		switch path[i] {
+		case '}':
+			depth++
+		case '{':
+			depth--
+		case '/':
+			if depth == 0 {
+				return path, ""
+			}
+		case ':':
+			if depth == 0 {
+				return path[:i], path[i+1:]
+			}
+		}
+	}
+	return path, ""
+}
+
+func splitSegments(path string) ([]string, error) {
+	var segments []string
+	depth, start := 0, 0
+	for i, c := range path {
+		switch c {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth < 0 {
+				return nil, errors.New("unbalanced braces")
+			}
+		case '/':
+			if depth == 0 {
+				segments = append(segments, path[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, errors.New("unbalanced braces")
+	}
+	return append(segments, path[start:]), nil
+}
+
+func segmentsRegex(segments []string, allowVariables bool) (string, bool, error) {
+	literal := true
+	parts := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		switch {
+		case segment == "":
+			return "", false, errors.New("empty segment")
+		case segment == "*":
+			literal = false
+			parts = append(parts, "[^/]+")
+		case segment == "**":
+			literal = false
+			parts = append(parts, ".*")
+		case strings.HasPrefix(segment, "{"):
+			if !allowVariables || !strings.HasSuffix(segment, "}") {
+				return "", false, errors.New("invalid variable")
+			}
+			literal = false
+			variable := segment[1 : len(segment)-1]
+			fieldPathAndSegments := strings.SplitN(variable, "=", 2)
+			if fieldPathAndSegments[0] == "" {
+				return "", false, errors.New("variable without a field path")
+			}
+			if len(fieldPathAndSegments) == 1 {
+				parts = append(parts, "[^/]+")
+				continue
+			}
+			nestedSegments, err := splitSegments(fieldPathAndSegments[1])
+			if err != nil {
+				return "", false, err
+			}
+			regex, _, err := segmentsRegex(nestedSegments, false)
+			if err != nil {
+				return "", false, err
+			}
+			parts = append(parts, regex)
+		default:
+			if strings.ContainsAny(segment, "{}") {
+				return "", false, errors.New("invalid literal")
+			}
+			parts = append(parts, regexp.QuoteMeta(segment))
+		}
+	}
+	return strings.Join(parts, "/"), literal, nil
+}
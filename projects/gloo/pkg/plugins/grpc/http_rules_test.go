@@ -0,0 +1,147 @@
+package grpc
+
+import (
+	"encoding/base64"
+	"regexp"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	pluginsv1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
+	v1grpc "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc"
+	v1static "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"google.golang.org/genproto/googleapis/api/annotations"
+)
+
+// Returns base64 encoded descriptors of the foo.bar service, like function discovery stores them
+func annotatedDescriptors(rules map[string]*annotations.HttpRule, methods ...string) []byte {
+	svc := &descriptor.ServiceDescriptorProto{Name: proto.String("bar")}
+	for _, name := range methods {
+		method := &descriptor.MethodDescriptorProto{
+			Name:       proto.String(name),
+			InputType:  proto.String(".foo.Request"),
+			OutputType: proto.String(".foo.Response"),
+		}
+		if rule, ok := rules[name]; ok {
+			method.Options = &descriptor.MethodOptions{}
+			Expect(proto.SetExtension(method.Options, annotations.E_Http, rule)).NotTo(HaveOccurred())
+		}
+		svc.Method = append(svc.Method, method)
+	}
+	set := &descriptor.FileDescriptorSet{File: []*descriptor.FileDescriptorProto{{
+		Name:    proto.String("foo.proto"),
+		Package: proto.String("foo"),
+		MessageType: []*descriptor.DescriptorProto{
+			{Name: proto.String("Request")},
+			{Name: proto.String("Response")},
+		},
+		Service: []*descriptor.ServiceDescriptorProto{svc},
+	}}}
+	b, err := proto.Marshal(set)
+	Expect(err).NotTo(HaveOccurred())
+	return []byte(base64.StdEncoding.EncodeToString(b))
+}
+
+var _ = Describe("google.api.http rules", func() {
+
+	DescribeTable("path templates",
+		func(template, path string, matches bool) {
+			regex, literal, err := pathTemplateRegex(template)
+			Expect(err).NotTo(HaveOccurred())
+			if literal {
+				Expect(path == template).To(Equal(matches))
+				return
+			}
+			Expect(regexp.MustCompile("^" + regex + "$").MatchString(path)).To(Equal(matches))
+		},
+		Entry("literal", "/v1/shelves", "/v1/shelves", true),
+		Entry("variable", "/v1/shelves/{shelf}", "/v1/shelves/1", true),
+		Entry("variable spans one segment", "/v1/shelves/{shelf}", "/v1/shelves/1/books", false),
+		Entry("variable with segments", "/v1/{name=shelves/*/books/*}", "/v1/shelves/1/books/2", true),
+		Entry("variable with segments does not match other paths", "/v1/{name=shelves/*/books/*}", "/v1/shelves/1/authors/2", false),
+		Entry("multi segment wildcard", "/v1/{name=files/**}", "/v1/files/a/b/c", true),
+		Entry("verb", "/v1/shelves/{shelf}:clear", "/v1/shelves/1:clear", true),
+		Entry("verb is required", "/v1/shelves/{shelf}:clear", "/v1/shelves/1", false),
+		Entry("literals are quoted", "/v1/a.b", "/v1/aXb", false),
+	)
+
+	DescribeTable("invalid path templates",
+		func(template string) {
+			_, _, err := pathTemplateRegex(template)
+			Expect(err).To(MatchError(InvalidPathTemplateErr(template)))
+		},
+		Entry("relative", "v1/shelves"),
+		Entry("unbalanced", "/v1/{shelf"),
+		Entry("nested variables", "/v1/{name=shelves/{shelf}}"),
+		Entry("empty segment", "/v1//shelves"),
+		Entry("variable without a field", "/v1/{=shelves/*}"),
+	)
+
+	Context("routes", func() {
+		var upstream *v1.Upstream
+
+		BeforeEach(func() {
+			upstream = &v1.Upstream{
+				Metadata: &core.Metadata{Name: "test", Namespace: "default"},
+				UpstreamType: &v1.Upstream_Static{
+					Static: &v1static.UpstreamSpec{
+						ServiceSpec: &pluginsv1.ServiceSpec{
+							PluginType: &pluginsv1.ServiceSpec_Grpc{
+								Grpc: &v1grpc.ServiceSpec{
+									Descriptors: annotatedDescriptors(map[string]*annotations.HttpRule{
+										"GetShelf": {
+											Pattern: &annotations.HttpRule_Get{Get: "/v1/shelves/{shelf}"},
+											AdditionalBindings: []*annotations.HttpRule{{
+												Pattern: &annotations.HttpRule_Custom{Custom: &annotations.CustomHttpPattern{Kind: "HEAD", Path: "/v1/shelves/{shelf}"}},
+											}},
+										},
+										"CreateShelf": {
+											Pattern: &annotations.HttpRule_Post{Post: "/v1/shelves"},
+											Body:    "shelf",
+										},
+									}, "GetShelf", "CreateShelf", "Internal"),
+									GrpcServices: []*v1grpc.ServiceSpec_GrpcService{{
+										PackageName:   "foo",
+										ServiceName:   "bar",
+										FunctionNames: []string{"GetShelf", "CreateShelf", "Internal"},
+									}},
+								},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("generates a route per binding of the annotated functions", func() {
+			routes, err := HttpRuleRoutes(upstream)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(routes).To(HaveLen(3))
+
+			Expect(routes[0].GetName()).To(Equal("foo.bar.GetShelf"))
+			Expect(routes[0].GetMatchers()[0].GetRegex()).To(Equal("/v1/shelves/[^/]+"))
+			Expect(routes[0].GetMatchers()[0].GetMethods()).To(Equal([]string{"GET"}))
+			grpcDest := routes[0].GetRouteAction().GetSingle().GetDestinationSpec().GetGrpc()
+			Expect(grpcDest.GetFunction()).To(Equal("GetShelf"))
+			Expect(grpcDest.GetUseHttpAnnotation()).To(BeTrue())
+			Expect(routes[0].GetRouteAction().GetSingle().GetUpstream().GetName()).To(Equal("test"))
+
+			Expect(routes[1].GetName()).To(Equal("foo.bar.GetShelf-1"))
+			Expect(routes[1].GetMatchers()[0].GetMethods()).To(Equal([]string{"HEAD"}))
+
+			Expect(routes[2].GetName()).To(Equal("foo.bar.CreateShelf"))
+			Expect(routes[2].GetMatchers()[0].GetExact()).To(Equal("/v1/shelves"))
+			Expect(routes[2].GetMatchers()[0].GetMethods()).To(Equal([]string{"POST"}))
+		})
+
+		It("fails for upstreams without a grpc service spec", func() {
+			upstream.GetStatic().ServiceSpec = nil
+			_, err := HttpRuleRoutes(upstream)
+			Expect(err).To(MatchError(NotGrpcUpstreamErr(upstream.GetMetadata().Ref())))
+		})
+	})
+})
@@ -44,8 +44,9 @@ var _ plugins.HttpFilterPlugin = &plugin{}
 
 func NewPlugin(transformsAdded *bool) *plugin {
 	return &plugin{
-		recordedUpstreams: make(map[string]*v1.Upstream),
-		transformsAdded:   transformsAdded,
+		recordedUpstreams:  make(map[string]*v1.Upstream),
+		annotatedFunctions: make(map[string]bool),
+		transformsAdded:    transformsAdded,
 	}
 }
 
@@ -53,6 +54,8 @@ type plugin struct {
 	transformsAdded   *bool
 	recordedUpstreams map[string]*v1.Upstream
 	upstreamServices  []ServicesAndDescriptor
+	// functions with a google.api.http annotation, by the path gloo transforms their requests to
+	annotatedFunctions map[string]bool
 
 	ctx context.Context
 }
@@ -96,7 +99,7 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 	for _, svc := range grpcSpec.GrpcServices {
 
 		// find the relevant service
-		err := addHttpRulesToProto(in, svc, descriptors)
+		err := p.addHttpRulesToProto(in, svc, descriptors)
 		if err != nil {
 			return errors.Wrapf(err, "failed to generate http rules for service %s in proto descriptors", svc.ServiceName)
 		}
@@ -151,6 +154,10 @@ func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 			// copy as it might be modified
 			grpcDestinationSpec := *grpcDestinationSpecWrapper.Grpc
 
+			if grpcDestinationSpec.UseHttpAnnotation {
+				return nil, p.validateHttpAnnotation(spec, &grpcDestinationSpec)
+			}
+
 			if grpcDestinationSpec.Parameters == nil {
 				if out.Match.PathSpecifier == nil {
 					return nil, errors.New("missing path for grpc route")
@@ -215,8 +222,25 @@ func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 	)
 }
 
+// the transcoder maps the request by the annotation of the function, so gloo leaves it untouched
+func (p *plugin) validateHttpAnnotation(spec *v1.Destination, grpcDestinationSpec *grpcapi.DestinationSpec) error {
+	upstreamRef, err := upstreams.DestinationToUpstreamRef(spec)
+	if err != nil {
+		return err
+	}
+	upstream := p.recordedUpstreams[translator.UpstreamToClusterName(upstreamRef)]
+	if upstream == nil {
+		return errors.New("upstream was not recorded for grpc route")
+	}
+	fullServiceName := genFullServiceName(grpcDestinationSpec.Package, grpcDestinationSpec.Service)
+	if !p.annotatedFunctions[httpPath(upstream, fullServiceName, grpcDestinationSpec.Function)] {
+		return errors.Errorf("function %s.%s does not have a google.api.http annotation", fullServiceName, grpcDestinationSpec.Function)
+	}
+	return nil
+}
+
 // returns package name
-func addHttpRulesToProto(upstream *v1.Upstream, currentsvc *grpcapi.ServiceSpec_GrpcService, set *descriptor.FileDescriptorSet) error {
+func (p *plugin) addHttpRulesToProto(upstream *v1.Upstream, currentsvc *grpcapi.ServiceSpec_GrpcService, set *descriptor.FileDescriptorSet) error {
 	for _, file := range set.File {
 		if file.Package == nil || *file.Package != currentsvc.PackageName {
 			continue
@@ -227,14 +251,22 @@ func addHttpRulesToProto(upstream *v1.Upstream, currentsvc *grpcapi.ServiceSpec_
 			}
 			for _, method := range svc.Method {
 				fullServiceName := genFullServiceName(currentsvc.PackageName, currentsvc.ServiceName)
+				path := httpPath(upstream, fullServiceName, *method.Name)
+				// keep the bindings the function is annotated with, for routes that rely on them
+				var bindings []*annotations.HttpRule
+				if rule := httpRule(method); rule != nil {
+					bindings = httpRuleBindings(rule)
+					p.annotatedFunctions[path] = true
+				}
 				if method.Options == nil {
 					method.Options = &descriptor.MethodOptions{}
 				}
 				if err := proto.SetExtension(method.Options, annotations.E_Http, &annotations.HttpRule{
 					Pattern: &annotations.HttpRule_Post{
-						Post: httpPath(upstream, fullServiceName, *method.Name),
+						Post: path,
 					},
-					Body: "*",
+					Body:               "*",
+					AdditionalBindings: bindings,
 				}); err != nil {
 					return errors.Wrap(err, "setting http extensions for method.Options")
 				}
@@ -18,6 +18,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/transformation"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"google.golang.org/genproto/googleapis/api/annotations"
 )
 
 var _ = Describe("Plugin", func() {
@@ -196,5 +197,55 @@ var _ = Describe("Plugin", func() {
 			Expect(subMatches[extrs["ever"].Subgroup]).To(Equal("second%34value"))
 			Expect(subMatches[extrs["nested.field"].Subgroup]).To(Equal("third-value"))
 		})
+
+		Context("http annotations", func() {
+			BeforeEach(func() {
+				grpcSpec.Grpc.Descriptors = annotatedDescriptors(map[string]*annotations.HttpRule{
+					"func": {Pattern: &annotations.HttpRule_Get{Get: "/v1/things/{id}"}},
+				}, "func", "other")
+				grpcSpec.Grpc.GrpcServices[0].FunctionNames = []string{"func", "other"}
+				routeIn.GetRouteAction().GetSingle().GetDestinationSpec().GetGrpc().UseHttpAnnotation = true
+			})
+
+			It("keeps the annotated bindings for the transcoder", func() {
+				err := p.ProcessUpstream(params, upstream, out)
+				Expect(err).NotTo(HaveOccurred())
+
+				var rule *annotations.HttpRule
+				for _, file := range p.upstreamServices[0].Descriptors.GetFile() {
+					for _, svc := range file.GetService() {
+						for _, method := range svc.GetMethod() {
+							if method.GetName() == "func" {
+								rule = httpRule(method)
+							}
+						}
+					}
+				}
+				Expect(rule.GetPost()).To(HaveSuffix("/test/foo.bar/func"))
+				Expect(rule.GetAdditionalBindings()).To(HaveLen(1))
+				Expect(rule.GetAdditionalBindings()[0].GetGet()).To(Equal("/v1/things/{id}"))
+			})
+
+			It("does not transform requests routed by their annotation", func() {
+				err := p.ProcessUpstream(params, upstream, out)
+				Expect(err).NotTo(HaveOccurred())
+
+				var routeParams plugins.RouteParams
+				err = p.ProcessRoute(routeParams, routeIn, routeOut)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(routeOut.GetTypedPerFilterConfig()).NotTo(HaveKey(transformation.FilterName))
+			})
+
+			It("fails for functions without an annotation", func() {
+				routeIn.GetRouteAction().GetSingle().GetDestinationSpec().GetGrpc().Function = "other"
+				err := p.ProcessUpstream(params, upstream, out)
+				Expect(err).NotTo(HaveOccurred())
+
+				var routeParams plugins.RouteParams
+				err = p.ProcessRoute(routeParams, routeIn, routeOut)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("function foo.bar.other does not have a google.api.http annotation"))
+			})
+		})
 	})
 })
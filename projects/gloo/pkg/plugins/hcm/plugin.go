@@ -164,6 +164,29 @@ func copyCoreHcmSettings(ctx context.Context, cfg *envoyhttp.HttpConnectionManag
 			}
 
 			webSocketUpgradeSpecified = true
+		case *protocol_upgrade.ProtocolUpgradeConfig_Named:
+			cfg.UpgradeConfigs[i] = &envoyhttp.HttpConnectionManager_UpgradeConfig{
+				UpgradeType: config.GetNamed().GetName(),
+				Enabled:     config.GetNamed().GetEnabled(),
+			}
+
+			if upgradeconfig.IsUpgradeType(config.GetNamed().GetName(), upgradeconfig.WebSocketUpgradeType) {
+				webSocketUpgradeSpecified = true
+			}
+		case *protocol_upgrade.ProtocolUpgradeConfig_Connect:
+			if config.GetConnect().GetTerminate() != nil {
+				return errors.Errorf("CONNECT termination can only be configured on routes")
+			}
+			cfg.UpgradeConfigs[i] = &envoyhttp.HttpConnectionManager_UpgradeConfig{
+				UpgradeType: upgradeconfig.ConnectUpgradeType,
+				Enabled:     config.GetConnect().GetEnabled(),
+			}
+
+			// CONNECT over HTTP/2 must be explicitly allowed
+			if cfg.GetHttp2ProtocolOptions() == nil {
+				cfg.Http2ProtocolOptions = &envoycore.Http2ProtocolOptions{}
+			}
+			cfg.Http2ProtocolOptions.AllowConnect = true
 		default:
 			return errors.Errorf("unimplemented upgrade type: %T", upgradeType)
 		}
@@ -310,5 +310,50 @@ var _ = Describe("Plugin", func() {
 
 		})
 
+		It("configures named and CONNECT upgrades alongside the default websocket upgrade", func() {
+			hcms.Upgrades = []*protocol_upgrade.ProtocolUpgradeConfig{
+				{
+					UpgradeType: &protocol_upgrade.ProtocolUpgradeConfig_Named{
+						Named: &protocol_upgrade.ProtocolUpgradeConfig_NamedProtocolUpgradeSpec{
+							Name: "h2c",
+						},
+					},
+				},
+				{
+					UpgradeType: &protocol_upgrade.ProtocolUpgradeConfig_Connect{
+						Connect: &protocol_upgrade.ProtocolUpgradeConfig_ConnectSpec{},
+					},
+				},
+			}
+
+			err := p.ProcessListener(plugins.Params{}, in, outl)
+			Expect(err).NotTo(HaveOccurred())
+
+			var cfg envoyhttp.HttpConnectionManager
+			err = translatorutil.ParseTypedConfig(filters[0], &cfg)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(len(cfg.GetUpgradeConfigs())).To(Equal(3))
+			Expect(cfg.GetUpgradeConfigs()[0].UpgradeType).To(Equal("h2c"))
+			Expect(cfg.GetUpgradeConfigs()[1].UpgradeType).To(Equal("CONNECT"))
+			Expect(cfg.GetUpgradeConfigs()[2].UpgradeType).To(Equal("websocket"))
+			Expect(cfg.GetHttp2ProtocolOptions().GetAllowConnect()).To(BeTrue())
+		})
+
+		It("should error when CONNECT termination is configured on the listener", func() {
+			hcms.Upgrades = []*protocol_upgrade.ProtocolUpgradeConfig{
+				{
+					UpgradeType: &protocol_upgrade.ProtocolUpgradeConfig_Connect{
+						Connect: &protocol_upgrade.ProtocolUpgradeConfig_ConnectSpec{
+							Terminate: &protocol_upgrade.ProtocolUpgradeConfig_ConnectSpec_Termination{},
+						},
+					},
+				},
+			}
+
+			err := p.ProcessListener(plugins.Params{}, in, outl)
+			Expect(err).To(MatchError(ContainSubstring("CONNECT termination can only be configured on routes")))
+		})
+
 	})
 })
@@ -3,12 +3,14 @@ package healthcheck
 import (
 	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoyhealthcheck "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/health_check/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	errors "github.com/rotisserie/eris"
 
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
 )
 
 // filter info
@@ -42,8 +44,17 @@ func (p *Plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) (
 		return nil, errors.Errorf("health check path cannot be \"\"")
 	}
 
+	passThrough := healthCheck.GetPassThroughMode().GetValue()
+	if healthCheck.GetCacheTime() != nil && !passThrough {
+		return nil, errors.Errorf("health check cache time can only be set in pass through mode")
+	}
+	if len(healthCheck.GetUpstreamMinHealthyPercentages()) > 0 && passThrough {
+		return nil, errors.Errorf("health check upstream min healthy percentages cannot be set in pass through mode")
+	}
+
 	hc := &envoyhealthcheck.HealthCheck{
-		PassThroughMode: &wrappers.BoolValue{Value: false},
+		PassThroughMode: &wrappers.BoolValue{Value: passThrough},
+		CacheTime:       healthCheck.GetCacheTime(),
 		Headers: []*route.HeaderMatcher{{
 			Name: ":path",
 			HeaderMatchSpecifier: &route.HeaderMatcher_ExactMatch{
@@ -52,6 +63,22 @@ func (p *Plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) (
 		}},
 	}
 
+	for _, minHealthy := range healthCheck.GetUpstreamMinHealthyPercentages() {
+		if minHealthy.GetUpstream() == nil {
+			return nil, errors.Errorf("health check upstream min healthy percentage must reference an upstream")
+		}
+		percentage := minHealthy.GetPercentage()
+		if percentage < 0 || percentage > 100 {
+			return nil, errors.Errorf("health check upstream min healthy percentage must be between 0 and 100, got %v", percentage)
+		}
+		if hc.ClusterMinHealthyPercentages == nil {
+			hc.ClusterMinHealthyPercentages = map[string]*envoy_type_v3.Percent{}
+		}
+		hc.ClusterMinHealthyPercentages[translator.UpstreamToClusterName(minHealthy.GetUpstream())] = &envoy_type_v3.Percent{
+			Value: percentage,
+		}
+	}
+
 	healthCheckFilter, err := plugins.NewStagedFilterWithConfig(wellknown.HealthCheck, hc, pluginStage)
 	if err != nil {
 		return nil, errors.Wrapf(err, "generating filter config")
@@ -0,0 +1,88 @@
+package healthcheck_test
+
+import (
+	envoyhealthcheck "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/health_check/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/healthcheck"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/healthcheck"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/test/matchers"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		hc       *healthcheck.HealthCheck
+		upstream *core.ResourceRef
+	)
+
+	BeforeEach(func() {
+		hc = &healthcheck.HealthCheck{Path: "/healthz"}
+		upstream = &core.ResourceRef{Name: "critical", Namespace: "gloo-system"}
+	})
+
+	filterConfig := func() (*envoyhealthcheck.HealthCheck, error) {
+		filters, err := NewPlugin().HttpFilters(plugins.Params{}, &v1.HttpListener{
+			Options: &v1.HttpListenerOptions{HealthCheck: hc},
+		})
+		if err != nil {
+			return nil, err
+		}
+		Expect(filters).To(HaveLen(1))
+		cfg, err := utils.AnyToMessage(filters[0].HttpFilter.GetTypedConfig())
+		Expect(err).NotTo(HaveOccurred())
+		return cfg.(*envoyhealthcheck.HealthCheck), nil
+	}
+
+	It("answers health checks from envoy by default", func() {
+		cfg, err := filterConfig()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.GetPassThroughMode().GetValue()).To(BeFalse())
+		Expect(cfg.GetHeaders()[0].GetExactMatch()).To(Equal("/healthz"))
+	})
+
+	It("configures pass through mode with caching", func() {
+		hc.PassThroughMode = &wrappers.BoolValue{Value: true}
+		hc.CacheTime = &duration.Duration{Seconds: 2}
+		cfg, err := filterConfig()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.GetPassThroughMode().GetValue()).To(BeTrue())
+		Expect(cfg.GetCacheTime()).To(matchers.MatchProto(hc.CacheTime))
+	})
+
+	It("fails health checks based on upstream health", func() {
+		hc.UpstreamMinHealthyPercentages = []*healthcheck.HealthCheck_UpstreamMinHealthyPercentage{{
+			Upstream:   upstream,
+			Percentage: 50,
+		}}
+		cfg, err := filterConfig()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.GetClusterMinHealthyPercentages()).To(HaveKey(translator.UpstreamToClusterName(upstream)))
+		Expect(cfg.GetClusterMinHealthyPercentages()[translator.UpstreamToClusterName(upstream)]).To(matchers.MatchProto(&envoy_type_v3.Percent{Value: 50}))
+	})
+
+	It("rejects invalid configurations", func() {
+		hc.CacheTime = &duration.Duration{Seconds: 2}
+		_, err := filterConfig()
+		Expect(err).To(HaveOccurred())
+
+		hc.CacheTime = nil
+		hc.PassThroughMode = &wrappers.BoolValue{Value: true}
+		hc.UpstreamMinHealthyPercentages = []*healthcheck.HealthCheck_UpstreamMinHealthyPercentage{{Upstream: upstream, Percentage: 50}}
+		_, err = filterConfig()
+		Expect(err).To(HaveOccurred())
+
+		hc.PassThroughMode = nil
+		hc.UpstreamMinHealthyPercentages[0].Percentage = 150
+		_, err = filterConfig()
+		Expect(err).To(HaveOccurred())
+	})
+})
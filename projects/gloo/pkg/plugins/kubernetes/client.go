@@ -9,6 +9,8 @@ import (
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/controller"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
 	kubeinformers "k8s.io/client-go/informers"
 	kubelisters "k8s.io/client-go/listers/core/v1"
 
@@ -22,6 +24,8 @@ import (
 
 type KubePluginSharedFactory interface {
 	EndpointsLister(ns string) kubelisters.EndpointsLister
+	// Returns nil if EndpointSlices are not watched, in which case Endpoints are
+	EndpointSliceLister(ns string) cache.GenericNamespaceLister
 	Subscribe() <-chan struct{}
 	Unsubscribe(<-chan struct{})
 }
@@ -29,31 +33,47 @@ type KubePluginSharedFactory interface {
 type KubePluginListers struct {
 	initError error
 
-	endpointsLister map[string]kubelisters.EndpointsLister
+	endpointsLister     map[string]kubelisters.EndpointsLister
+	endpointSliceLister map[string]cache.GenericNamespaceLister
 
 	cacheUpdatedWatchers      []chan struct{}
 	cacheUpdatedWatchersMutex sync.Mutex
 }
 
-func getInformerFactory(ctx context.Context, client kubernetes.Interface, watchNamespaces []string) *KubePluginListers {
+// If sliceClient is non-nil, EndpointSlices of the given services are watched instead of Endpoints,
+// in the namespaces where Gloo is allowed to list them.
+// Endpoints are truncated to 1000 addresses and lack topology information, and changing a single pod
+// of a large service rewrites the whole Endpoints object.
+func getInformerFactory(ctx context.Context, client kubernetes.Interface, sliceClient dynamic.Interface, watchNamespaces, serviceNames []string) *KubePluginListers {
 	if len(watchNamespaces) == 0 {
 		watchNamespaces = []string{metav1.NamespaceAll}
 	}
-	kubePluginSharedFactory := startInformerFactory(ctx, client, watchNamespaces)
+	kubePluginSharedFactory := startInformerFactory(ctx, client, sliceClient, watchNamespaces, serviceNames)
 	if kubePluginSharedFactory.initError != nil {
 		panic(kubePluginSharedFactory.initError)
 	}
 	return kubePluginSharedFactory
 }
 
-func startInformerFactory(ctx context.Context, client kubernetes.Interface, watchNamespaces []string) *KubePluginListers {
+func startInformerFactory(ctx context.Context, client kubernetes.Interface, sliceClient dynamic.Interface, watchNamespaces, serviceNames []string) *KubePluginListers {
 	resyncDuration := 12 * time.Hour
 
 	var informers []cache.SharedIndexInformer
 	k := &KubePluginListers{
-		endpointsLister: map[string]kubelisters.EndpointsLister{},
+		endpointsLister:     map[string]kubelisters.EndpointsLister{},
+		endpointSliceLister: map[string]cache.GenericNamespaceLister{},
 	}
 	for _, nsToWatch := range watchNamespaces {
+		if sliceClient != nil && endpointSlicesAllowed(ctx, sliceClient, nsToWatch) {
+			labelSelector := endpointSliceSelector(serviceNames)
+			sliceInformer := dynamicinformer.NewFilteredDynamicInformer(sliceClient, endpointSliceGVR, nsToWatch, resyncDuration, cache.Indexers{},
+				func(options *metav1.ListOptions) {
+					options.LabelSelector = labelSelector
+				})
+			informers = append(informers, sliceInformer.Informer())
+			k.endpointSliceLister[nsToWatch] = sliceInformer.Lister().ByNamespace(nsToWatch)
+			continue
+		}
 		kubeInformerFactory := kubeinformers.NewSharedInformerFactoryWithOptions(client, resyncDuration, kubeinformers.WithNamespace(nsToWatch))
 		endpointInformer := kubeInformerFactory.Core().V1().Endpoints()
 		informers = append(informers, endpointInformer.Informer())
@@ -79,7 +99,7 @@ func startInformerFactory(ctx context.Context, client kubernetes.Interface, watc
 	ok := cache.WaitForCacheSync(stop, syncFuncs...)
 	if !ok && ctx.Err() == nil {
 		// if initError is non-nil, the kube resource client will panic
-		k.initError = errors.Errorf("waiting for kube pod, endpoints, services cache sync failed")
+		k.initError = errors.Errorf("waiting for kube pod, endpoints, endpoint slices, services cache sync failed")
 	}
 
 	return k
@@ -89,6 +109,13 @@ func (k *KubePluginListers) EndpointsLister(ns string) kubelisters.EndpointsList
 	return k.endpointsLister[ns]
 }
 
+func (k *KubePluginListers) EndpointSliceLister(ns string) cache.GenericNamespaceLister {
+	if lister, ok := k.endpointSliceLister[ns]; ok {
+		return lister
+	}
+	return nil
+}
+
 func (k *KubePluginListers) Subscribe() <-chan struct{} {
 	k.cacheUpdatedWatchersMutex.Lock()
 	defer k.cacheUpdatedWatchersMutex.Unlock()
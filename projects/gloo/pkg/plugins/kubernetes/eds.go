@@ -24,8 +24,23 @@ import (
 
 func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.UpstreamList, opts clients.WatchOpts) (<-chan v1.EndpointList, <-chan error, error) {
 
+	sliceClient, err := p.getEndpointSliceClient()
+	if err != nil {
+		contextutils.LoggerFrom(opts.Ctx).Warnf("watching Endpoints instead of EndpointSlices: %v", err)
+	}
+	serviceNames := map[string]bool{}
+	for _, upstream := range upstreamsToTrack {
+		if svcName := upstream.GetKube().GetServiceName(); svcName != "" {
+			serviceNames[svcName] = true
+		}
+	}
+	var sliceServiceNames []string
+	for svcName := range serviceNames {
+		sliceServiceNames = append(sliceServiceNames, svcName)
+	}
+
 	kubeFactory := func(namespaces []string) KubePluginSharedFactory {
-		return getInformerFactory(opts.Ctx, p.kube, namespaces)
+		return getInformerFactory(opts.Ctx, p.kube, sliceClient, namespaces, sliceServiceNames)
 	}
 	watcher, err := newEndpointWatcherForUpstreams(kubeFactory, p.kubeCoreCache, writeNamespace, upstreamsToTrack, opts)
 	if err != nil {
@@ -93,6 +108,8 @@ func newEndpointsWatcher(kubeCoreCache corecache.KubeCoreCache, namespaces []str
 
 func (c *edsWatcher) List(writeNamespace string, opts clients.ListOpts) (v1.EndpointList, error) {
 	var endpointList []*kubev1.Endpoints
+	var endpointSliceList []*EndpointSlice
+	var useEndpointSlices bool
 	var serviceList []*kubev1.Service
 	var podList []*kubev1.Pod
 	ctx := contextutils.WithLogger(opts.Ctx, "kubernetes_eds")
@@ -115,6 +132,16 @@ func (c *edsWatcher) List(writeNamespace string, opts clients.ListOpts) (v1.Endp
 		}
 		podList = append(podList, pods...)
 
+		if sliceLister := c.kubeShareFactory.EndpointSliceLister(ns); sliceLister != nil {
+			useEndpointSlices = true
+			slices, err := listEndpointSlices(sliceLister, labels.SelectorFromSet(opts.Selector))
+			if err != nil {
+				return nil, err
+			}
+			endpointSliceList = append(endpointSliceList, slices...)
+			continue
+		}
+
 		endpoints, err := c.kubeShareFactory.EndpointsLister(ns).List(labels.SelectorFromSet(opts.Selector))
 		if err != nil {
 			return nil, err
@@ -122,7 +149,13 @@ func (c *edsWatcher) List(writeNamespace string, opts clients.ListOpts) (v1.Endp
 		endpointList = append(endpointList, endpoints...)
 	}
 
-	eps, warns, errsToLog := filterEndpoints(ctx, writeNamespace, endpointList, serviceList, podList, c.upstreams)
+	var eps v1.EndpointList
+	var warns, errsToLog []string
+	if useEndpointSlices {
+		eps, warns, errsToLog = filterEndpointSlices(ctx, writeNamespace, endpointSliceList, serviceList, podList, c.upstreams)
+	} else {
+		eps, warns, errsToLog = filterEndpoints(ctx, writeNamespace, endpointList, serviceList, podList, c.upstreams)
+	}
 	warnsToLog = append(warnsToLog, warns...)
 
 	hasher := fnv.New64()
@@ -203,6 +236,14 @@ func (c *edsWatcher) watch(writeNamespace string, opts clients.WatchOpts) (<-cha
 	return endpointsChan, errs, nil
 }
 
+type endpointKey struct {
+	Address      string
+	Port         uint32
+	PodName      string
+	PodNamespace string
+	UpstreamRef  *core.ResourceRef
+}
+
 func filterEndpoints(
 	_ context.Context, // do not use for logging! return logging messages as strings and log them after hashing (see https://github.com/solo-io/gloo/issues/3761)
 	writeNamespace string,
@@ -211,42 +252,13 @@ func filterEndpoints(
 	pods []*kubev1.Pod,
 	upstreams map[*core.ResourceRef]*kubeplugin.UpstreamSpec,
 ) (v1.EndpointList, []string, []string) {
-	var endpoints v1.EndpointList
-
 	var warnsToLog, errorsToLog []string
 
-	type Epkey struct {
-		Address      string
-		Port         uint32
-		PodName      string
-		PodNamespace string
-		UpstreamRef  *core.ResourceRef
-	}
-	endpointsMap := make(map[Epkey][]*core.ResourceRef)
+	endpointsMap := make(map[endpointKey][]*core.ResourceRef)
 
 	// for each upstream
 	for usRef, spec := range upstreams {
-		var kubeServicePort *kubev1.ServicePort
-		var singlePortService bool
-	findServicePort:
-		for _, svc := range services {
-			if svc.Namespace != spec.ServiceNamespace || svc.Name != spec.ServiceName {
-				continue
-			}
-			if len(svc.Spec.Ports) == 1 {
-				singlePortService = true
-				if spec.ServicePort == uint32(svc.Spec.Ports[0].Port) {
-					kubeServicePort = &svc.Spec.Ports[0]
-					break findServicePort
-				}
-			}
-			for _, port := range svc.Spec.Ports {
-				if spec.ServicePort == uint32(port.Port) {
-					kubeServicePort = &port
-					break findServicePort
-				}
-			}
-		}
+		kubeServicePort, singlePortService := findServicePort(spec, services)
 		if kubeServicePort == nil {
 			errorsToLog = append(errorsToLog, fmt.Sprintf("upstream %v: port %v not found for service %v", usRef.Key(), spec.ServicePort, spec.ServiceName))
 			continue
@@ -274,31 +286,17 @@ func filterEndpoints(
 					continue
 				}
 				for _, addr := range subset.Addresses {
-					var podName, podNamespace string
-					targetRef := addr.TargetRef
-					if targetRef != nil {
-						if targetRef.Kind == "Pod" {
-							podName = targetRef.Name
-							podNamespace = targetRef.Namespace
-						}
-					}
+					podName, podNamespace := podForTargetRef(addr.TargetRef)
 					if len(spec.Selector) != 0 {
-						// determine whether labels for the owner of this ip (pod) matches the spec
-						podLabels, err := getPodLabelsForIp(addr.IP, podName, podNamespace, pods)
-						if err != nil {
-							// pod not found for IP? what's that about?
-							warnsToLog = append(warnsToLog, fmt.Sprintf("error for upstream %v service %v: %v", usRef.Key(), spec.ServiceName, err))
-							continue
+						matches, warn := podMatchesSelector(usRef, spec, addr.IP, podName, podNamespace, pods)
+						if warn != "" {
+							warnsToLog = append(warnsToLog, warn)
 						}
-						if !labels.AreLabelsInWhiteList(spec.Selector, podLabels) {
-							continue
-						}
-						// pod hasn't been assigned address yet
-						if addr.IP == "" {
+						if !matches {
 							continue
 						}
 					}
-					key := Epkey{addr.IP, port, podName, podNamespace, usRef}
+					key := endpointKey{addr.IP, port, podName, podNamespace, usRef}
 					copyRef := *usRef
 					endpointsMap[key] = append(endpointsMap[key], &copyRef)
 				}
@@ -306,6 +304,159 @@ func filterEndpoints(
 		}
 	}
 
+	return buildEndpoints(writeNamespace, endpointsMap, nil, pods), warnsToLog, errorsToLog
+}
+
+// Builds endpoints from EndpointSlices. Unlike Endpoints, slices are not truncated for large services and carry the
+// zones (and topology aware hints) of the endpoints, which become the localities of the endpoints.
+// Ready endpoints are used if an upstream has any. Otherwise, like kube-proxy, we fall back to terminating endpoints
+// that are still serving, so connections are drained gracefully during a rollout instead of failing.
+func filterEndpointSlices(
+	_ context.Context, // do not use for logging! return logging messages as strings and log them after hashing (see https://github.com/solo-io/gloo/issues/3761)
+	writeNamespace string,
+	endpointSlices []*EndpointSlice,
+	services []*kubev1.Service,
+	pods []*kubev1.Pod,
+	upstreams map[*core.ResourceRef]*kubeplugin.UpstreamSpec,
+) (v1.EndpointList, []string, []string) {
+	var warnsToLog, errorsToLog []string
+
+	endpointsMap := make(map[endpointKey][]*core.ResourceRef)
+	localities := make(map[endpointKey]*v1.Locality)
+
+	// for each upstream
+	for usRef, spec := range upstreams {
+		kubeServicePort, singlePortService := findServicePort(spec, services)
+		if kubeServicePort == nil {
+			errorsToLog = append(errorsToLog, fmt.Sprintf("upstream %v: port %v not found for service %v", usRef.Key(), spec.ServicePort, spec.ServiceName))
+			continue
+		}
+
+		var ready, terminating []endpointKey
+		for _, slice := range endpointSlices {
+			if slice.Namespace != spec.ServiceNamespace || slice.ServiceName() != spec.ServiceName {
+				continue
+			}
+			// FQDN slices are managed by third parties and do not point at pods
+			if slice.AddressType != "IPv4" && slice.AddressType != "IPv6" {
+				continue
+			}
+			var port uint32
+			for _, p := range slice.Ports {
+				if p.Port == nil {
+					continue
+				}
+				var name string
+				if p.Name != nil {
+					name = *p.Name
+				}
+				switch {
+				case singlePortService:
+					port = uint32(*p.Port)
+				case name == kubeServicePort.Name:
+					port = uint32(*p.Port)
+				}
+			}
+			if port == 0 {
+				warnsToLog = append(warnsToLog, fmt.Sprintf("upstream %v: port %v not found for service %v in endpoint slice %v", usRef.Key(), spec.ServicePort, spec.ServiceName, slice.Name))
+				continue
+			}
+			for _, endpoint := range slice.Endpoints {
+				if len(endpoint.Addresses) == 0 {
+					continue
+				}
+				conditions := endpoint.Conditions
+				if !conditions.IsReady() && !(conditions.IsServing() && conditions.IsTerminating()) {
+					continue
+				}
+				address := endpoint.Addresses[0]
+				podName, podNamespace := podForTargetRef(endpoint.TargetRef)
+				if len(spec.Selector) != 0 {
+					matches, warn := podMatchesSelector(usRef, spec, address, podName, podNamespace, pods)
+					if warn != "" {
+						warnsToLog = append(warnsToLog, warn)
+					}
+					if !matches {
+						continue
+					}
+				}
+				key := endpointKey{address, port, podName, podNamespace, usRef}
+				// an endpoint can briefly appear in two slices while it is moved between them
+				if _, ok := localities[key]; ok {
+					continue
+				}
+				var locality *v1.Locality
+				if zone := endpoint.HintedZone(); zone != "" {
+					locality = &v1.Locality{Zone: zone}
+				}
+				localities[key] = locality
+				if conditions.IsReady() {
+					ready = append(ready, key)
+				} else {
+					terminating = append(terminating, key)
+				}
+			}
+		}
+
+		keys := ready
+		if len(keys) == 0 {
+			keys = terminating
+		}
+		for _, key := range keys {
+			endpointsMap[key] = append(endpointsMap[key], &core.ResourceRef{Name: usRef.GetName(), Namespace: usRef.GetNamespace()})
+		}
+	}
+
+	return buildEndpoints(writeNamespace, endpointsMap, localities, pods), warnsToLog, errorsToLog
+}
+
+// Returns the port of the service the upstream points to, and whether it is the only port of the service
+func findServicePort(spec *kubeplugin.UpstreamSpec, services []*kubev1.Service) (*kubev1.ServicePort, bool) {
+	var singlePortService bool
+	for _, svc := range services {
+		if svc.Namespace != spec.ServiceNamespace || svc.Name != spec.ServiceName {
+			continue
+		}
+		if len(svc.Spec.Ports) == 1 {
+			singlePortService = true
+			if spec.ServicePort == uint32(svc.Spec.Ports[0].Port) {
+				return &svc.Spec.Ports[0], singlePortService
+			}
+		}
+		for _, port := range svc.Spec.Ports {
+			if spec.ServicePort == uint32(port.Port) {
+				port := port
+				return &port, singlePortService
+			}
+		}
+	}
+	return nil, singlePortService
+}
+
+func podForTargetRef(targetRef *kubev1.ObjectReference) (string, string) {
+	if targetRef != nil && targetRef.Kind == "Pod" {
+		return targetRef.Name, targetRef.Namespace
+	}
+	return "", ""
+}
+
+// Returns whether the pod at the given address matches the selector of the upstream, and a warning to log, if any
+func podMatchesSelector(usRef *core.ResourceRef, spec *kubeplugin.UpstreamSpec, ip, podName, podNamespace string, pods []*kubev1.Pod) (bool, string) {
+	// determine whether labels for the owner of this ip (pod) matches the spec
+	podLabels, err := getPodLabelsForIp(ip, podName, podNamespace, pods)
+	if err != nil {
+		// pod not found for IP? what's that about?
+		return false, fmt.Sprintf("error for upstream %v service %v: %v", usRef.Key(), spec.ServiceName, err)
+	}
+	if !labels.AreLabelsInWhiteList(spec.Selector, podLabels) {
+		return false, ""
+	}
+	// pod hasn't been assigned address yet
+	return ip != "", ""
+}
+
+func buildEndpoints(writeNamespace string, endpointsMap map[endpointKey][]*core.ResourceRef, localities map[endpointKey]*v1.Locality, pods []*kubev1.Pod) v1.EndpointList {
+	var endpoints v1.EndpointList
 	for addr, refs := range endpointsMap {
 
 		// sort refs for idempotency
@@ -324,13 +475,14 @@ func filterEndpoints(
 		endpointName := fmt.Sprintf("ep-%v-%v-%x", dnsname, addr.Port, hasher.Sum64())
 		pod, _ := getPodForIp(addr.Address, addr.PodName, addr.PodNamespace, pods)
 		ep := createEndpoint(writeNamespace, endpointName, refs, addr.Address, addr.Port, pod)
+		ep.Locality = localities[addr]
 		endpoints = append(endpoints, ep)
 	}
 
 	// sort refs for idempotency
 	sort.Slice(endpoints, func(i, j int) bool { return endpoints[i].Metadata.Name < endpoints[j].Metadata.Name })
 
-	return endpoints, warnsToLog, errorsToLog
+	return endpoints
 }
 
 func createEndpoint(namespace, name string, upstreams []*core.ResourceRef, address string, port uint32, pod *kubev1.Pod) *v1.Endpoint {
@@ -342,7 +494,6 @@ func createEndpoint(namespace, name string, upstreams []*core.ResourceRef, addre
 		Upstreams: upstreams,
 		Address:   address,
 		Port:      port,
-		// TODO: add locality info
 	}
 
 	if pod != nil {
@@ -10,6 +10,9 @@ import (
 	mock_kubernetes "github.com/solo-io/gloo/projects/gloo/pkg/plugins/kubernetes/mocks"
 	mock_cache "github.com/solo-io/gloo/test/mocks/cache"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -54,4 +57,68 @@ var _ = Describe("Eds", func() {
 
 	})
 
+	Context("services without a selector", func() {
+		var (
+			usRef     *core.ResourceRef
+			upstreams map[*core.ResourceRef]*kubev1.UpstreamSpec
+			services  []*corev1.Service
+		)
+
+		BeforeEach(func() {
+			usRef = &core.ResourceRef{Name: "saas", Namespace: "gloo-system"}
+			upstreams = map[*core.ResourceRef]*kubev1.UpstreamSpec{
+				usRef: {ServiceName: "saas", ServiceNamespace: "foo", ServicePort: 443},
+			}
+			services = []*corev1.Service{{
+				ObjectMeta: metav1.ObjectMeta{Name: "saas", Namespace: "foo"},
+				Spec: corev1.ServiceSpec{
+					ClusterIP: corev1.ClusterIPNone,
+					Ports: []corev1.ServicePort{
+						{Name: "https", Port: 443},
+						{Name: "admin", Port: 8443},
+					},
+				},
+			}}
+		})
+
+		It("uses manually managed Endpoints", func() {
+			endpoints := []*corev1.Endpoints{{
+				ObjectMeta: metav1.ObjectMeta{Name: "saas", Namespace: "foo"},
+				Subsets: []corev1.EndpointSubset{{
+					Addresses: []corev1.EndpointAddress{{IP: "203.0.113.10"}, {IP: "203.0.113.11"}},
+					Ports:     []corev1.EndpointPort{{Name: "https", Port: 443}, {Name: "admin", Port: 8443}},
+				}},
+			}}
+
+			eps, warns, errs := filterEndpoints(ctx, "gloo-system", endpoints, services, nil, upstreams)
+			Expect(warns).To(BeEmpty())
+			Expect(errs).To(BeEmpty())
+			Expect(eps).To(HaveLen(2))
+			for _, ep := range eps {
+				Expect(ep.GetPort()).To(BeEquivalentTo(443))
+				Expect(ep.GetMetadata().GetLabels()).To(BeEmpty())
+			}
+		})
+
+		It("uses manually managed EndpointSlices", func() {
+			portName, port := "https", int32(443)
+			slices := []*EndpointSlice{{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "saas-manual",
+					Namespace: "foo",
+					Labels:    map[string]string{EndpointSliceServiceNameLabel: "saas"},
+				},
+				AddressType: "IPv4",
+				Endpoints:   []EndpointSliceEndpoint{{Addresses: []string{"203.0.113.10"}}},
+				Ports:       []EndpointSlicePort{{Name: &portName, Port: &port}},
+			}}
+
+			eps, warns, errs := filterEndpointSlices(ctx, "gloo-system", slices, services, nil, upstreams)
+			Expect(warns).To(BeEmpty())
+			Expect(errs).To(BeEmpty())
+			Expect(eps).To(HaveLen(1))
+			Expect(eps[0].GetAddress()).To(Equal("203.0.113.10"))
+		})
+	})
+
 })
@@ -0,0 +1,176 @@
+package kubernetes
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	kubev1 "k8s.io/api/core/v1"
+	kubeerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	// The label EndpointSlices use to reference the service they belong to
+	EndpointSliceServiceNameLabel = "kubernetes.io/service-name"
+
+	// Beyond this many services, EDS watches all the EndpointSlices in its namespaces
+	// instead of passing the (then very long) list of service names to the api server.
+	maxEndpointSliceServiceNames = 100
+)
+
+var endpointSliceGVR = schema.GroupVersionResource{Group: "discovery.k8s.io", Version: "v1", Resource: "endpointslices"}
+
+// The parts of a discovery.k8s.io/v1 EndpointSlice that EDS uses.
+// The Kubernetes client Gloo is built with predates the v1 API, so slices are read with the dynamic client.
+type EndpointSlice struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// One of IPv4, IPv6 or FQDN
+	AddressType string                  `json:"addressType"`
+	Endpoints   []EndpointSliceEndpoint `json:"endpoints"`
+	Ports       []EndpointSlicePort     `json:"ports,omitempty"`
+}
+
+type EndpointSliceEndpoint struct {
+	// Kubernetes considers all the addresses of an endpoint fungible and consumers may only use the first one
+	Addresses  []string                        `json:"addresses"`
+	Conditions EndpointSliceEndpointConditions `json:"conditions,omitempty"`
+	TargetRef  *kubev1.ObjectReference         `json:"targetRef,omitempty"`
+	NodeName   *string                         `json:"nodeName,omitempty"`
+	Zone       *string                         `json:"zone,omitempty"`
+	Hints      *EndpointSliceEndpointHints     `json:"hints,omitempty"`
+}
+
+type EndpointSliceEndpointConditions struct {
+	Ready       *bool `json:"ready,omitempty"`
+	Serving     *bool `json:"serving,omitempty"`
+	Terminating *bool `json:"terminating,omitempty"`
+}
+
+type EndpointSliceEndpointHints struct {
+	ForZones []EndpointSliceForZone `json:"forZones,omitempty"`
+}
+
+type EndpointSliceForZone struct {
+	Name string `json:"name"`
+}
+
+type EndpointSlicePort struct {
+	Name     *string          `json:"name,omitempty"`
+	Protocol *kubev1.Protocol `json:"protocol,omitempty"`
+	Port     *int32           `json:"port,omitempty"`
+}
+
+// The name of the service the slice belongs to
+func (s *EndpointSlice) ServiceName() string {
+	return s.Labels[EndpointSliceServiceNameLabel]
+}
+
+// An unknown readiness is to be interpreted as ready
+func (c EndpointSliceEndpointConditions) IsReady() bool {
+	return c.Ready == nil || *c.Ready
+}
+
+// An unknown serving condition is to be interpreted as the readiness
+func (c EndpointSliceEndpointConditions) IsServing() bool {
+	if c.Serving == nil {
+		return c.IsReady()
+	}
+	return *c.Serving
+}
+
+func (c EndpointSliceEndpointConditions) IsTerminating() bool {
+	return c.Terminating != nil && *c.Terminating
+}
+
+// The zone traffic to the endpoint should come from. Topology aware hints take precedence over the zone the endpoint runs in.
+func (e EndpointSliceEndpoint) HintedZone() string {
+	if e.Hints != nil && len(e.Hints.ForZones) > 0 {
+		return e.Hints.ForZones[0].Name
+	}
+	if e.Zone != nil {
+		return *e.Zone
+	}
+	return ""
+}
+
+func listEndpointSlices(lister cache.GenericNamespaceLister, selector labels.Selector) ([]*EndpointSlice, error) {
+	objs, err := lister.List(selector)
+	if err != nil {
+		return nil, err
+	}
+	var slices []*EndpointSlice
+	for _, obj := range objs {
+		u, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		slice, err := toEndpointSlice(u)
+		if err != nil {
+			return nil, err
+		}
+		slices = append(slices, slice)
+	}
+	return slices, nil
+}
+
+func toEndpointSlice(u *unstructured.Unstructured) (*EndpointSlice, error) {
+	var slice EndpointSlice
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), &slice); err != nil {
+		return nil, err
+	}
+	return &slice, nil
+}
+
+// Returns whether the cluster serves discovery.k8s.io/v1 EndpointSlices
+func endpointSlicesSupported(client kubernetes.Interface) bool {
+	resources, err := client.Discovery().ServerResourcesForGroupVersion(endpointSliceGVR.GroupVersion().String())
+	if err != nil {
+		return false
+	}
+	for _, resource := range resources.APIResources {
+		if resource.Name == endpointSliceGVR.Resource {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the client used to watch EndpointSlices, or nil if the cluster does not serve them
+// or the config of the Kubernetes client is unknown.
+// The lookup only happens once, as the available APIs do not change while Gloo runs.
+func (p *plugin) getEndpointSliceClient() (dynamic.Interface, error) {
+	p.endpointSliceClientOnce.Do(func() {
+		if p.endpointSliceClient != nil || p.restConfig == nil || !endpointSlicesSupported(p.kube) {
+			return
+		}
+		p.endpointSliceClient, p.endpointSliceClientErr = dynamic.NewForConfig(p.restConfig)
+	})
+	return p.endpointSliceClient, p.endpointSliceClientErr
+}
+
+// Returns whether EndpointSlices can be listed in the given namespace.
+// Gloo may have been installed with RBAC rules that only grant access to Endpoints.
+func endpointSlicesAllowed(ctx context.Context, sliceClient dynamic.Interface, namespace string) bool {
+	_, err := sliceClient.Resource(endpointSliceGVR).Namespace(namespace).List(ctx, metav1.ListOptions{Limit: 1})
+	return !kubeerrors.IsForbidden(err)
+}
+
+// Selects the EndpointSlices of the given services, so that EDS is not woken up by changes to other services
+func endpointSliceSelector(serviceNames []string) string {
+	if len(serviceNames) == 0 || len(serviceNames) > maxEndpointSliceServiceNames {
+		return ""
+	}
+	names := append([]string{}, serviceNames...)
+	sort.Strings(names)
+	return EndpointSliceServiceNameLabel + " in (" + strings.Join(names, ",") + ")"
+}
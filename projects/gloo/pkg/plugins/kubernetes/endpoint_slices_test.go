@@ -0,0 +1,213 @@
+package kubernetes
+
+import (
+	"context"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	kubeplugin "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	. "github.com/solo-io/solo-kit/test/matchers"
+	kubev1 "k8s.io/api/core/v1"
+	kubeerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EndpointSlices", func() {
+
+	boolPtr := func(b bool) *bool { return &b }
+	strPtr := func(s string) *string { return &s }
+	int32Ptr := func(i int32) *int32 { return &i }
+
+	sliceEndpoint := func(ip string, ready, serving, terminating *bool) EndpointSliceEndpoint {
+		return EndpointSliceEndpoint{
+			Addresses: []string{ip},
+			Conditions: EndpointSliceEndpointConditions{
+				Ready:       ready,
+				Serving:     serving,
+				Terminating: terminating,
+			},
+		}
+	}
+
+	endpointSlice := func(name, svcName string, portName *string, endpoints ...EndpointSliceEndpoint) *EndpointSlice {
+		return &EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "ns",
+				Labels:    map[string]string{EndpointSliceServiceNameLabel: svcName},
+			},
+			AddressType: "IPv4",
+			Endpoints:   endpoints,
+			Ports:       []EndpointSlicePort{{Name: portName, Port: int32Ptr(8080)}},
+		}
+	}
+
+	Context("filtering", func() {
+		var (
+			usRef     *core.ResourceRef
+			upstreams map[*core.ResourceRef]*kubeplugin.UpstreamSpec
+			services  []*kubev1.Service
+		)
+
+		BeforeEach(func() {
+			usRef = &core.ResourceRef{Name: "us", Namespace: "gloo-system"}
+			upstreams = map[*core.ResourceRef]*kubeplugin.UpstreamSpec{
+				usRef: {ServiceName: "svc", ServiceNamespace: "ns", ServicePort: 80},
+			}
+			services = []*kubev1.Service{{
+				ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: "ns"},
+				Spec: kubev1.ServiceSpec{Ports: []kubev1.ServicePort{
+					{Name: "http", Port: 80},
+					{Name: "metrics", Port: 9090},
+				}},
+			}}
+		})
+
+		addresses := func(eps v1.EndpointList) []string {
+			var result []string
+			for _, ep := range eps {
+				result = append(result, ep.GetAddress())
+			}
+			return result
+		}
+
+		It("uses ready endpoints of the matching port", func() {
+			slices := []*EndpointSlice{
+				endpointSlice("svc-a", "svc", strPtr("http"),
+					sliceEndpoint("1.1.1.1", nil, nil, nil),
+					sliceEndpoint("1.1.1.2", boolPtr(true), boolPtr(true), boolPtr(false)),
+					sliceEndpoint("1.1.1.3", boolPtr(false), boolPtr(false), boolPtr(false)),
+					sliceEndpoint("1.1.1.4", boolPtr(false), boolPtr(true), boolPtr(true)),
+				),
+				endpointSlice("svc-metrics", "svc", strPtr("metrics")),
+				endpointSlice("other", "other", strPtr("http"), sliceEndpoint("2.2.2.2", nil, nil, nil)),
+			}
+
+			eps, warns, errs := filterEndpointSlices(context.TODO(), "gloo-system", slices, services, nil, upstreams)
+			Expect(errs).To(BeEmpty())
+			Expect(warns).To(HaveLen(1))
+			Expect(addresses(eps)).To(ConsistOf("1.1.1.1", "1.1.1.2"))
+			Expect(eps[0].GetPort()).To(BeEquivalentTo(8080))
+			Expect(eps[0].GetUpstreams()).To(ConsistOf(MatchProto(usRef)))
+		})
+
+		It("falls back to serving terminating endpoints if none are ready", func() {
+			slices := []*EndpointSlice{
+				endpointSlice("svc-a", "svc", strPtr("http"),
+					sliceEndpoint("1.1.1.1", boolPtr(false), boolPtr(false), boolPtr(true)),
+					sliceEndpoint("1.1.1.2", boolPtr(false), boolPtr(true), boolPtr(true)),
+					sliceEndpoint("1.1.1.3", boolPtr(false), nil, nil),
+				),
+			}
+
+			eps, _, _ := filterEndpointSlices(context.TODO(), "gloo-system", slices, services, nil, upstreams)
+			Expect(addresses(eps)).To(ConsistOf("1.1.1.2"))
+		})
+
+		It("sets the locality from zone hints and the endpoint zone", func() {
+			hinted := sliceEndpoint("1.1.1.1", nil, nil, nil)
+			hinted.Zone = strPtr("zone-a")
+			hinted.Hints = &EndpointSliceEndpointHints{ForZones: []EndpointSliceForZone{{Name: "zone-b"}}}
+			zoned := sliceEndpoint("1.1.1.2", nil, nil, nil)
+			zoned.Zone = strPtr("zone-c")
+			slices := []*EndpointSlice{endpointSlice("svc-a", "svc", strPtr("http"), hinted, zoned, sliceEndpoint("1.1.1.3", nil, nil, nil))}
+
+			eps, _, _ := filterEndpointSlices(context.TODO(), "gloo-system", slices, services, nil, upstreams)
+			localities := map[string]string{}
+			for _, ep := range eps {
+				localities[ep.GetAddress()] = ep.GetLocality().GetZone()
+			}
+			Expect(localities).To(Equal(map[string]string{"1.1.1.1": "zone-b", "1.1.1.2": "zone-c", "1.1.1.3": ""}))
+		})
+
+		It("does not duplicate endpoints that appear in several slices", func() {
+			slices := []*EndpointSlice{
+				endpointSlice("svc-a", "svc", strPtr("http"), sliceEndpoint("1.1.1.1", nil, nil, nil)),
+				endpointSlice("svc-b", "svc", strPtr("http"), sliceEndpoint("1.1.1.1", nil, nil, nil)),
+			}
+
+			eps, _, _ := filterEndpointSlices(context.TODO(), "gloo-system", slices, services, nil, upstreams)
+			Expect(eps).To(HaveLen(1))
+			Expect(eps[0].GetUpstreams()).To(HaveLen(1))
+		})
+
+		It("ignores FQDN slices", func() {
+			slice := endpointSlice("svc-a", "svc", strPtr("http"), sliceEndpoint("example.com", nil, nil, nil))
+			slice.AddressType = "FQDN"
+
+			eps, _, _ := filterEndpointSlices(context.TODO(), "gloo-system", []*EndpointSlice{slice}, services, nil, upstreams)
+			Expect(eps).To(BeEmpty())
+		})
+	})
+
+	Context("watching", func() {
+
+		toUnstructured := func(slice *EndpointSlice) runtime.Object {
+			slice.APIVersion = "discovery.k8s.io/v1"
+			slice.Kind = "EndpointSlice"
+			obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(slice)
+			Expect(err).NotTo(HaveOccurred())
+			return &unstructured.Unstructured{Object: obj}
+		}
+
+		It("only watches the slices of the tracked services", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			sliceClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(),
+				toUnstructured(endpointSlice("svc-a", "svc", nil, sliceEndpoint("1.1.1.1", nil, nil, nil))),
+				toUnstructured(endpointSlice("other-a", "other", nil, sliceEndpoint("2.2.2.2", nil, nil, nil))),
+			)
+
+			factory := getInformerFactory(ctx, fake.NewSimpleClientset(), sliceClient, []string{"ns"}, []string{"svc"})
+			Expect(factory.EndpointsLister("ns")).To(BeNil())
+			lister := factory.EndpointSliceLister("ns")
+			Expect(lister).NotTo(BeNil())
+
+			slices, err := listEndpointSlices(lister, labels.Everything())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(slices).To(HaveLen(1))
+			Expect(slices[0].ServiceName()).To(Equal("svc"))
+			Expect(slices[0].Endpoints[0].Addresses).To(Equal([]string{"1.1.1.1"}))
+		})
+
+		It("falls back to Endpoints in namespaces where listing EndpointSlices is forbidden", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			sliceClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+			sliceClient.PrependReactor("list", "endpointslices", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				if action.GetNamespace() != "forbidden" {
+					return false, nil, nil
+				}
+				return true, nil, kubeerrors.NewForbidden(endpointSliceGVR.GroupResource(), "", nil)
+			})
+
+			factory := getInformerFactory(ctx, fake.NewSimpleClientset(), sliceClient, []string{"ns", "forbidden"}, []string{"svc"})
+			Expect(factory.EndpointSliceLister("ns")).NotTo(BeNil())
+			Expect(factory.EndpointsLister("ns")).To(BeNil())
+			Expect(factory.EndpointSliceLister("forbidden")).To(BeNil())
+			Expect(factory.EndpointsLister("forbidden")).NotTo(BeNil())
+		})
+
+		It("falls back to Endpoints if the cluster does not serve EndpointSlices", func() {
+			kube := fake.NewSimpleClientset()
+			Expect(endpointSlicesSupported(kube)).To(BeFalse())
+
+			kube.Resources = []*metav1.APIResourceList{{
+				GroupVersion: "discovery.k8s.io/v1",
+				APIResources: []metav1.APIResource{{Name: "endpointslices"}},
+			}}
+			Expect(endpointSlicesSupported(kube)).To(BeTrue())
+		})
+	})
+})
@@ -149,7 +149,7 @@ var _ = Describe("Kubernetes", func() {
 		})
 
 		It("uses json keys when serializing", func() {
-			plug := kubeplugin.NewPlugin(kubeClient, kubeCoreCache).(discovery.DiscoveryPlugin)
+			plug := kubeplugin.NewPlugin(kubeClient, nil, kubeCoreCache).(discovery.DiscoveryPlugin)
 			upstreams, errs, err := plug.DiscoverUpstreams([]string{svcNamespace}, svcNamespace, clients.WatchOpts{
 				Ctx:         context.TODO(),
 				RefreshRate: time.Second,
@@ -185,7 +185,7 @@ var _ = Describe("Kubernetes", func() {
 					},
 				}
 			}
-			plug := kubeplugin.NewPlugin(kubeClient, kubeCoreCache).(discovery.DiscoveryPlugin)
+			plug := kubeplugin.NewPlugin(kubeClient, nil, kubeCoreCache).(discovery.DiscoveryPlugin)
 			eds, errs, err := plug.WatchEndpoints(
 				"",
 				v1.UpstreamList{makeUpstream("a"), makeUpstream("b"), makeUpstream("c")},
@@ -9,6 +9,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	v1 "k8s.io/client-go/listers/core/v1"
+	cache "k8s.io/client-go/tools/cache"
 )
 
 // MockKubePluginSharedFactory is a mock of KubePluginSharedFactory interface
@@ -34,6 +35,20 @@ func (m *MockKubePluginSharedFactory) EXPECT() *MockKubePluginSharedFactoryMockR
 	return m.recorder
 }
 
+// EndpointSliceLister mocks base method
+func (m *MockKubePluginSharedFactory) EndpointSliceLister(arg0 string) cache.GenericNamespaceLister {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndpointSliceLister", arg0)
+	ret0, _ := ret[0].(cache.GenericNamespaceLister)
+	return ret0
+}
+
+// EndpointSliceLister indicates an expected call of EndpointSliceLister
+func (mr *MockKubePluginSharedFactoryMockRecorder) EndpointSliceLister(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndpointSliceLister", reflect.TypeOf((*MockKubePluginSharedFactory)(nil).EndpointSliceLister), arg0)
+}
+
 // EndpointsLister mocks base method
 func (m *MockKubePluginSharedFactory) EndpointsLister(arg0 string) v1.EndpointsLister {
 	m.ctrl.T.Helper()
@@ -3,18 +3,26 @@ package kubernetes
 import (
 	"fmt"
 	"net/url"
+	"sync"
 
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	errors "github.com/rotisserie/eris"
+	kubev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
 
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	kubeplugin "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/utils/healthcheck"
 	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
 	corecache "github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/cache"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 )
 
 var _ discovery.DiscoveryPlugin = new(plugin)
@@ -22,12 +30,19 @@ var _ plugins.UpstreamPlugin = new(plugin)
 
 type plugin struct {
 	kube kubernetes.Interface
+	// the config kube was created from, nil if unknown
+	restConfig *rest.Config
 
 	UpstreamConverter UpstreamConverter
 
 	kubeCoreCache corecache.KubeCoreCache
 
 	settings *v1.Settings
+
+	// used to watch EndpointSlices, if the cluster serves them
+	endpointSliceClient     dynamic.Interface
+	endpointSliceClientErr  error
+	endpointSliceClientOnce sync.Once
 }
 
 func (p *plugin) Resolve(u *v1.Upstream) (*url.URL, error) {
@@ -39,9 +54,10 @@ func (p *plugin) Resolve(u *v1.Upstream) (*url.URL, error) {
 	return url.Parse(fmt.Sprintf("tcp://%v.%v.svc.cluster.local:%v", kubeSpec.Kube.ServiceName, kubeSpec.Kube.ServiceNamespace, kubeSpec.Kube.ServicePort))
 }
 
-func NewPlugin(kube kubernetes.Interface, kubeCoreCache corecache.KubeCoreCache) plugins.Plugin {
+func NewPlugin(kube kubernetes.Interface, restConfig *rest.Config, kubeCoreCache corecache.KubeCoreCache) plugins.Plugin {
 	return &plugin{
 		kube:              kube,
+		restConfig:        restConfig,
 		UpstreamConverter: DefaultUpstreamConverter(),
 		kubeCoreCache:     kubeCoreCache,
 	}
@@ -76,6 +92,15 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 	}
 	for _, s := range svcs {
 		if s.Name == kube.Kube.ServiceName {
+			if s.Spec.Type == kubev1.ServiceTypeExternalName {
+				return errors.Errorf("Upstream %s references the service \"%s\" of type ExternalName, which has no endpoints. "+
+					"Use a static upstream for %s instead.", upstreamRef.String(), kube.Kube.ServiceName, s.Spec.ExternalName)
+			}
+			if healthcheck.ShouldSynthesize(kube.Kube.GetSynthesizedHealthCheck(), out.GetHealthChecks()) {
+				if hc := p.synthesizeHealthCheck(kube.Kube, s, in.GetSslConfig() != nil); hc != nil {
+					out.HealthChecks = append(out.GetHealthChecks(), hc)
+				}
+			}
 			return nil
 		}
 	}
@@ -84,3 +109,89 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 		upstreamRef.String(), kube.Kube.ServiceName, kube.Kube.ServiceNamespace)
 
 }
+
+// synthesizeHealthCheck builds a health check from the readiness probe of the first pod selected by the service
+// that probes the upstream's target port. Returns nil if no such probe is found.
+// HTTPS probes are only turned into HTTP health checks if the upstream originates TLS, since Envoy health checks use
+// the transport socket of the cluster; otherwise only the connection to the port is checked.
+func (p *plugin) synthesizeHealthCheck(spec *kubeplugin.UpstreamSpec, svc *kubev1.Service, upstreamTls bool) *envoy_config_core_v3.HealthCheck {
+	cfg := spec.GetSynthesizedHealthCheck()
+	if healthcheck.UseGrpc(cfg, spec.GetServiceSpec()) {
+		return healthcheck.Grpc(cfg)
+	}
+
+	var targetPort *intstr.IntOrString
+	for _, port := range svc.Spec.Ports {
+		if uint32(port.Port) == spec.GetServicePort() {
+			targetPort = &port.TargetPort
+			// kubernetes defaults the target port to the service port
+			if targetPort.Type == intstr.Int && targetPort.IntVal == 0 || targetPort.Type == intstr.String && targetPort.StrVal == "" {
+				defaultPort := intstr.FromInt(int(port.Port))
+				targetPort = &defaultPort
+			}
+			break
+		}
+	}
+	podLister := p.kubeCoreCache.NamespacedPodLister(svc.Namespace)
+	if targetPort == nil || podLister == nil {
+		return nil
+	}
+
+	selector := spec.GetSelector()
+	if len(selector) == 0 {
+		selector = svc.Spec.Selector
+	}
+	// the endpoints of services without a selector are managed by hand and need not be pods
+	if len(selector) == 0 {
+		return nil
+	}
+	pods, err := podLister.List(labels.SelectorFromSet(selector))
+	if err != nil {
+		return nil
+	}
+	for _, pod := range pods {
+		for _, container := range pod.Spec.Containers {
+			containerPort, ok := findContainerPort(container, *targetPort)
+			if !ok {
+				continue
+			}
+			probe := container.ReadinessProbe
+			if probe == nil {
+				continue
+			}
+			switch {
+			case probe.HTTPGet != nil && probesPort(probe.HTTPGet.Port, containerPort):
+				if probe.HTTPGet.Scheme == kubev1.URISchemeHTTPS && !upstreamTls {
+					return healthcheck.Tcp(cfg)
+				}
+				return healthcheck.Http(cfg, probe.HTTPGet.Path)
+			case probe.TCPSocket != nil && probesPort(probe.TCPSocket.Port, containerPort):
+				return healthcheck.Tcp(cfg)
+			}
+		}
+	}
+	return nil
+}
+
+func findContainerPort(container kubev1.Container, targetPort intstr.IntOrString) (kubev1.ContainerPort, bool) {
+	for _, port := range container.Ports {
+		if targetPort.Type == intstr.String && port.Name == targetPort.StrVal {
+			return port, true
+		}
+		if targetPort.Type == intstr.Int && port.ContainerPort == targetPort.IntVal {
+			return port, true
+		}
+	}
+	// containers need not declare the ports they listen on, so a numeric target port is probed directly
+	if targetPort.Type == intstr.Int {
+		return kubev1.ContainerPort{ContainerPort: targetPort.IntVal}, true
+	}
+	return kubev1.ContainerPort{}, false
+}
+
+func probesPort(probePort intstr.IntOrString, containerPort kubev1.ContainerPort) bool {
+	if probePort.Type == intstr.String {
+		return probePort.StrVal == containerPort.Name
+	}
+	return probePort.IntVal == containerPort.ContainerPort
+}
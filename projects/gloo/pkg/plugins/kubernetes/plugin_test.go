@@ -7,8 +7,14 @@ import (
 
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/utils/healthcheck"
 	corecache "github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/cache"
+	. "github.com/solo-io/solo-kit/test/matchers"
+	kubev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes/fake"
 
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
@@ -30,7 +36,7 @@ var _ = Describe("Plugin", func() {
 		kube = fake.NewSimpleClientset()
 		kubeCoreCache, err := corecache.NewKubeCoreCache(context.Background(), kube)
 		Expect(err).To(BeNil())
-		plugin = NewPlugin(kube, kubeCoreCache)
+		plugin = NewPlugin(kube, nil, kubeCoreCache)
 		plugin.Init(plugins.InitParams{})
 		upstream = &v1.Upstream{
 			Metadata: &core.Metadata{
@@ -60,7 +66,7 @@ var _ = Describe("Plugin", func() {
 			// Reset plugin to not watch all namespaces.
 			kubeCoreCache, err := corecache.NewKubeCoreCacheWithOptions(context.Background(), kube, time.Duration(1), []string{"ns"})
 			Expect(err).To(BeNil())
-			plugin = NewPlugin(kube, kubeCoreCache)
+			plugin = NewPlugin(kube, nil, kubeCoreCache)
 			plugin.Init(plugins.InitParams{})
 			upstream.UpstreamType = &v1.Upstream_Kube{
 				Kube: &kubernetes.UpstreamSpec{
@@ -73,6 +79,188 @@ var _ = Describe("Plugin", func() {
 			Expect(strings.Contains(err.Error(), "invalid ServiceNamespace")).To(BeTrue())
 		})
 
+		It("should error upstream with ExternalName service", func() {
+			_, err := kube.CoreV1().Services("ns").Create(context.Background(), &kubev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "mySvc", Namespace: "ns"},
+				Spec: kubev1.ServiceSpec{
+					Type:         kubev1.ServiceTypeExternalName,
+					ExternalName: "api.example.com",
+				},
+			}, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			kubeCoreCache, err := corecache.NewKubeCoreCache(context.Background(), kube)
+			Expect(err).NotTo(HaveOccurred())
+			plugin = NewPlugin(kube, nil, kubeCoreCache)
+			plugin.Init(plugins.InitParams{})
+
+			err = plugin.(plugins.UpstreamPlugin).ProcessUpstream(params, upstream, out)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Use a static upstream for api.example.com"))
+		})
+
+	})
+
+	Context("synthesized health checks", func() {
+
+		var (
+			probe         *kubev1.Probe
+			svcSelector   map[string]string
+			svcTargetPort intstr.IntOrString
+			containerPort int32
+			declarePorts  bool
+		)
+
+		BeforeEach(func() {
+			svcSelector = map[string]string{"app": "my-app"}
+			svcTargetPort = intstr.FromInt(8080)
+			containerPort = 8080
+			declarePorts = true
+			probe = &kubev1.Probe{
+				Handler: kubev1.Handler{
+					HTTPGet: &kubev1.HTTPGetAction{
+						Path: "/ready",
+						Port: intstr.FromString("http"),
+					},
+				},
+			}
+			upstream.UpstreamType = &v1.Upstream_Kube{
+				Kube: &kubernetes.UpstreamSpec{
+					ServiceName:            "mySvc",
+					ServiceNamespace:       "ns",
+					ServicePort:            80,
+					SynthesizedHealthCheck: &options.SynthesizedHealthCheck{Enabled: true},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			_, err := kube.CoreV1().Services("ns").Create(context.Background(), &kubev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "mySvc", Namespace: "ns"},
+				Spec: kubev1.ServiceSpec{
+					Selector: svcSelector,
+					Ports: []kubev1.ServicePort{{
+						Port:       80,
+						TargetPort: svcTargetPort,
+					}},
+				},
+			}, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			var containerPorts []kubev1.ContainerPort
+			if declarePorts {
+				containerPorts = []kubev1.ContainerPort{{Name: "http", ContainerPort: containerPort}}
+			}
+			_, err = kube.CoreV1().Pods("ns").Create(context.Background(), &kubev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-pod", Namespace: "ns", Labels: map[string]string{"app": "my-app"}},
+				Spec: kubev1.PodSpec{
+					Containers: []kubev1.Container{{
+						Name:           "app",
+						Ports:          containerPorts,
+						ReadinessProbe: probe,
+					}},
+				},
+			}, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			kubeCoreCache, err := corecache.NewKubeCoreCache(context.Background(), kube)
+			Expect(err).NotTo(HaveOccurred())
+			plugin = NewPlugin(kube, nil, kubeCoreCache)
+			plugin.Init(plugins.InitParams{})
+		})
+
+		It("synthesizes an http health check from the readiness probe", func() {
+			err := plugin.(plugins.UpstreamPlugin).ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.HealthChecks).To(HaveLen(1))
+			Expect(out.HealthChecks[0].GetHttpHealthCheck().GetPath()).To(Equal("/ready"))
+			Expect(out.HealthChecks[0].GetInterval()).To(MatchProto(healthcheck.DefaultInterval))
+		})
+
+		Context("tcp probe", func() {
+			BeforeEach(func() {
+				probe.Handler = kubev1.Handler{
+					TCPSocket: &kubev1.TCPSocketAction{Port: intstr.FromInt(8080)},
+				}
+			})
+
+			It("synthesizes a tcp health check", func() {
+				err := plugin.(plugins.UpstreamPlugin).ProcessUpstream(params, upstream, out)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(out.HealthChecks).To(HaveLen(1))
+				Expect(out.HealthChecks[0].GetTcpHealthCheck()).NotTo(BeNil())
+			})
+		})
+
+		Context("probe on a different port", func() {
+			BeforeEach(func() {
+				probe.HTTPGet.Port = intstr.FromInt(9090)
+			})
+
+			It("does not synthesize a health check", func() {
+				err := plugin.(plugins.UpstreamPlugin).ProcessUpstream(params, upstream, out)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(out.HealthChecks).To(BeEmpty())
+			})
+		})
+
+		Context("service without a target port", func() {
+			BeforeEach(func() {
+				svcTargetPort = intstr.IntOrString{}
+				containerPort = 80
+			})
+
+			It("matches the probe on the service port", func() {
+				err := plugin.(plugins.UpstreamPlugin).ProcessUpstream(params, upstream, out)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(out.HealthChecks).To(HaveLen(1))
+				Expect(out.HealthChecks[0].GetHttpHealthCheck().GetPath()).To(Equal("/ready"))
+			})
+		})
+
+		Context("container without declared ports", func() {
+			BeforeEach(func() {
+				declarePorts = false
+				probe.HTTPGet.Port = intstr.FromInt(8080)
+			})
+
+			It("matches the probe on the target port", func() {
+				err := plugin.(plugins.UpstreamPlugin).ProcessUpstream(params, upstream, out)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(out.HealthChecks).To(HaveLen(1))
+				Expect(out.HealthChecks[0].GetHttpHealthCheck().GetPath()).To(Equal("/ready"))
+			})
+		})
+
+		Context("https probe", func() {
+			BeforeEach(func() {
+				probe.HTTPGet.Scheme = kubev1.URISchemeHTTPS
+			})
+
+			It("synthesizes a tcp health check if the upstream does not originate tls", func() {
+				err := plugin.(plugins.UpstreamPlugin).ProcessUpstream(params, upstream, out)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(out.HealthChecks).To(HaveLen(1))
+				Expect(out.HealthChecks[0].GetTcpHealthCheck()).NotTo(BeNil())
+			})
+
+			It("synthesizes an http health check if the upstream originates tls", func() {
+				upstream.SslConfig = &v1.UpstreamSslConfig{Sni: "my-app"}
+				err := plugin.(plugins.UpstreamPlugin).ProcessUpstream(params, upstream, out)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(out.HealthChecks).To(HaveLen(1))
+				Expect(out.HealthChecks[0].GetHttpHealthCheck().GetPath()).To(Equal("/ready"))
+			})
+		})
+
+		Context("service without a selector", func() {
+			BeforeEach(func() {
+				svcSelector = nil
+			})
+
+			It("does not synthesize a health check from unrelated pods", func() {
+				err := plugin.(plugins.UpstreamPlugin).ProcessUpstream(params, upstream, out)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(out.HealthChecks).To(BeEmpty())
+			})
+		})
 	})
 
 })
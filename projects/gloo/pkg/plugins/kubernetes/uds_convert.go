@@ -3,7 +3,9 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"net"
 	"reflect"
+	"strings"
 
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/kubernetes/serviceconverter"
 	"github.com/solo-io/go-utils/contextutils"
@@ -14,6 +16,7 @@ import (
 
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	kubeplugin "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
 	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
 	"github.com/solo-io/solo-kit/pkg/errors"
 	"github.com/solo-io/solo-kit/pkg/utils/kubeutils"
@@ -64,6 +67,9 @@ func (uc *KubeUpstreamConverter) CreateUpstream(ctx context.Context, svc *kubev1
 			Labels: labels,
 		},
 	}
+	if svc.Spec.Type == kubev1.ServiceTypeExternalName {
+		us.UpstreamType = externalNameUpstreamType(svc, port)
+	}
 
 	for _, sc := range uc.serviceConverters {
 		if err := sc.ConvertService(svc, port, us); err != nil {
@@ -71,9 +77,50 @@ func (uc *KubeUpstreamConverter) CreateUpstream(ctx context.Context, svc *kubev1
 		}
 	}
 
+	// present the external name to the backend when TLS is configured through the service annotations
+	if staticSpec := us.GetStatic(); staticSpec != nil && us.GetSslConfig() != nil && us.GetSslConfig().GetSni() == "" {
+		us.SslConfig.Sni = staticSpec.GetHosts()[0].GetSniAddr()
+	}
+
 	return us
 }
 
+var tlsPortNames = []string{
+	"https",
+	"tls",
+}
+
+// ExternalName services alias a DNS name outside of the cluster, so there are no endpoints for EDS to discover.
+// Instead, Envoy resolves the name itself, and uses it for SNI so that the certificate of the backend matches.
+func externalNameUpstreamType(svc *kubev1.Service, port kubev1.ServicePort) *v1.Upstream_Static {
+	host := &static.Host{
+		Addr: strings.TrimSuffix(svc.Spec.ExternalName, "."),
+		Port: uint32(port.Port),
+	}
+	if net.ParseIP(host.Addr) == nil {
+		host.SniAddr = host.Addr
+	}
+	return &v1.Upstream_Static{
+		Static: &static.UpstreamSpec{
+			Hosts:  []*static.Host{host},
+			UseTls: useTls(port),
+		},
+	}
+}
+
+func useTls(port kubev1.ServicePort) bool {
+	if port.AppProtocol != nil {
+		appProtocol := strings.ToLower(*port.AppProtocol)
+		return containsString(appProtocol, tlsPortNames)
+	}
+	for _, tlsName := range tlsPortNames {
+		if strings.HasPrefix(port.Name, tlsName) {
+			return true
+		}
+	}
+	return port.Port == 443
+}
+
 func UpstreamName(serviceNamespace, serviceName string, servicePort int32) string {
 	return sanitizer.SanitizeNameV2(fmt.Sprintf("%s-%s-%v", serviceNamespace, serviceName, servicePort))
 }
@@ -109,18 +156,30 @@ func (p *plugin) UpdateUpstream(original, desired *v1.Upstream) (bool, error) {
 }
 
 func UpdateUpstream(original, desired *v1.Upstream) (didChange bool, err error) {
-	originalSpec, ok := original.UpstreamType.(*v1.Upstream_Kube)
-	if !ok {
-		return false, errors.Errorf("internal error: expected *v1.Upstream_Kube, got %v", reflect.TypeOf(original.UpstreamType).Name())
-	}
-	desiredSpec, ok := desired.UpstreamType.(*v1.Upstream_Kube)
-	if !ok {
-		return false, errors.Errorf("internal error: expected *v1.Upstream_Kube, got %v", reflect.TypeOf(original.UpstreamType).Name())
+	switch desiredSpec := desired.UpstreamType.(type) {
+	case *v1.Upstream_Kube:
+		originalSpec, ok := original.UpstreamType.(*v1.Upstream_Kube)
+		if !ok {
+			// the service was changed from an ExternalName service
+			break
+		}
+		// copy service spec, we don't want to overwrite that
+		desiredSpec.Kube.ServiceSpec = originalSpec.Kube.ServiceSpec
+		// copy labels; user may have written them over. cannot be auto-discovered
+		desiredSpec.Kube.Selector = originalSpec.Kube.Selector
+		// copy the health check synthesis, which is only set by users
+		desiredSpec.Kube.SynthesizedHealthCheck = originalSpec.Kube.SynthesizedHealthCheck
+	case *v1.Upstream_Static:
+		originalSpec, ok := original.UpstreamType.(*v1.Upstream_Static)
+		if !ok {
+			// the service was changed to an ExternalName service
+			break
+		}
+		// copy service spec, we don't want to overwrite that
+		desiredSpec.Static.ServiceSpec = originalSpec.Static.ServiceSpec
+	default:
+		return false, errors.Errorf("internal error: expected *v1.Upstream_Kube or *v1.Upstream_Static, got %v", reflect.TypeOf(desired.UpstreamType).Name())
 	}
-	// copy service spec, we don't want to overwrite that
-	desiredSpec.Kube.ServiceSpec = originalSpec.Kube.ServiceSpec
-	// copy labels; user may have written them over. cannot be auto-discovered
-	desiredSpec.Kube.Selector = originalSpec.Kube.Selector
 
 	utils.UpdateUpstream(original, desired)
 
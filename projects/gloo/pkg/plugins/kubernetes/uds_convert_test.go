@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -169,4 +170,86 @@ var _ = Describe("UdsConvert", func() {
 			}, nil),
 		)
 	})
+
+	Context("ExternalName service", func() {
+		var svc *kubev1.Service
+
+		BeforeEach(func() {
+			svc = &kubev1.Service{
+				Spec: kubev1.ServiceSpec{
+					Type:         kubev1.ServiceTypeExternalName,
+					ExternalName: "api.example.com.",
+				},
+			}
+			svc.Name = "test"
+			svc.Namespace = "test"
+		})
+
+		It("should create a static upstream for the external name", func() {
+			up := createUpstream(context.TODO(), svc, kubev1.ServicePort{Port: 80})
+			Expect(up.GetKube()).To(BeNil())
+			Expect(up.GetStatic().GetHosts()).To(HaveLen(1))
+			host := up.GetStatic().GetHosts()[0]
+			Expect(host.GetAddr()).To(Equal("api.example.com"))
+			Expect(host.GetPort()).To(BeEquivalentTo(80))
+			Expect(host.GetSniAddr()).To(Equal("api.example.com"))
+			Expect(up.GetStatic().GetUseTls()).To(BeFalse())
+		})
+
+		It("should not use an ip address for SNI", func() {
+			svc.Spec.ExternalName = "10.0.0.1"
+			up := createUpstream(context.TODO(), svc, kubev1.ServicePort{Port: 80})
+			Expect(up.GetStatic().GetHosts()[0].GetSniAddr()).To(BeEmpty())
+		})
+
+		It("should use the external name for SNI when ssl annotations are present", func() {
+			svc.Annotations = map[string]string{serviceconverter.GlooSslSecretAnnotation: "mysecret"}
+			up := createUpstream(context.TODO(), svc, kubev1.ServicePort{Port: 8443})
+			Expect(up.GetSslConfig().GetSni()).To(Equal("api.example.com"))
+		})
+
+		appProtocol := func(s string) *string { return &s }
+
+		DescribeTable("should use tls for tls ports", func(port kubev1.ServicePort, useTls bool) {
+			up := createUpstream(context.TODO(), svc, port)
+			Expect(up.GetStatic().GetUseTls()).To(Equal(useTls))
+		},
+			Entry("port 443", kubev1.ServicePort{Port: 443}, true),
+			Entry("https port name", kubev1.ServicePort{Name: "https-api", Port: 8443}, true),
+			Entry("tls app protocol", kubev1.ServicePort{Port: 8443, AppProtocol: appProtocol("TLS")}, true),
+			Entry("http app protocol on port 443", kubev1.ServicePort{Port: 443, AppProtocol: appProtocol("http")}, false),
+			Entry("plain port", kubev1.ServicePort{Name: "http", Port: 8080}, false),
+		)
+	})
+
+	Context("UpdateUpstream", func() {
+		It("should replace a kube upstream when the service becomes an ExternalName service", func() {
+			svc := &kubev1.Service{}
+			svc.Name = "test"
+			svc.Namespace = "test"
+			original := createUpstream(context.TODO(), svc, kubev1.ServicePort{Port: 80})
+
+			svc.Spec.Type = kubev1.ServiceTypeExternalName
+			svc.Spec.ExternalName = "api.example.com"
+			desired := createUpstream(context.TODO(), svc, kubev1.ServicePort{Port: 80})
+
+			changed, err := UpdateUpstream(original, desired)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changed).To(BeTrue())
+			Expect(desired.GetStatic()).NotTo(BeNil())
+		})
+
+		It("should keep the synthesized health check of a kube upstream", func() {
+			svc := &kubev1.Service{}
+			svc.Name = "test"
+			svc.Namespace = "test"
+			original := createUpstream(context.TODO(), svc, kubev1.ServicePort{Port: 80})
+			original.GetKube().SynthesizedHealthCheck = &options.SynthesizedHealthCheck{Enabled: true}
+			desired := createUpstream(context.TODO(), svc, kubev1.ServicePort{Port: 80})
+
+			_, err := UpdateUpstream(original, desired)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(desired.GetKube().GetSynthesizedHealthCheck().GetEnabled()).To(BeTrue())
+		})
+	})
 })
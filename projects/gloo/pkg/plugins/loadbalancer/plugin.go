@@ -1,11 +1,14 @@
 package loadbalancer
 
 import (
+	"context"
+
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/utils/regexutils"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/lbhash"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
@@ -41,11 +44,11 @@ func (p *Plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 		return InvalidRouteTypeError(err)
 	}
 	outRa := out.GetRoute()
-	outRa.HashPolicy = getHashPoliciesFromSpec(lbPlugin.HashPolicies)
+	outRa.HashPolicy = getHashPoliciesFromSpec(params.Ctx, lbPlugin.HashPolicies)
 	return nil
 }
 
-func getHashPoliciesFromSpec(spec []*lbhash.HashPolicy) []*envoy_config_route_v3.RouteAction_HashPolicy {
+func getHashPoliciesFromSpec(ctx context.Context, spec []*lbhash.HashPolicy) []*envoy_config_route_v3.RouteAction_HashPolicy {
 	var policies []*envoy_config_route_v3.RouteAction_HashPolicy
 	for _, s := range spec {
 		policy := &envoy_config_route_v3.RouteAction_HashPolicy{
@@ -72,6 +75,25 @@ func getHashPoliciesFromSpec(spec []*lbhash.HashPolicy) []*envoy_config_route_v3
 					SourceIp: keyType.SourceIp,
 				},
 			}
+		case *lbhash.HashPolicy_QueryParameter:
+			policy.PolicySpecifier = &envoy_config_route_v3.RouteAction_HashPolicy_QueryParameter_{
+				QueryParameter: &envoy_config_route_v3.RouteAction_HashPolicy_QueryParameter{
+					Name: keyType.QueryParameter,
+				},
+			}
+		case *lbhash.HashPolicy_HeaderRegex:
+			policy.PolicySpecifier = &envoy_config_route_v3.RouteAction_HashPolicy_Header_{
+				Header: &envoy_config_route_v3.RouteAction_HashPolicy_Header{
+					HeaderName:   keyType.HeaderRegex.GetHeaderName(),
+					RegexRewrite: regexutils.ConvertRegexMatchAndSubstitute(ctx, keyType.HeaderRegex.GetRegexRewrite()),
+				},
+			}
+		case *lbhash.HashPolicy_FilterState:
+			policy.PolicySpecifier = &envoy_config_route_v3.RouteAction_HashPolicy_FilterState_{
+				FilterState: &envoy_config_route_v3.RouteAction_HashPolicy_FilterState{
+					Key: keyType.FilterState,
+				},
+			}
 		}
 		policies = append(policies, policy)
 	}
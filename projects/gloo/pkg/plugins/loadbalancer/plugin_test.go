@@ -1,17 +1,20 @@
 package loadbalancer_test
 
 import (
+	"context"
 	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
 
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/printers"
+	v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/matcher/v3"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/lbhash"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
@@ -360,6 +363,66 @@ status: {}
 				},
 			}))
 		})
+		It("configures routes - query parameter, header regex and filter state", func() {
+			routeParams.Ctx = context.Background()
+			route.Options = &v1.RouteOptions{
+				LbHash: &lbhash.RouteActionHashConfig{
+					HashPolicies: []*lbhash.HashPolicy{
+						{
+							KeyType:  &lbhash.HashPolicy_QueryParameter{QueryParameter: "tenant"},
+							Terminal: true,
+						},
+						{
+							KeyType: &lbhash.HashPolicy_HeaderRegex{HeaderRegex: &lbhash.HeaderRegex{
+								HeaderName: "x-session",
+								RegexRewrite: &v3.RegexMatchAndSubstitute{
+									Pattern:      &v3.RegexMatcher{Regex: "^tenant=([^;]+).*$"},
+									Substitution: "\\1",
+								},
+							}},
+						},
+						{
+							KeyType: &lbhash.HashPolicy_FilterState{FilterState: "io.solo.tenant"},
+						},
+					},
+				},
+			}
+			err := plugin.ProcessRoute(routeParams, route, outRoute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(outRoute.GetRoute().HashPolicy).To(Equal([]*envoy_config_route_v3.RouteAction_HashPolicy{
+				{
+					PolicySpecifier: &envoy_config_route_v3.RouteAction_HashPolicy_QueryParameter_{
+						QueryParameter: &envoy_config_route_v3.RouteAction_HashPolicy_QueryParameter{
+							Name: "tenant",
+						},
+					},
+					Terminal: true,
+				},
+				{
+					PolicySpecifier: &envoy_config_route_v3.RouteAction_HashPolicy_Header_{
+						Header: &envoy_config_route_v3.RouteAction_HashPolicy_Header{
+							HeaderName: "x-session",
+							RegexRewrite: &envoy_type_matcher_v3.RegexMatchAndSubstitute{
+								Pattern: &envoy_type_matcher_v3.RegexMatcher{
+									EngineType: &envoy_type_matcher_v3.RegexMatcher_GoogleRe2{
+										GoogleRe2: &envoy_type_matcher_v3.RegexMatcher_GoogleRE2{},
+									},
+									Regex: "^tenant=([^;]+).*$",
+								},
+								Substitution: "\\1",
+							},
+						},
+					},
+				},
+				{
+					PolicySpecifier: &envoy_config_route_v3.RouteAction_HashPolicy_FilterState_{
+						FilterState: &envoy_config_route_v3.RouteAction_HashPolicy_FilterState{
+							Key: "io.solo.tenant",
+						},
+					},
+				},
+			}))
+		})
 		// negative cases
 		It("skips non-route-action routes", func() {
 			outRoute.Action = &envoy_config_route_v3.Route_Redirect{}
@@ -2,24 +2,56 @@ package pipe
 
 import (
 	"errors"
+	"fmt"
+	"path"
+	"strings"
 
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1pipe "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/pipe"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/utils/healthcheck"
+	corecache "github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/cache"
+	kubev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
-type Plugin struct{}
+const (
+	// Prefix of the paths of sockets in the Linux abstract namespace
+	AbstractSocketPrefix = "@"
+
+	// The label identifying the pods of gateway proxies. The value of the gateway-proxy-id label is the name of
+	// the container running Envoy.
+	gatewayProxyLabel   = "gloo"
+	gatewayProxyValue   = "gateway-proxy"
+	gatewayProxyIdLabel = "gateway-proxy-id"
+)
+
+var (
+	InvalidModeErr = func(mode uint32) error {
+		return fmt.Errorf("invalid socket mode %#o, must be at most 0777", mode)
+	}
+	AbstractSocketModeErr = errors.New("a mode cannot be set for abstract sockets, which have no permissions")
+)
+
+type Plugin struct {
+	// used to look up the volumes of the gateway proxy pods; nil when not running in Kubernetes
+	kubeCoreCache  corecache.KubeCoreCache
+	proxyNamespace string
+}
 
 var _ plugins.Plugin = new(Plugin)
 var _ plugins.UpstreamPlugin = new(Plugin)
 
-func NewPlugin() *Plugin {
-	return &Plugin{}
+func NewPlugin(kubeCoreCache corecache.KubeCoreCache) *Plugin {
+	return &Plugin{kubeCoreCache: kubeCoreCache}
 }
 
 func (p *Plugin) Init(params plugins.InitParams) error {
+	p.proxyNamespace = params.Settings.GetDiscoveryNamespace()
 	return nil
 }
 
@@ -37,6 +69,17 @@ func (p *Plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 	if spec.Path == "" {
 		return errors.New("no path provided")
 	}
+	socketPath := spec.GetPath()
+	if spec.GetAbstract() && !strings.HasPrefix(socketPath, AbstractSocketPrefix) {
+		socketPath = AbstractSocketPrefix + socketPath
+	}
+	abstract := strings.HasPrefix(socketPath, AbstractSocketPrefix)
+	if spec.GetMode() > 0777 {
+		return InvalidModeErr(spec.GetMode())
+	}
+	if abstract && spec.GetMode() != 0 {
+		return AbstractSocketModeErr
+	}
 
 	out.LoadAssignment = &envoy_config_endpoint_v3.ClusterLoadAssignment{
 		ClusterName: out.Name,
@@ -50,7 +93,7 @@ func (p *Plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 					Address: &envoy_config_core_v3.Address{
 						Address: &envoy_config_core_v3.Address_Pipe{
 							Pipe: &envoy_config_core_v3.Pipe{
-								Path: spec.Path,
+								Path: socketPath,
 							},
 						},
 					},
@@ -58,5 +101,72 @@ func (p *Plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 			},
 		})
 
+	if healthcheck.ShouldSynthesize(spec.GetSynthesizedHealthCheck(), out.GetHealthChecks()) {
+		out.HealthChecks = append(out.GetHealthChecks(), synthesizeHealthCheck(spec))
+	}
+
+	// the remaining checks only produce warnings, so they come last
+	if abstract {
+		return nil
+	}
+	var warnings []string
+	if mode := spec.GetMode(); mode != 0 && mode&0022 == 0 {
+		warnings = append(warnings, fmt.Sprintf("socket mode %#o only allows the owner of %s to connect, "+
+			"Envoy will not be able to connect unless it runs as the same user", mode, socketPath))
+	}
+	if warning := p.checkProxyVolumes(socketPath); warning != nil {
+		warnings = append(warnings, warning.Error())
+	}
+	if len(warnings) > 0 {
+		return pluginutils.NewUpstreamWarning("%s", strings.Join(warnings, "; "))
+	}
 	return nil
 }
+
+func synthesizeHealthCheck(spec *v1pipe.UpstreamSpec) *envoy_config_core_v3.HealthCheck {
+	cfg := spec.GetSynthesizedHealthCheck()
+	if healthcheck.UseGrpc(cfg, spec.GetServiceSpec()) {
+		return healthcheck.Grpc(cfg)
+	}
+	return healthcheck.Http(cfg, spec.GetHealthCheckPath())
+}
+
+// Sockets shared with a sidecar must be in a volume mounted into both containers, as each container has its own
+// file system. Returns a warning if no gateway proxy pod mounts a volume containing the socket.
+func (p *Plugin) checkProxyVolumes(socketPath string) error {
+	if p.kubeCoreCache == nil {
+		return nil
+	}
+	podLister := p.kubeCoreCache.NamespacedPodLister(p.proxyNamespace)
+	if podLister == nil {
+		return nil
+	}
+	pods, err := podLister.List(labels.SelectorFromSet(map[string]string{gatewayProxyLabel: gatewayProxyValue}))
+	if err != nil || len(pods) == 0 {
+		// we cannot tell where the proxies run
+		return nil
+	}
+	for _, pod := range pods {
+		if proxyMountsPath(pod, socketPath) {
+			return nil
+		}
+	}
+	return pluginutils.NewUpstreamWarning("socket %s is not in a volume mounted into the gateway proxy pods in namespace %s",
+		socketPath, p.proxyNamespace)
+}
+
+func proxyMountsPath(pod *kubev1.Pod, socketPath string) bool {
+	socketPath = path.Clean(socketPath)
+	for _, container := range pod.Spec.Containers {
+		if container.Name != pod.Labels[gatewayProxyIdLabel] {
+			continue
+		}
+		for _, mount := range container.VolumeMounts {
+			mountPath := path.Clean(mount.MountPath)
+			if mountPath == "/" || strings.HasPrefix(socketPath, mountPath+"/") {
+				return true
+			}
+		}
+	}
+	return false
+}
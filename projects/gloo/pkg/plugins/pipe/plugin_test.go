@@ -1,15 +1,24 @@
 package pipe_test
 
 import (
+	"context"
+
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
+	"github.com/golang/protobuf/ptypes/wrappers"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
 	v1pipe "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/pipe"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/pipe"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	corecache "github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/cache"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	kubev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
 )
 
 var _ = Describe("Plugin", func() {
@@ -23,7 +32,7 @@ var _ = Describe("Plugin", func() {
 	)
 
 	BeforeEach(func() {
-		p = NewPlugin()
+		p = NewPlugin(nil)
 		out = new(envoy_config_cluster_v3.Cluster)
 		out.Name = "foo"
 
@@ -57,4 +66,157 @@ var _ = Describe("Plugin", func() {
 		Expect(err).To(MatchError("no path provided"))
 	})
 
+	pipePath := func() string {
+		return out.GetLoadAssignment().GetEndpoints()[0].GetLbEndpoints()[0].GetEndpoint().GetAddress().GetPipe().GetPath()
+	}
+
+	Context("abstract sockets", func() {
+		It("should prefix the path of abstract sockets", func() {
+			upstreamSpec.Abstract = true
+			err := p.ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipePath()).To(Equal("@/foo"))
+		})
+
+		It("should accept paths in the abstract namespace", func() {
+			upstreamSpec.Path = "@sidecar"
+			upstreamSpec.Abstract = true
+			err := p.ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipePath()).To(Equal("@sidecar"))
+		})
+
+		It("should error if a mode is set", func() {
+			upstreamSpec.Path = "@sidecar"
+			upstreamSpec.Mode = 0660
+			err := p.ProcessUpstream(params, upstream, out)
+			Expect(err).To(MatchError(AbstractSocketModeErr))
+		})
+	})
+
+	Context("mode", func() {
+		It("should error with an invalid mode", func() {
+			upstreamSpec.Mode = 01777
+			err := p.ProcessUpstream(params, upstream, out)
+			Expect(err).To(MatchError(InvalidModeErr(01777)))
+		})
+
+		It("should warn if only the owner can connect", func() {
+			upstreamSpec.Mode = 0600
+			err := p.ProcessUpstream(params, upstream, out)
+			Expect(pluginutils.IsUpstreamWarning(err)).To(BeTrue())
+			Expect(pipePath()).To(Equal("/foo"))
+		})
+
+		It("should accept a mode that lets the group connect", func() {
+			upstreamSpec.Mode = 0660
+			err := p.ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Context("synthesized health checks", func() {
+		BeforeEach(func() {
+			upstreamSpec.SynthesizedHealthCheck = &options.SynthesizedHealthCheck{Enabled: true}
+		})
+
+		It("should synthesize an http health check", func() {
+			upstreamSpec.HealthCheckPath = "/healthz"
+			err := p.ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetHealthChecks()).To(HaveLen(1))
+			Expect(out.GetHealthChecks()[0].GetHttpHealthCheck().GetPath()).To(Equal("/healthz"))
+		})
+
+		It("should synthesize a grpc health check for grpc services", func() {
+			upstreamSpec.SynthesizedHealthCheck.Grpc = &wrappers.BoolValue{Value: true}
+			err := p.ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetHealthChecks()).To(HaveLen(1))
+			Expect(out.GetHealthChecks()[0].GetGrpcHealthCheck()).NotTo(BeNil())
+		})
+	})
+
+	Context("gateway proxy volumes", func() {
+		var kube *fake.Clientset
+
+		proxyPod := func() *kubev1.Pod {
+			return &kubev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "gateway-proxy-abc",
+					Namespace: "gloo-system",
+					Labels:    map[string]string{"gloo": "gateway-proxy", "gateway-proxy-id": "gateway-proxy"},
+				},
+				Spec: kubev1.PodSpec{
+					Containers: []kubev1.Container{
+						{
+							Name:         "gateway-proxy",
+							VolumeMounts: []kubev1.VolumeMount{{Name: "envoy-config", MountPath: "/etc/envoy"}},
+						},
+						{
+							Name:         "sidecar",
+							VolumeMounts: []kubev1.VolumeMount{{Name: "sockets", MountPath: "/var/run/sidecar"}},
+						},
+					},
+				},
+			}
+		}
+
+		JustBeforeEach(func() {
+			kubeCoreCache, err := corecache.NewKubeCoreCache(context.Background(), kube)
+			Expect(err).NotTo(HaveOccurred())
+			p = NewPlugin(kubeCoreCache)
+			p.Init(plugins.InitParams{Settings: &v1.Settings{DiscoveryNamespace: "gloo-system"}})
+		})
+
+		BeforeEach(func() {
+			upstreamSpec.Path = "/var/run/sidecar/ext.sock"
+			kube = fake.NewSimpleClientset(proxyPod())
+		})
+
+		It("should warn if the socket is not mounted into the envoy container", func() {
+			err := p.ProcessUpstream(params, upstream, out)
+			Expect(pluginutils.IsUpstreamWarning(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("not in a volume mounted into the gateway proxy pods"))
+		})
+
+		It("should warn about the volume if only the owner can connect", func() {
+			upstreamSpec.Mode = 0600
+			err := p.ProcessUpstream(params, upstream, out)
+			Expect(pluginutils.IsUpstreamWarning(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("only allows the owner"))
+			Expect(err.Error()).To(ContainSubstring("not in a volume mounted into the gateway proxy pods"))
+		})
+
+		Context("socket volume mounted into envoy", func() {
+			BeforeEach(func() {
+				pod := proxyPod()
+				pod.Spec.Containers[0].VolumeMounts = append(pod.Spec.Containers[0].VolumeMounts,
+					kubev1.VolumeMount{Name: "sockets", MountPath: "/var/run/sidecar/"})
+				kube = fake.NewSimpleClientset(pod)
+			})
+
+			It("should not warn", func() {
+				err := p.ProcessUpstream(params, upstream, out)
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("no gateway proxy pods", func() {
+			BeforeEach(func() {
+				kube = fake.NewSimpleClientset()
+			})
+
+			It("should not warn", func() {
+				err := p.ProcessUpstream(params, upstream, out)
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		It("should not check abstract sockets", func() {
+			upstreamSpec.Abstract = true
+			err := p.ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
 })
@@ -0,0 +1,25 @@
+package pluginutils
+
+import "fmt"
+
+// Returned by upstream plugins for configuration that is valid, but is unlikely to work as intended.
+// The translator reports it as a warning on the upstream rather than an error, so the upstream is still accepted.
+type UpstreamWarning struct {
+	Message string
+}
+
+func NewUpstreamWarning(format string, args ...interface{}) *UpstreamWarning {
+	return &UpstreamWarning{Message: fmt.Sprintf(format, args...)}
+}
+
+func (w *UpstreamWarning) Error() string {
+	return w.Message
+}
+
+func IsUpstreamWarning(err error) bool {
+	if err == nil {
+		return false
+	}
+	_, ok := err.(*UpstreamWarning)
+	return ok
+}
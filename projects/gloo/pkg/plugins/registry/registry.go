@@ -38,6 +38,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/tracing"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/transformation"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/tunneling"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/udp"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/upstreamconn"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/virtualhost"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/wasm"
@@ -62,8 +63,9 @@ var globalRegistry = func(opts bootstrap.Opts, pluginExtensions ...func() plugin
 		hcmPlugin,
 		als.NewPlugin(),
 		tls_inspector.NewPlugin(),
-		pipe.NewPlugin(),
+		pipe.NewPlugin(opts.KubeCoreCache),
 		tcp.NewPlugin(utils.NewSslConfigTranslator()),
+		udp.NewPlugin(),
 		static.NewPlugin(),
 		transformationPlugin,
 		grpcweb.NewPlugin(),
@@ -92,7 +94,7 @@ var globalRegistry = func(opts bootstrap.Opts, pluginExtensions ...func() plugin
 		tunneling.NewPlugin(),
 	)
 	if opts.KubeClient != nil {
-		reg.plugins = append(reg.plugins, kubernetes.NewPlugin(opts.KubeClient, opts.KubeCoreCache))
+		reg.plugins = append(reg.plugins, kubernetes.NewPlugin(opts.KubeClient, opts.KubeRestConfig, opts.KubeCoreCache))
 	}
 	if opts.Consul.ConsulWatcher != nil {
 		reg.plugins = append(reg.plugins, consul.NewPlugin(opts.Consul.ConsulWatcher, &consul.ConsulDnsResolver{DnsAddress: opts.Consul.DnsServer}, opts.Consul.DnsPollingInterval))
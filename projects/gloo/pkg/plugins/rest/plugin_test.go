@@ -170,5 +170,97 @@ var _ = Describe("Plugin", func() {
 			Expect(subMatches[extrs["ever"].Subgroup]).To(Equal("second%34value"))
 			Expect(subMatches[extrs["nested.field"].Subgroup]).To(Equal("third-value"))
 		})
+
+		Context("response defaults", func() {
+			var responseSpec *v1rest.ResponseSpec
+
+			translate := func() (*envoy_transform.RouteTransformations, error) {
+				restSpec.Rest.Responses = map[string]*v1rest.ResponseSpec{"func": responseSpec}
+				err := p.ProcessUpstream(params, upstream, out)
+				Expect(err).NotTo(HaveOccurred())
+				var routeParams plugins.RouteParams
+				if err := p.ProcessRoute(routeParams, routeIn, routeOut); err != nil {
+					return nil, err
+				}
+				var cfg envoy_transform.RouteTransformations
+				err = ptypes.UnmarshalAny(routeOut.GetTypedPerFilterConfig()[transformation.FilterName], &cfg)
+				Expect(err).NotTo(HaveOccurred())
+				return &cfg, nil
+			}
+
+			BeforeEach(func() {
+				responseSpec = &v1rest.ResponseSpec{
+					Transformation: &envoy_transform.TransformationTemplate{AdvancedTemplates: true},
+					StatusMappings: []*v1rest.ResponseSpec_StatusMapping{{From: 404, To: 204}},
+					ContentTypes: []*v1rest.ResponseSpec_ContentType{{
+						ContentType:    "application/xml",
+						Transformation: &envoy_transform.TransformationTemplate{IgnoreErrorOnParse: true},
+					}},
+				}
+			})
+
+			It("matches content types first, then status codes, then the default", func() {
+				cfg, err := translate()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cfg.GetRequestTransformation()).To(BeNil())
+				Expect(cfg.GetTransformations()).To(HaveLen(4))
+
+				xml := cfg.GetTransformations()[0].GetRequestMatch()
+				Expect(xml.GetMatch().GetHeaders()[0].GetName()).To(Equal("accept"))
+				Expect(xml.GetMatch().GetHeaders()[0].GetSafeRegexMatch().GetRegex()).To(Equal(`.*application/xml.*`))
+				Expect(xml.GetRequestTransformation().GetTransformationTemplate().GetExtractors()).To(HaveKey("what"))
+				Expect(xml.GetResponseTransformation().GetTransformationTemplate().GetIgnoreErrorOnParse()).To(BeTrue())
+
+				catchAll := cfg.GetTransformations()[1].GetRequestMatch()
+				Expect(catchAll.GetMatch()).To(BeNil())
+				Expect(catchAll.GetRequestTransformation()).NotTo(BeNil())
+				Expect(catchAll.GetResponseTransformation()).To(BeNil())
+
+				status := cfg.GetTransformations()[2].GetResponseMatch()
+				Expect(status.GetMatch().GetHeaders()[0].GetName()).To(Equal(":status"))
+				Expect(status.GetMatch().GetHeaders()[0].GetExactMatch()).To(Equal("404"))
+				statusTemplate := status.GetResponseTransformation().GetTransformationTemplate()
+				Expect(statusTemplate.GetHeaders()[":status"].GetText()).To(Equal("204"))
+				Expect(statusTemplate.GetPassthrough()).NotTo(BeNil())
+
+				def := cfg.GetTransformations()[3].GetResponseMatch()
+				Expect(def.GetMatch().GetHeaders()).To(BeEmpty())
+				Expect(def.GetResponseTransformation().GetTransformationTemplate().GetAdvancedTemplates()).To(BeTrue())
+			})
+
+			It("uses the body template of a status mapping", func() {
+				responseSpec.StatusMappings[0].Body = &envoy_transform.TransformationTemplate{
+					BodyTransformation: &envoy_transform.TransformationTemplate_Body{
+						Body: &envoy_transform.InjaTemplate{Text: "not found"},
+					},
+				}
+				cfg, err := translate()
+				Expect(err).NotTo(HaveOccurred())
+				statusTemplate := cfg.GetTransformations()[2].GetResponseMatch().GetResponseTransformation().GetTransformationTemplate()
+				Expect(statusTemplate.GetBody().GetText()).To(Equal("not found"))
+				Expect(statusTemplate.GetHeaders()[":status"].GetText()).To(Equal("204"))
+				Expect(responseSpec.StatusMappings[0].Body.GetHeaders()).To(BeEmpty())
+			})
+
+			It("ignores the response defaults if the destination sets a response transformation", func() {
+				routeIn.GetRouteAction().GetSingle().GetDestinationSpec().GetRest().ResponseTransformation = &envoy_transform.TransformationTemplate{
+					ParseBodyBehavior: envoy_transform.TransformationTemplate_DontParse,
+				}
+				cfg, err := translate()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cfg.GetTransformations()).To(BeEmpty())
+				Expect(cfg.GetRequestTransformation().GetTransformationTemplate().GetExtractors()).To(HaveKey("what"))
+				responseTemplate := cfg.GetResponseTransformation().GetTransformationTemplate()
+				Expect(responseTemplate.GetParseBodyBehavior()).To(Equal(envoy_transform.TransformationTemplate_DontParse))
+				Expect(responseTemplate.GetAdvancedTemplates()).To(BeFalse())
+			})
+
+			It("rejects invalid status codes", func() {
+				responseSpec.StatusMappings[0].To = 42
+				_, err := translate()
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("invalid status mapping from 404 to 42"))
+			})
+		})
 	})
 })
@@ -6,13 +6,18 @@ this will grab the parameters from the route extension
 */
 import (
 	"context"
+	"regexp"
+	"strconv"
 
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	"github.com/golang/protobuf/proto"
+	envoyroutev3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/route/v3"
 	transformapi "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/transformation"
+	v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/matcher/v3"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	glooplugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/rest"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/transformation"
@@ -108,16 +113,24 @@ func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 				return nil, err
 			}
 
+			requestTransformation := &transformapi.Transformation{
+				TransformationType: &transformapi.Transformation_TransformationTemplate{
+					TransformationTemplate: &transformation,
+				},
+			}
+			*p.transformsAdded = true
+
+			// the response transformation of the destination replaces the response defaults of the function
+			responseSpec := restServiceSpec.Rest.GetResponses()[funcname]
+			if responseSpec != nil && restDestinationSpec.Rest.GetResponseTransformation() == nil {
+				return translateResponseSpec(funcname, requestTransformation, responseSpec)
+			}
+
 			// get function
 			ret := &transformapi.RouteTransformations{
-				RequestTransformation: &transformapi.Transformation{
-					TransformationType: &transformapi.Transformation_TransformationTemplate{
-						TransformationTemplate: &transformation,
-					},
-				},
+				RequestTransformation: requestTransformation,
 			}
 
-			*p.transformsAdded = true
 			if restDestinationSpec.Rest.ResponseTransformation != nil {
 				// TODO(yuval-k): should we add \ support response parameters?
 				ret.ResponseTransformation = &transformapi.Transformation{
@@ -131,3 +144,106 @@ func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 		},
 	)
 }
+
+// Translates the response defaults of a function. Requests are matched by their Accept header first, so that
+// content type specific transformations win; all other responses go through the status mappings, then the default.
+func translateResponseSpec(funcname string, requestTransformation *transformapi.Transformation, spec *rest.ResponseSpec) (*transformapi.RouteTransformations, error) {
+	ret := &transformapi.RouteTransformations{}
+
+	for _, contentType := range spec.GetContentTypes() {
+		if contentType.GetContentType() == "" {
+			return nil, errors.Errorf("function %v has a content type transformation without a content type", funcname)
+		}
+		ret.Transformations = append(ret.Transformations, &transformapi.RouteTransformations_RouteTransformation{
+			Match: &transformapi.RouteTransformations_RouteTransformation_RequestMatch_{
+				RequestMatch: &transformapi.RouteTransformations_RouteTransformation_RequestMatch{
+					Match: &envoyroutev3.RouteMatch{
+						PathSpecifier: &envoyroutev3.RouteMatch_Prefix{Prefix: "/"},
+						Headers: []*envoyroutev3.HeaderMatcher{{
+							Name: "accept",
+							HeaderMatchSpecifier: &envoyroutev3.HeaderMatcher_SafeRegexMatch{
+								SafeRegexMatch: &v3.RegexMatcher{
+									EngineType: &v3.RegexMatcher_GoogleRe2{GoogleRe2: &v3.RegexMatcher_GoogleRE2{}},
+									Regex:      ".*" + regexp.QuoteMeta(contentType.GetContentType()) + ".*",
+								},
+							},
+						}},
+					},
+					RequestTransformation:  requestTransformation,
+					ResponseTransformation: templateTransformation(contentType.GetTransformation()),
+				},
+			},
+		})
+	}
+
+	// without a response transformation here, the response matches below are used
+	ret.Transformations = append(ret.Transformations, &transformapi.RouteTransformations_RouteTransformation{
+		Match: &transformapi.RouteTransformations_RouteTransformation_RequestMatch_{
+			RequestMatch: &transformapi.RouteTransformations_RouteTransformation_RequestMatch{
+				RequestTransformation: requestTransformation,
+			},
+		},
+	})
+
+	for _, mapping := range spec.GetStatusMappings() {
+		if !validStatus(mapping.GetFrom()) || !validStatus(mapping.GetTo()) {
+			return nil, errors.Errorf("function %v has an invalid status mapping from %d to %d", funcname, mapping.GetFrom(), mapping.GetTo())
+		}
+		ret.Transformations = append(ret.Transformations, &transformapi.RouteTransformations_RouteTransformation{
+			Match: &transformapi.RouteTransformations_RouteTransformation_ResponseMatch_{
+				ResponseMatch: &transformapi.RouteTransformations_RouteTransformation_ResponseMatch{
+					Match: &transformapi.ResponseMatcher{
+						Headers: []*envoyroutev3.HeaderMatcher{{
+							Name:                 ":status",
+							HeaderMatchSpecifier: &envoyroutev3.HeaderMatcher_ExactMatch{ExactMatch: strconv.Itoa(int(mapping.GetFrom()))},
+						}},
+					},
+					ResponseTransformation: templateTransformation(statusTemplate(mapping)),
+				},
+			},
+		})
+	}
+
+	if responseTemplate := spec.GetTransformation(); responseTemplate != nil {
+		ret.Transformations = append(ret.Transformations, &transformapi.RouteTransformations_RouteTransformation{
+			Match: &transformapi.RouteTransformations_RouteTransformation_ResponseMatch_{
+				ResponseMatch: &transformapi.RouteTransformations_RouteTransformation_ResponseMatch{
+					Match:                  &transformapi.ResponseMatcher{},
+					ResponseTransformation: templateTransformation(responseTemplate),
+				},
+			},
+		})
+	}
+
+	return ret, nil
+}
+
+// Sets the status of the response, and its body if the mapping has a template for it.
+func statusTemplate(mapping *rest.ResponseSpec_StatusMapping) *transformapi.TransformationTemplate {
+	template := &transformapi.TransformationTemplate{
+		BodyTransformation: &transformapi.TransformationTemplate_Passthrough{Passthrough: &transformapi.Passthrough{}},
+	}
+	if body := mapping.GetBody(); body != nil {
+		template = proto.Clone(body).(*transformapi.TransformationTemplate)
+	}
+	if template.Headers == nil {
+		template.Headers = map[string]*transformapi.InjaTemplate{}
+	}
+	template.Headers[":status"] = &transformapi.InjaTemplate{Text: strconv.Itoa(int(mapping.GetTo()))}
+	return template
+}
+
+func templateTransformation(template *transformapi.TransformationTemplate) *transformapi.Transformation {
+	if template == nil {
+		return nil
+	}
+	return &transformapi.Transformation{
+		TransformationType: &transformapi.Transformation_TransformationTemplate{
+			TransformationTemplate: template,
+		},
+	}
+}
+
+func validStatus(status uint32) bool {
+	return status >= 100 && status <= 599
+}
@@ -14,6 +14,7 @@ import (
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	v1static "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/utils/healthcheck"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/solo-kit/pkg/errors"
 )
@@ -170,8 +171,37 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 		out.DnsLookupFamily = envoy_config_cluster_v3.Cluster_V4_ONLY
 	}
 
+	if healthcheck.ShouldSynthesize(spec.GetSynthesizedHealthCheck(), out.GetHealthChecks()) {
+		hc, err := synthesizeHealthCheck(spec)
+		if err != nil {
+			return err
+		}
+		out.HealthChecks = append(out.GetHealthChecks(), hc)
+	}
+
 	return nil
 }
+
+func synthesizeHealthCheck(spec *v1static.UpstreamSpec) (*envoy_config_core_v3.HealthCheck, error) {
+	cfg := spec.GetSynthesizedHealthCheck()
+	if healthcheck.UseGrpc(cfg, spec.GetServiceSpec()) {
+		return healthcheck.Grpc(cfg), nil
+	}
+
+	// envoy applies a cluster's health checks to all of its hosts, so the hosts must agree on the path
+	var path string
+	for _, host := range spec.GetHosts() {
+		hostPath := host.GetHealthCheckConfig().GetPath()
+		if hostPath == "" {
+			continue
+		}
+		if path != "" && path != hostPath {
+			return nil, errors.Errorf("cannot synthesize health check: hosts specify different health check paths %q and %q", path, hostPath)
+		}
+		path = hostPath
+	}
+	return healthcheck.Http(cfg, path), nil
+}
 func mutateSni(in *envoy_config_core_v3.TransportSocket, sni string) (*envoy_config_core_v3.TransportSocket, error) {
 	copy := *in
 
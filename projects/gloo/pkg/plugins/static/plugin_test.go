@@ -10,10 +10,14 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc"
 	v1static "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/utils/healthcheck"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	. "github.com/solo-io/solo-kit/test/matchers"
 )
 
 var _ = Describe("Plugin", func() {
@@ -155,6 +159,68 @@ var _ = Describe("Plugin", func() {
 			Expect(out.LoadAssignment.Endpoints[0].LbEndpoints[0].Metadata.FilterMetadata[AdvancedHttpCheckerName].Fields[PathFieldName].GetStringValue()).To(Equal("/foo"))
 		})
 
+		It("does not synthesize health checks by default", func() {
+			err := p.ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.HealthChecks).To(BeEmpty())
+		})
+
+		Context("synthesized health checks", func() {
+
+			BeforeEach(func() {
+				upstreamSpec.SynthesizedHealthCheck = &options.SynthesizedHealthCheck{Enabled: true}
+			})
+
+			It("synthesizes an http health check from the host path with defaults", func() {
+				upstreamSpec.Hosts[0].HealthCheckConfig = &v1static.Host_HealthCheckConfig{
+					Path: "/foo",
+				}
+				err := p.ProcessUpstream(params, upstream, out)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(out.HealthChecks).To(HaveLen(1))
+				hc := out.HealthChecks[0]
+				Expect(hc.GetHttpHealthCheck().GetPath()).To(Equal("/foo"))
+				Expect(hc.GetTimeout()).To(MatchProto(healthcheck.DefaultTimeout))
+				Expect(hc.GetInterval()).To(MatchProto(healthcheck.DefaultInterval))
+				Expect(hc.GetHealthyThreshold()).To(MatchProto(healthcheck.DefaultHealthyThreshold))
+				Expect(hc.GetUnhealthyThreshold()).To(MatchProto(healthcheck.DefaultUnhealthyThreshold))
+			})
+
+			It("uses the default path and configured thresholds", func() {
+				upstreamSpec.SynthesizedHealthCheck.UnhealthyThreshold = &wrappers.UInt32Value{Value: 7}
+				err := p.ProcessUpstream(params, upstream, out)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(out.HealthChecks[0].GetHttpHealthCheck().GetPath()).To(Equal(healthcheck.DefaultPath))
+				Expect(out.HealthChecks[0].GetUnhealthyThreshold().GetValue()).To(BeEquivalentTo(7))
+			})
+
+			It("synthesizes a grpc health check for grpc services", func() {
+				upstreamSpec.ServiceSpec = &options.ServiceSpec{
+					PluginType: &options.ServiceSpec_Grpc{Grpc: &grpc.ServiceSpec{}},
+				}
+				err := p.ProcessUpstream(params, upstream, out)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(out.HealthChecks[0].GetGrpcHealthCheck()).NotTo(BeNil())
+			})
+
+			It("errors when hosts disagree on the path", func() {
+				upstreamSpec.Hosts = []*v1static.Host{
+					{Addr: "1.2.3.4", Port: 80, HealthCheckConfig: &v1static.Host_HealthCheckConfig{Path: "/a"}},
+					{Addr: "1.2.3.5", Port: 80, HealthCheckConfig: &v1static.Host_HealthCheckConfig{Path: "/b"}},
+				}
+				err := p.ProcessUpstream(params, upstream, out)
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("does not override explicit health checks", func() {
+				existing := &envoy_config_core_v3.HealthCheck{}
+				out.HealthChecks = []*envoy_config_core_v3.HealthCheck{existing}
+				err := p.ProcessUpstream(params, upstream, out)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(out.HealthChecks).To(HaveLen(1))
+				Expect(out.HealthChecks[0]).To(BeIdenticalTo(existing))
+			})
+		})
 	})
 
 	Context("ssl", func() {
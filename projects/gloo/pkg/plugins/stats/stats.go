@@ -2,6 +2,8 @@ package stats
 
 import (
 	"context"
+	"fmt"
+	"regexp"
 	"sort"
 	"strings"
 
@@ -10,6 +12,7 @@ import (
 	"github.com/rotisserie/eris"
 	regexutils "github.com/solo-io/gloo/pkg/utils/regexutils"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/stats"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
@@ -19,13 +22,18 @@ var (
 	invalidVirtualClusterErr = func(err error, vcName string) error {
 		return eris.Wrapf(err, "failed to process virtual cluster [%s]", vcName)
 	}
-	missingNameErr    = eris.Errorf("name is required")
-	missingPatternErr = eris.Errorf("pattern is required")
-	invalidMethodErr  = func(methodName string) error {
+	missingNameErr       = eris.Errorf("name is required")
+	missingPatternErr    = eris.Errorf("pattern or matcher is required")
+	patternAndMatcherErr = eris.Errorf("only one of pattern and matcher can be specified")
+	methodWithMatcherErr = eris.Errorf("method cannot be used with matcher, use matcher.methods instead")
+	invalidMethodErr     = func(methodName string) error {
 		return eris.Errorf("invalid method name [%s]. Allowed values: %s", methodName, validMethodNames())
 	}
 )
 
+// matches the optional query string following a path
+const queryStringRegex = `(?:\?.*)?`
+
 type Plugin struct{}
 
 // Compile-time assertion
@@ -48,10 +56,14 @@ func (p *Plugin) ProcessVirtualHost(
 		return nil
 	}
 
-	vClusters, err := converter{ctx: params.Ctx}.convertVirtualClusters(params, in.GetOptions().GetStats())
+	c := converter{ctx: params.Ctx}
+	vClusters, err := c.convertVirtualClusters(params, in.GetOptions().GetStats())
 	if err != nil {
 		return err
 	}
+	if in.GetOptions().GetStats().GetGenerateRouteVirtualClusters() {
+		vClusters = append(vClusters, c.routeVirtualClusters(in.GetRoutes())...)
+	}
 	out.VirtualClusters = vClusters
 
 	return nil
@@ -73,6 +85,24 @@ func (c converter) convertVirtualClusters(
 			return nil, invalidVirtualClusterErr(err, virtualCluster.Name)
 		}
 
+		if virtualCluster.GetMatcher() != nil {
+			if virtualCluster.Pattern != "" {
+				return nil, invalidVirtualClusterErr(patternAndMatcherErr, virtualCluster.Name)
+			}
+			if virtualCluster.Method != "" {
+				return nil, invalidVirtualClusterErr(methodWithMatcherErr, virtualCluster.Name)
+			}
+			headerMatchers, err := c.matcherToHeaders(virtualCluster.GetMatcher())
+			if err != nil {
+				return nil, invalidVirtualClusterErr(err, virtualCluster.Name)
+			}
+			result = append(result, &envoy_config_route_v3.VirtualCluster{
+				Name:    name,
+				Headers: headerMatchers,
+			})
+			continue
+		}
+
 		if virtualCluster.Pattern == "" {
 			return nil, invalidVirtualClusterErr(missingPatternErr, virtualCluster.Name)
 		}
@@ -107,6 +137,117 @@ func (c converter) convertVirtualClusters(
 	return result, nil
 }
 
+// routeVirtualClusters generates a virtual cluster for each matcher of each named route.
+// Routes with invalid matchers are skipped, as they are reported by the translator.
+func (c converter) routeVirtualClusters(routes []*v1.Route) []*envoy_config_route_v3.VirtualCluster {
+	var result []*envoy_config_route_v3.VirtualCluster
+	for _, route := range routes {
+		if route.GetName() == "" {
+			continue
+		}
+		name := utils.SanitizeForEnvoy(c.ctx, route.GetName(), "virtual cluster")
+		for i, matcher := range route.GetMatchers() {
+			headerMatchers, err := c.matcherToHeaders(matcher)
+			if err != nil {
+				continue
+			}
+			vcName := name
+			if len(route.GetMatchers()) > 1 {
+				vcName = fmt.Sprintf("%s_%d", name, i)
+			}
+			result = append(result, &envoy_config_route_v3.VirtualCluster{
+				Name:    vcName,
+				Headers: headerMatchers,
+			})
+		}
+	}
+	return result
+}
+
+// matcherToHeaders converts a route matcher into the header matchers envoy uses to select requests
+// for a virtual cluster. As virtual clusters can only match on headers, the path and query parameters
+// are matched with regexes on the ":path" pseudo-header, which includes the query string.
+func (c converter) matcherToHeaders(matcher *matchers.Matcher) ([]*envoy_config_route_v3.HeaderMatcher, error) {
+	var pathRegex string
+	switch path := matcher.GetPathSpecifier().(type) {
+	case *matchers.Matcher_Prefix:
+		pathRegex = regexp.QuoteMeta(path.Prefix) + ".*"
+	case *matchers.Matcher_Exact:
+		pathRegex = regexp.QuoteMeta(path.Exact) + queryStringRegex
+	case *matchers.Matcher_Regex:
+		pathRegex = "(?:" + path.Regex + ")" + queryStringRegex
+	default:
+		pathRegex = "/.*"
+	}
+	if matcher.GetCaseSensitive() != nil && !matcher.GetCaseSensitive().GetValue() {
+		pathRegex = "(?i)" + pathRegex
+	}
+
+	headerMatchers := []*envoy_config_route_v3.HeaderMatcher{{
+		Name: ":path",
+		HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_SafeRegexMatch{
+			SafeRegexMatch: regexutils.NewRegex(c.ctx, pathRegex),
+		},
+	}}
+
+	for _, header := range matcher.GetHeaders() {
+		envoyMatch := &envoy_config_route_v3.HeaderMatcher{
+			Name:        header.GetName(),
+			InvertMatch: header.GetInvertMatch(),
+		}
+		switch {
+		case header.GetValue() == "":
+			envoyMatch.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_PresentMatch{
+				PresentMatch: true,
+			}
+		case header.GetRegex():
+			envoyMatch.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_SafeRegexMatch{
+				SafeRegexMatch: regexutils.NewRegex(c.ctx, header.GetValue()),
+			}
+		default:
+			envoyMatch.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_ExactMatch{
+				ExactMatch: header.GetValue(),
+			}
+		}
+		headerMatchers = append(headerMatchers, envoyMatch)
+	}
+
+	for _, queryParam := range matcher.GetQueryParameters() {
+		// with no value, the parameter only needs to be present
+		valueRegex := "(?:=[^&]*)?"
+		if queryParam.GetRegex() && queryParam.GetValue() != "" {
+			valueRegex = "=(?:" + queryParam.GetValue() + ")"
+		} else if queryParam.GetValue() != "" {
+			valueRegex = "=" + regexp.QuoteMeta(queryParam.GetValue())
+		}
+		headerMatchers = append(headerMatchers, &envoy_config_route_v3.HeaderMatcher{
+			Name: ":path",
+			HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_SafeRegexMatch{
+				SafeRegexMatch: regexutils.NewRegex(c.ctx, `[^?]*\?(?:.*&)?`+regexp.QuoteMeta(queryParam.GetName())+valueRegex+"(?:&.*)?"),
+			},
+		})
+	}
+
+	if len(matcher.GetMethods()) > 0 {
+		var methods []string
+		for _, methodName := range matcher.GetMethods() {
+			method, err := c.validateHttpMethod(methodName)
+			if err != nil {
+				return nil, err
+			}
+			methods = append(methods, method)
+		}
+		headerMatchers = append(headerMatchers, &envoy_config_route_v3.HeaderMatcher{
+			Name: ":method",
+			HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_SafeRegexMatch{
+				SafeRegexMatch: regexutils.NewRegex(c.ctx, strings.Join(methods, "|")),
+			},
+		})
+	}
+
+	return headerMatchers, nil
+}
+
 func (c converter) validateName(name string) (string, error) {
 	if name == "" {
 		return "", missingNameErr
@@ -3,11 +3,14 @@ package stats
 import (
 	"context"
 	"net/http"
+	"regexp"
 
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/golang/protobuf/ptypes/wrappers"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 	statsapi "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/stats"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 )
@@ -102,6 +105,87 @@ var _ = Describe("Virtual Clusters", func() {
 		Expect(getMethod(outputVh.VirtualClusters[0])).To(Equal(""))
 	})
 
+	Context("matchers", func() {
+
+		// envoy regexes must match the full header value
+		fullMatch := func(regex, value string) bool {
+			return regexp.MustCompile("^(?:" + regex + ")$").MatchString(value)
+		}
+
+		AfterEach(func() {
+			inputVh.Routes = nil
+			inputVh.Options.Stats.GenerateRouteVirtualClusters = false
+			outputVh.VirtualClusters = nil
+		})
+
+		It("converts a matcher into header matchers", func() {
+			inputVh.Options.Stats.VirtualClusters = []*statsapi.VirtualCluster{{
+				Name: "rides",
+				Matcher: &matchers.Matcher{
+					PathSpecifier:   &matchers.Matcher_Exact{Exact: "/rides"},
+					Headers:         []*matchers.HeaderMatcher{{Name: "x-tenant", Value: "a"}},
+					QueryParameters: []*matchers.QueryParameterMatcher{{Name: "page", Value: "[0-9]+", Regex: true}},
+					Methods:         []string{"get", "HEAD"},
+				},
+			}}
+			err := plugin.ProcessVirtualHost(pluginParams, &inputVh, &outputVh)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(outputVh.VirtualClusters).To(HaveLen(1))
+			headers := outputVh.VirtualClusters[0].GetHeaders()
+			Expect(headers).To(HaveLen(4))
+
+			pathRegex := headers[0].GetSafeRegexMatch().GetRegex()
+			Expect(fullMatch(pathRegex, "/rides")).To(BeTrue())
+			Expect(fullMatch(pathRegex, "/rides?page=1")).To(BeTrue())
+			Expect(fullMatch(pathRegex, "/rides/1")).To(BeFalse())
+
+			Expect(headers[1].GetName()).To(Equal("x-tenant"))
+			Expect(headers[1].GetExactMatch()).To(Equal("a"))
+
+			queryRegex := headers[2].GetSafeRegexMatch().GetRegex()
+			Expect(headers[2].GetName()).To(Equal(":path"))
+			Expect(fullMatch(queryRegex, "/rides?page=12")).To(BeTrue())
+			Expect(fullMatch(queryRegex, "/rides?sort=asc&page=12&x=y")).To(BeTrue())
+			Expect(fullMatch(queryRegex, "/rides?page=abc")).To(BeFalse())
+			Expect(fullMatch(queryRegex, "/rides")).To(BeFalse())
+
+			Expect(headers[3].GetName()).To(Equal(":method"))
+			Expect(fullMatch(headers[3].GetSafeRegexMatch().GetRegex(), http.MethodGet)).To(BeTrue())
+			Expect(fullMatch(headers[3].GetSafeRegexMatch().GetRegex(), http.MethodPost)).To(BeFalse())
+		})
+
+		It("generates virtual clusters for named routes", func() {
+			inputVh.Options.Stats.VirtualClusters = nil
+			inputVh.Options.Stats.GenerateRouteVirtualClusters = true
+			inputVh.Routes = []*v1.Route{
+				{
+					Name:     "vs:default.rides",
+					Matchers: []*matchers.Matcher{{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/rides"}}},
+				},
+				{
+					Name: "drivers",
+					Matchers: []*matchers.Matcher{
+						{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/drivers"}},
+						{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/DRIVERS"}, CaseSensitive: &wrappers.BoolValue{Value: false}},
+					},
+				},
+				{
+					Matchers: []*matchers.Matcher{{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/unnamed"}}},
+				},
+			}
+			err := plugin.ProcessVirtualHost(pluginParams, &inputVh, &outputVh)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(outputVh.VirtualClusters).To(HaveLen(3))
+			Expect(outputVh.VirtualClusters[0].Name).To(Equal("vs:default_rides"))
+			Expect(fullMatch(getPattern(outputVh.VirtualClusters[0]), "/rides/1?x=y")).To(BeTrue())
+			Expect(outputVh.VirtualClusters[1].Name).To(Equal("drivers_0"))
+			Expect(outputVh.VirtualClusters[2].Name).To(Equal("drivers_1"))
+			Expect(fullMatch(getPattern(outputVh.VirtualClusters[2]), "/drivers/1")).To(BeTrue())
+		})
+	})
+
 	Describe("expected failures", func() {
 
 		It("fails if a virtual cluster name is missing", func() {
@@ -111,6 +195,17 @@ var _ = Describe("Virtual Clusters", func() {
 			Expect(err.Error()).To(Equal(invalidVirtualClusterErr(missingNameErr, "").Error()))
 		})
 
+		It("fails if both a pattern and a matcher are specified", func() {
+			inputVh.Options.Stats.VirtualClusters = []*statsapi.VirtualCluster{{
+				Name:    "test-vc",
+				Pattern: "/test/.*",
+				Matcher: &matchers.Matcher{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/test"}},
+			}}
+			err := plugin.ProcessVirtualHost(pluginParams, &inputVh, &outputVh)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal(invalidVirtualClusterErr(patternAndMatcherErr, "test-vc").Error()))
+		})
+
 		It("fails if a virtual cluster pattern is missing", func() {
 			inputVh.Options.Stats.VirtualClusters = []*statsapi.VirtualCluster{{Name: "test-vc"}}
 			err := plugin.ProcessVirtualHost(pluginParams, &inputVh, &outputVh)
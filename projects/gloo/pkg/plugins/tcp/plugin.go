@@ -198,6 +198,17 @@ func (p *Plugin) computerTcpFilterChain(
 		}, nil
 	}
 
+	if isPassthrough(sslConfig) {
+		// match the SNI of the connection without terminating TLS
+		return &envoy_config_listener_v3.FilterChain{
+			FilterChainMatch: &envoy_config_listener_v3.FilterChainMatch{
+				ServerNames: sslConfig.GetSniDomains(),
+			},
+			Filters:       listenerFilters,
+			UseProxyProto: listener.GetUseProxyProto(),
+		}, nil
+	}
+
 	downstreamConfig, err := p.sslConfigTranslator.ResolveDownstreamSslConfig(snap.Secrets, sslConfig)
 	if err != nil {
 		return nil, InvalidSecretsError(err, listener.GetName())
@@ -209,6 +220,11 @@ func (p *Plugin) computerTcpFilterChain(
 	), nil
 }
 
+// An ssl config without a certificate that only specifies SNI domains passes TLS through
+func isPassthrough(sslConfig *v1.SslConfig) bool {
+	return sslConfig.GetSslSecrets() == nil && len(sslConfig.GetSniDomains()) > 0
+}
+
 func (p *Plugin) newSslFilterChain(
 	downstreamConfig *envoyauth.DownstreamTlsContext,
 	sniDomains []string,
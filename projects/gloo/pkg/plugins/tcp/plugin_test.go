@@ -276,6 +276,35 @@ var _ = Describe("Plugin", func() {
 			Expect(cluster.Cluster).To(Equal(""))
 		})
 
+		It("passes TLS through when the ssl config has no certificate", func() {
+			tcpListener.TcpHosts = append(tcpListener.TcpHosts, &v1.TcpHost{
+				Name: "one",
+				Destination: &v1.TcpHost_TcpAction{
+					Destination: &v1.TcpHost_TcpAction_Single{
+						Single: &v1.Destination{
+							DestinationType: &v1.Destination_Upstream{
+								Upstream: &core.ResourceRef{
+									Name:      "one",
+									Namespace: ns,
+								},
+							},
+						},
+					},
+				},
+				SslConfig: &v1.SslConfig{
+					SniDomains: []string{"hello.world"},
+				},
+			})
+
+			p := NewPlugin(sslTranslator)
+			filterChains, err := p.ProcessListenerFilterChain(plugins.Params{Snapshot: snap}, in)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filterChains).To(HaveLen(1))
+			Expect(filterChains[0].FilterChainMatch.ServerNames).To(Equal([]string{"hello.world"}))
+			Expect(filterChains[0].TransportSocket).To(BeNil())
+			Expect(filterChains[0].Filters).To(HaveLen(1))
+		})
+
 	})
 
 })
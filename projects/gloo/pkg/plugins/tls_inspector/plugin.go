@@ -25,6 +25,10 @@ func (p *plugin) Init(params plugins.InitParams) error {
 }
 
 func (p *plugin) ProcessListener(params plugins.Params, in *v1.Listener, out *envoy_config_listener_v3.Listener) error {
+	// tls inspector is a tcp listener filter and cannot be added to udp listeners
+	if in.GetUdpListener() != nil {
+		return nil
+	}
 	configEnvoy := &envoy_tls_inspector.TlsInspector{}
 	msg, err := utils.MessageToAny(configEnvoy)
 	if err != nil {
@@ -44,8 +48,15 @@ func (p *plugin) ProcessListener(params plugins.Params, in *v1.Listener, out *en
 		// check if ssl config is set on tcp host
 		switch in.GetListenerType().(type) {
 		case *v1.Listener_TcpListener:
-			for _, host := range in.GetTcpListener().GetTcpHosts() {
-				if host.GetSslConfig() != nil || host.GetDestination().GetForwardSniClusterName() != nil {
+			if tcpListenerUsesTls(in.GetTcpListener()) {
+				out.ListenerFilters = append(out.ListenerFilters, tlsInspector)
+			}
+		case *v1.Listener_HybridListener:
+			// the inspector also detects the application protocols negotiated via ALPN
+			for _, matchedListener := range in.GetHybridListener().GetMatchedListeners() {
+				matcher := matchedListener.GetMatcher()
+				if matcher.GetSslConfig() != nil || len(matcher.GetApplicationProtocols()) > 0 ||
+					tcpListenerUsesTls(matchedListener.GetTcpListener()) {
 					out.ListenerFilters = append(out.ListenerFilters, tlsInspector)
 					break
 				}
@@ -55,3 +66,12 @@ func (p *plugin) ProcessListener(params plugins.Params, in *v1.Listener, out *en
 
 	return nil
 }
+
+func tcpListenerUsesTls(tcpListener *v1.TcpListener) bool {
+	for _, host := range tcpListener.GetTcpHosts() {
+		if host.GetSslConfig() != nil || host.GetDestination().GetForwardSniClusterName() != nil {
+			return true
+		}
+	}
+	return false
+}
@@ -204,4 +204,88 @@ var _ = Describe("Plugin", func() {
 			Expect(out.ListenerFilters[0].GetTypedConfig()).To(Equal(config))
 		})
 	})
+
+	Context("tls inspector for udp", func() {
+
+		It("tls inspector is not added", func() {
+			in := &v1.Listener{
+				ListenerType: &v1.Listener_UdpListener{
+					UdpListener: &v1.UdpListener{},
+				},
+				SslConfigurations: []*v1.SslConfig{},
+			}
+
+			out := &envoy_config_listener_v3.Listener{}
+
+			p := NewPlugin()
+			err := p.ProcessListener(plugins.Params{}, in, out)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(out.ListenerFilters).To(HaveLen(0))
+		})
+	})
+
+	Context("tls inspector for hybrid", func() {
+
+		It("tls inspector is added when a matcher has an ssl config", func() {
+			in := &v1.Listener{
+				ListenerType: &v1.Listener_HybridListener{
+					HybridListener: &v1.HybridListener{
+						MatchedListeners: []*v1.MatchedListener{
+							{
+								Matcher: &v1.Matcher{},
+								ListenerType: &v1.MatchedListener_HttpListener{
+									HttpListener: &v1.HttpListener{},
+								},
+							},
+							{
+								Matcher: &v1.Matcher{
+									SslConfig: &v1.SslConfig{
+										SniDomains: []string{"passthrough.example.com"},
+									},
+								},
+								ListenerType: &v1.MatchedListener_TcpListener{
+									TcpListener: &v1.TcpListener{},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			out := &envoy_config_listener_v3.Listener{}
+
+			p := NewPlugin()
+			err := p.ProcessListener(plugins.Params{}, in, out)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(out.ListenerFilters).To(HaveLen(1))
+			Expect(out.ListenerFilters[0].GetName()).To(Equal(wellknown.TlsInspector))
+		})
+
+		It("tls inspector is not added when no matched listener uses tls", func() {
+			in := &v1.Listener{
+				ListenerType: &v1.Listener_HybridListener{
+					HybridListener: &v1.HybridListener{
+						MatchedListeners: []*v1.MatchedListener{
+							{
+								Matcher: &v1.Matcher{},
+								ListenerType: &v1.MatchedListener_HttpListener{
+									HttpListener: &v1.HttpListener{},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			out := &envoy_config_listener_v3.Listener{}
+
+			p := NewPlugin()
+			err := p.ProcessListener(plugins.Params{}, in, out)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(out.ListenerFilters).To(HaveLen(0))
+		})
+	})
 })
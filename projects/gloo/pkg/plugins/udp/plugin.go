@@ -0,0 +1,123 @@
+package udp
+
+import (
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoyudp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/udp/udp_proxy/v3"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/udp"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	translatorutil "github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	usconversion "github.com/solo-io/gloo/projects/gloo/pkg/upstreams"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+)
+
+const (
+	DefaultUdpStatPrefix = "udp"
+
+	UdpProxyFilter = "envoy.filters.udp_listener.udp_proxy"
+)
+
+func NewPlugin() *Plugin {
+	return &Plugin{}
+}
+
+var (
+	_ plugins.Plugin         = (*Plugin)(nil)
+	_ plugins.ListenerPlugin = (*Plugin)(nil)
+
+	NoDestinationError = func(listener string) error {
+		return eris.Errorf("no destination was specified for udp listener %v", listener)
+	}
+
+	SslConfigNotSupportedError = func(listener string) error {
+		return eris.Errorf("ssl configurations are not supported on udp listener %v", listener)
+	}
+
+	NoHashPolicySpecifiedError = eris.New("hash policy must specify either 'sourceIp' or 'key'")
+
+	TooManyHashPoliciesError = eris.New("only a single hash policy is supported")
+)
+
+type Plugin struct{}
+
+func (p *Plugin) Init(_ plugins.InitParams) error {
+	return nil
+}
+
+func (p *Plugin) ProcessListener(params plugins.Params, in *v1.Listener, out *envoy_config_listener_v3.Listener) error {
+	udpListener := in.GetUdpListener()
+	if udpListener == nil {
+		return nil
+	}
+	if len(in.GetSslConfigurations()) > 0 {
+		return SslConfigNotSupportedError(in.GetName())
+	}
+	if udpListener.GetDestination() == nil {
+		return NoDestinationError(in.GetName())
+	}
+	if err := translatorutil.ValidateUdpListenerDestination(params.Snapshot, udpListener.GetDestination()); err != nil {
+		return err
+	}
+	usRef, err := usconversion.DestinationToUpstreamRef(udpListener.GetDestination())
+	if err != nil {
+		return err
+	}
+
+	statPrefix := udpListener.GetStatPrefix()
+	if statPrefix == "" {
+		statPrefix = DefaultUdpStatPrefix
+	}
+	cfg := &envoyudp.UdpProxyConfig{
+		StatPrefix: statPrefix,
+		RouteSpecifier: &envoyudp.UdpProxyConfig_Cluster{
+			Cluster: translatorutil.UpstreamToClusterName(usRef),
+		},
+	}
+
+	if udpSettings := udpListener.GetOptions().GetUdpProxySettings(); udpSettings != nil {
+		cfg.IdleTimeout = udpSettings.GetIdleTimeout()
+		cfg.HashPolicies, err = convertHashPolicies(udpSettings.GetHashPolicies())
+		if err != nil {
+			return err
+		}
+	}
+
+	typedConfig, err := utils.MessageToAny(cfg)
+	if err != nil {
+		return err
+	}
+	out.ListenerFilters = append(out.ListenerFilters, &envoy_config_listener_v3.ListenerFilter{
+		Name: UdpProxyFilter,
+		ConfigType: &envoy_config_listener_v3.ListenerFilter_TypedConfig{
+			TypedConfig: typedConfig,
+		},
+	})
+	return nil
+}
+
+func convertHashPolicies(hashPolicies []*udp.UdpProxySettings_HashPolicy) ([]*envoyudp.UdpProxyConfig_HashPolicy, error) {
+	if len(hashPolicies) > 1 {
+		return nil, TooManyHashPoliciesError
+	}
+	var result []*envoyudp.UdpProxyConfig_HashPolicy
+	for _, hashPolicy := range hashPolicies {
+		switch policy := hashPolicy.GetPolicySpecifier().(type) {
+		case *udp.UdpProxySettings_HashPolicy_SourceIp:
+			result = append(result, &envoyudp.UdpProxyConfig_HashPolicy{
+				PolicySpecifier: &envoyudp.UdpProxyConfig_HashPolicy_SourceIp{
+					SourceIp: policy.SourceIp,
+				},
+			})
+		case *udp.UdpProxySettings_HashPolicy_Key:
+			result = append(result, &envoyudp.UdpProxyConfig_HashPolicy{
+				PolicySpecifier: &envoyudp.UdpProxyConfig_HashPolicy_Key{
+					Key: policy.Key,
+				},
+			})
+		default:
+			return nil, NoHashPolicySpecifiedError
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,177 @@
+package udp_test
+
+import (
+	"time"
+
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoyudp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/udp/udp_proxy/v3"
+	"github.com/golang/protobuf/ptypes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/udp"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/udp"
+	translatorutil "github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/utils/prototime"
+	"github.com/solo-io/solo-kit/test/matchers"
+)
+
+var _ = Describe("Plugin", func() {
+	var (
+		in          *v1.Listener
+		out         *envoy_config_listener_v3.Listener
+		udpListener *v1.UdpListener
+		snap        *v1.ApiSnapshot
+
+		ns = "one"
+	)
+
+	BeforeEach(func() {
+		snap = &v1.ApiSnapshot{
+			Upstreams: v1.UpstreamList{
+				{
+					Metadata: &core.Metadata{
+						Name:      "one",
+						Namespace: ns,
+					},
+				},
+			},
+		}
+		udpListener = &v1.UdpListener{
+			Destination: &v1.Destination{
+				DestinationType: &v1.Destination_Upstream{
+					Upstream: &core.ResourceRef{
+						Name:      "one",
+						Namespace: ns,
+					},
+				},
+			},
+		}
+		in = &v1.Listener{
+			Name: "dns",
+			ListenerType: &v1.Listener_UdpListener{
+				UdpListener: udpListener,
+			},
+		}
+		out = &envoy_config_listener_v3.Listener{}
+	})
+
+	getUdpProxyConfig := func() *envoyudp.UdpProxyConfig {
+		Expect(out.ListenerFilters).To(HaveLen(1))
+		Expect(out.ListenerFilters[0].GetName()).To(Equal(UdpProxyFilter))
+		var cfg envoyudp.UdpProxyConfig
+		err := ptypes.UnmarshalAny(out.ListenerFilters[0].GetTypedConfig(), &cfg)
+		Expect(err).NotTo(HaveOccurred())
+		return &cfg
+	}
+
+	It("ignores listeners that are not udp", func() {
+		in.ListenerType = &v1.Listener_TcpListener{
+			TcpListener: &v1.TcpListener{},
+		}
+		err := NewPlugin().ProcessListener(plugins.Params{Snapshot: snap}, in, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.ListenerFilters).To(BeEmpty())
+	})
+
+	It("adds a udp proxy filter for the destination", func() {
+		err := NewPlugin().ProcessListener(plugins.Params{Snapshot: snap}, in, out)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := getUdpProxyConfig()
+		Expect(cfg.GetStatPrefix()).To(Equal(DefaultUdpStatPrefix))
+		Expect(cfg.GetCluster()).To(Equal(translatorutil.UpstreamToClusterName(&core.ResourceRef{Namespace: ns, Name: "one"})))
+	})
+
+	It("can copy over udp proxy settings", func() {
+		udps := &udp.UdpProxySettings{
+			IdleTimeout: prototime.DurationToProto(5 * time.Second),
+			HashPolicies: []*udp.UdpProxySettings_HashPolicy{
+				{
+					PolicySpecifier: &udp.UdpProxySettings_HashPolicy_Key{
+						Key: "collector",
+					},
+				},
+			},
+		}
+		udpListener.StatPrefix = "dns"
+		udpListener.Options = &v1.UdpListenerOptions{
+			UdpProxySettings: udps,
+		}
+
+		err := NewPlugin().ProcessListener(plugins.Params{Snapshot: snap}, in, out)
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := getUdpProxyConfig()
+		Expect(cfg.GetStatPrefix()).To(Equal("dns"))
+		Expect(cfg.GetIdleTimeout()).To(matchers.MatchProto(udps.GetIdleTimeout()))
+		Expect(cfg.GetHashPolicies()).To(HaveLen(1))
+		Expect(cfg.GetHashPolicies()[0].GetKey()).To(Equal("collector"))
+	})
+
+	It("errors when there is more than one hash policy", func() {
+		udpListener.Options = &v1.UdpListenerOptions{
+			UdpProxySettings: &udp.UdpProxySettings{
+				HashPolicies: []*udp.UdpProxySettings_HashPolicy{
+					{
+						PolicySpecifier: &udp.UdpProxySettings_HashPolicy_SourceIp{
+							SourceIp: true,
+						},
+					},
+					{
+						PolicySpecifier: &udp.UdpProxySettings_HashPolicy_Key{
+							Key: "collector",
+						},
+					},
+				},
+			},
+		}
+
+		err := NewPlugin().ProcessListener(plugins.Params{Snapshot: snap}, in, out)
+		Expect(err).To(MatchError(TooManyHashPoliciesError))
+		Expect(out.ListenerFilters).To(BeEmpty())
+	})
+
+	It("errors when a hash policy is empty", func() {
+		udpListener.Options = &v1.UdpListenerOptions{
+			UdpProxySettings: &udp.UdpProxySettings{
+				HashPolicies: []*udp.UdpProxySettings_HashPolicy{{}},
+			},
+		}
+
+		err := NewPlugin().ProcessListener(plugins.Params{Snapshot: snap}, in, out)
+		Expect(err).To(MatchError(NoHashPolicySpecifiedError))
+		Expect(out.ListenerFilters).To(BeEmpty())
+	})
+
+	It("errors when the destination is missing", func() {
+		udpListener.Destination = nil
+
+		err := NewPlugin().ProcessListener(plugins.Params{Snapshot: snap}, in, out)
+		Expect(err).To(MatchError(NoDestinationError("dns").Error()))
+	})
+
+	It("errors when the upstream does not exist", func() {
+		udpListener.Destination = &v1.Destination{
+			DestinationType: &v1.Destination_Upstream{
+				Upstream: &core.ResourceRef{
+					Name:      "two",
+					Namespace: ns,
+				},
+			},
+		}
+
+		err := NewPlugin().ProcessListener(plugins.Params{Snapshot: snap}, in, out)
+		Expect(err).To(MatchError(pluginutils.NewUpstreamNotFoundErr(core.ResourceRef{Name: "two", Namespace: ns})))
+	})
+
+	It("errors when the listener has ssl configurations", func() {
+		in.SslConfigurations = []*v1.SslConfig{{}}
+
+		err := NewPlugin().ProcessListener(plugins.Params{Snapshot: snap}, in, out)
+		Expect(err).To(MatchError(SslConfigNotSupportedError("dns").Error()))
+	})
+})
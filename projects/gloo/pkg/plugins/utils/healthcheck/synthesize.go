@@ -0,0 +1,87 @@
+package healthcheck
+
+import (
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
+	"github.com/solo-io/solo-kit/pkg/utils/prototime"
+)
+
+const DefaultPath = "/"
+
+var (
+	DefaultTimeout            = prototime.DurationToProto(time.Second * 5)
+	DefaultInterval           = prototime.DurationToProto(time.Second * 10)
+	DefaultHealthyThreshold   = &wrappers.UInt32Value{Value: 2}
+	DefaultUnhealthyThreshold = &wrappers.UInt32Value{Value: 3}
+)
+
+// ShouldSynthesize returns true if a health check should be synthesized for a cluster,
+// i.e. synthesis is enabled and the user did not configure health checks explicitly.
+func ShouldSynthesize(cfg *options.SynthesizedHealthCheck, existing []*envoy_config_core_v3.HealthCheck) bool {
+	return cfg.GetEnabled() && len(existing) == 0
+}
+
+// UseGrpc returns whether the synthesized health check should use the gRPC health checking protocol.
+func UseGrpc(cfg *options.SynthesizedHealthCheck, serviceSpec *options.ServiceSpec) bool {
+	if cfg.GetGrpc() != nil {
+		return cfg.GetGrpc().GetValue()
+	}
+	return serviceSpec.GetGrpc() != nil
+}
+
+func Http(cfg *options.SynthesizedHealthCheck, path string) *envoy_config_core_v3.HealthCheck {
+	if path == "" {
+		path = DefaultPath
+	}
+	hc := withDefaults(cfg)
+	hc.HealthChecker = &envoy_config_core_v3.HealthCheck_HttpHealthCheck_{
+		HttpHealthCheck: &envoy_config_core_v3.HealthCheck_HttpHealthCheck{
+			Path: path,
+		},
+	}
+	return hc
+}
+
+func Grpc(cfg *options.SynthesizedHealthCheck) *envoy_config_core_v3.HealthCheck {
+	hc := withDefaults(cfg)
+	hc.HealthChecker = &envoy_config_core_v3.HealthCheck_GrpcHealthCheck_{
+		GrpcHealthCheck: &envoy_config_core_v3.HealthCheck_GrpcHealthCheck{},
+	}
+	return hc
+}
+
+// Tcp returns a health check that only verifies that a connection can be established.
+func Tcp(cfg *options.SynthesizedHealthCheck) *envoy_config_core_v3.HealthCheck {
+	hc := withDefaults(cfg)
+	hc.HealthChecker = &envoy_config_core_v3.HealthCheck_TcpHealthCheck_{
+		TcpHealthCheck: &envoy_config_core_v3.HealthCheck_TcpHealthCheck{},
+	}
+	return hc
+}
+
+func withDefaults(cfg *options.SynthesizedHealthCheck) *envoy_config_core_v3.HealthCheck {
+	return &envoy_config_core_v3.HealthCheck{
+		Timeout:            durationOrDefault(cfg.GetTimeout(), DefaultTimeout),
+		Interval:           durationOrDefault(cfg.GetInterval(), DefaultInterval),
+		HealthyThreshold:   thresholdOrDefault(cfg.GetHealthyThreshold(), DefaultHealthyThreshold),
+		UnhealthyThreshold: thresholdOrDefault(cfg.GetUnhealthyThreshold(), DefaultUnhealthyThreshold),
+	}
+}
+
+func durationOrDefault(d, def *duration.Duration) *duration.Duration {
+	if d == nil {
+		d = def
+	}
+	return &duration.Duration{Seconds: d.GetSeconds(), Nanos: d.GetNanos()}
+}
+
+func thresholdOrDefault(t, def *wrappers.UInt32Value) *wrappers.UInt32Value {
+	if t == nil {
+		t = def
+	}
+	return &wrappers.UInt32Value{Value: t.GetValue()}
+}
@@ -1,6 +1,8 @@
 package upgradeconfig
 
 import (
+	"strings"
+
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	"github.com/hashicorp/go-multierror"
@@ -9,17 +11,28 @@ import (
 
 const (
 	WebSocketUpgradeType = "websocket"
+	ConnectUpgradeType   = "CONNECT"
 )
 
+// upgrade types are case-insensitive in envoy
+func IsUpgradeType(upgradeType, expected string) bool {
+	return strings.EqualFold(upgradeType, expected)
+}
+
 func ValidateHCMUpgradeConfigs(upgradeConfigs []*envoyhttp.HttpConnectionManager_UpgradeConfig) error {
 	uniqConfigs := map[string]bool{}
 	var multiErr *multierror.Error
 
 	for _, config := range upgradeConfigs {
-		if _, ok := uniqConfigs[config.UpgradeType]; ok {
+		if config.UpgradeType == "" {
+			multiErr = multierror.Append(multiErr, errors.Errorf("upgrade config must specify an upgrade type"))
+			continue
+		}
+		upgradeType := strings.ToLower(config.UpgradeType)
+		if _, ok := uniqConfigs[upgradeType]; ok {
 			multiErr = multierror.Append(multiErr, errors.Errorf("upgrade config %s is not unique", config.UpgradeType))
 		}
-		uniqConfigs[config.UpgradeType] = true
+		uniqConfigs[upgradeType] = true
 	}
 	return multiErr.ErrorOrNil()
 }
@@ -29,10 +42,15 @@ func ValidateRouteUpgradeConfigs(upgradeConfigs []*envoy_config_route_v3.RouteAc
 	var multiErr *multierror.Error
 
 	for _, config := range upgradeConfigs {
-		if _, ok := uniqConfigs[config.UpgradeType]; ok {
+		if config.UpgradeType == "" {
+			multiErr = multierror.Append(multiErr, errors.Errorf("upgrade config must specify an upgrade type"))
+			continue
+		}
+		upgradeType := strings.ToLower(config.UpgradeType)
+		if _, ok := uniqConfigs[upgradeType]; ok {
 			multiErr = multierror.Append(multiErr, errors.Errorf("upgrade config %s is not unique", config.UpgradeType))
 		}
-		uniqConfigs[config.UpgradeType] = true
+		uniqConfigs[upgradeType] = true
 	}
 	return multiErr.ErrorOrNil()
 }
@@ -31,6 +31,33 @@ var _ = Describe("Upgradeconfig", func() {
 			err := ValidateHCMUpgradeConfigs(configs)
 			Expect(err).To(HaveOccurred())
 		})
+		It("should allow websocket, named and CONNECT upgrades together", func() {
+			configs := []*envoyhttp.HttpConnectionManager_UpgradeConfig{{
+				UpgradeType: WebSocketUpgradeType,
+			}, {
+				UpgradeType: "spdy/3.1",
+			}, {
+				UpgradeType: ConnectUpgradeType,
+			}}
+			err := ValidateHCMUpgradeConfigs(configs)
+			Expect(err).NotTo(HaveOccurred())
+		})
+		It("should not allow upgrades that only differ in case", func() {
+			configs := []*envoyhttp.HttpConnectionManager_UpgradeConfig{{
+				UpgradeType: WebSocketUpgradeType,
+			}, {
+				UpgradeType: "WebSocket",
+			}}
+			err := ValidateHCMUpgradeConfigs(configs)
+			Expect(err).To(HaveOccurred())
+		})
+		It("should not allow an empty upgrade type", func() {
+			configs := []*envoyhttp.HttpConnectionManager_UpgradeConfig{{
+				UpgradeType: "",
+			}}
+			err := ValidateHCMUpgradeConfigs(configs)
+			Expect(err).To(HaveOccurred())
+		})
 	})
 	Context("Route", func() {
 		It("should not error on empty list", func() {
@@ -53,6 +80,15 @@ var _ = Describe("Upgradeconfig", func() {
 			err := ValidateRouteUpgradeConfigs(configs)
 			Expect(err).To(HaveOccurred())
 		})
+		It("should not allow double CONNECT upgrade", func() {
+			configs := []*envoy_config_route_v3.RouteAction_UpgradeConfig{{
+				UpgradeType: ConnectUpgradeType,
+			}, {
+				UpgradeType: "connect",
+			}}
+			err := ValidateRouteUpgradeConfigs(configs)
+			Expect(err).To(HaveOccurred())
+		})
 	})
 
 })
@@ -792,6 +792,7 @@ func constructOpts(ctx context.Context, clientset *kubernetes.Interface, kubeCac
 		Artifacts:         artifactFactory,
 		AuthConfigs:       authConfigFactory,
 		RateLimitConfigs:  rateLimitConfigFactory,
+		KubeRestConfig:    cfg,
 		KubeCoreCache:     kubeCoreCache,
 	}, nil
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/solo-io/gloo/pkg/utils/api_conversion"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
 	"github.com/solo-io/go-utils/contextutils"
@@ -67,6 +68,10 @@ func (t *translatorInstance) computeCluster(
 		}
 
 		if err := upstreamPlugin.ProcessUpstream(params, upstream, out); err != nil {
+			if pluginutils.IsUpstreamWarning(err) {
+				reports.AddWarning(upstream, err.Error())
+				continue
+			}
 			reports.AddError(upstream, err)
 		}
 	}
@@ -51,7 +51,9 @@ func loadAssignmentForUpstream(
 	clusterEndpoints []*v1.Endpoint,
 ) *envoy_config_endpoint_v3.ClusterLoadAssignment {
 	clusterName := UpstreamToClusterName(upstream.Metadata.Ref())
-	var endpoints []*envoy_config_endpoint_v3.LbEndpoint
+	// endpoints are grouped by locality, in the order in which each locality first appears
+	var localityEndpoints []*envoy_config_endpoint_v3.LocalityLbEndpoints
+	localityIndexes := map[localityKey]int{}
 	for _, addr := range clusterEndpoints {
 		metadata := getLbMetadata(upstream, addr.Metadata.Labels, "")
 		metadata = addAnnotations(metadata, addr.Metadata.Annotations)
@@ -81,14 +83,46 @@ func loadAssignmentForUpstream(
 				},
 			},
 		}
-		endpoints = append(endpoints, &lbEndpoint)
+
+		locality := localityKey{
+			region:  addr.GetLocality().GetRegion(),
+			zone:    addr.GetLocality().GetZone(),
+			subZone: addr.GetLocality().GetSubZone(),
+		}
+		idx, ok := localityIndexes[locality]
+		if !ok {
+			idx = len(localityEndpoints)
+			localityIndexes[locality] = idx
+			localityEndpoints = append(localityEndpoints, &envoy_config_endpoint_v3.LocalityLbEndpoints{
+				Locality: envoyLocality(locality),
+			})
+		}
+		localityEndpoints[idx].LbEndpoints = append(localityEndpoints[idx].LbEndpoints, &lbEndpoint)
+	}
+
+	if len(localityEndpoints) == 0 {
+		// an upstream without endpoints still gets a single (empty) group of endpoints
+		localityEndpoints = []*envoy_config_endpoint_v3.LocalityLbEndpoints{{}}
 	}
 
 	return &envoy_config_endpoint_v3.ClusterLoadAssignment{
 		ClusterName: clusterName,
-		Endpoints: []*envoy_config_endpoint_v3.LocalityLbEndpoints{{
-			LbEndpoints: endpoints,
-		}},
+		Endpoints:   localityEndpoints,
+	}
+}
+
+type localityKey struct {
+	region, zone, subZone string
+}
+
+func envoyLocality(locality localityKey) *envoy_config_core_v3.Locality {
+	if locality == (localityKey{}) {
+		return nil
+	}
+	return &envoy_config_core_v3.Locality{
+		Region:  locality.region,
+		Zone:    locality.zone,
+		SubZone: locality.subZone,
 	}
 }
 
@@ -0,0 +1,154 @@
+package translator
+
+import (
+	"fmt"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/golang/protobuf/proto"
+	validationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+)
+
+// the matched listeners of a hybrid listener are translated as standalone http and tcp listeners.
+// their filter chains are restricted by the matcher of the matched listener and served by the envoy listener
+// of the hybrid listener.
+type matchedListener struct {
+	matcher  *v1.Matcher
+	listener *v1.Listener
+	report   *validationapi.ListenerReport
+}
+
+func (t *translatorInstance) computeHybridRouteConfigs(
+	params plugins.Params,
+	proxy *v1.Proxy,
+	listener *v1.Listener,
+	listenerReport *validationapi.ListenerReport,
+) []*envoy_config_route_v3.RouteConfiguration {
+	var routeConfigs []*envoy_config_route_v3.RouteConfiguration
+	for _, matched := range matchedListeners(listener, listenerReport) {
+		routeConfig := t.computeRouteConfig(params, proxy, matched.listener, routeConfigName(matched.listener), matched.report)
+		if routeConfig != nil {
+			routeConfigs = append(routeConfigs, routeConfig)
+		}
+		listenerReport.Errors = append(listenerReport.Errors, matched.report.GetErrors()...)
+	}
+	return routeConfigs
+}
+
+func (t *translatorInstance) computeHybridFilterChains(
+	params plugins.Params,
+	listener *v1.Listener,
+	listenerReport *validationapi.ListenerReport,
+) []*envoy_config_listener_v3.FilterChain {
+	var filterChains []*envoy_config_listener_v3.FilterChain
+	for _, matched := range matchedListeners(listener, listenerReport) {
+		var matchedFilterChains []*envoy_config_listener_v3.FilterChain
+		switch matched.listener.GetListenerType().(type) {
+		case *v1.Listener_HttpListener:
+			listenerFilters := t.computeListenerFilters(params, matched.listener, matched.report)
+			if len(listenerFilters) > 0 {
+				matchedFilterChains = t.computeFilterChainsFromSslConfig(params.Snapshot, matched.listener, listenerFilters, matched.report)
+			}
+		case *v1.Listener_TcpListener:
+			matchedFilterChains = t.computeTcpFilterChains(params, matched.listener, matched.report)
+		}
+
+		// the listener plugins modify the filter chains of the matched listener (e.g. to apply the settings of its
+		// http connection manager), so run them on a listener holding only those filter chains
+		t.runListenerPlugins(params, matched.listener, &envoy_config_listener_v3.Listener{
+			Name:         matched.listener.GetName(),
+			FilterChains: matchedFilterChains,
+		}, matched.report)
+
+		for _, filterChain := range matchedFilterChains {
+			applyMatcherToFilterChain(matched.matcher, filterChain)
+		}
+		filterChains = append(filterChains, matchedFilterChains...)
+		listenerReport.Errors = append(listenerReport.Errors, matched.report.GetErrors()...)
+	}
+	return filterChains
+}
+
+// Each matched listener is named after the hybrid listener and its index, so that route configs don't collide.
+// The reports returned share the http and tcp listener reports of the hybrid listener report,
+// errors on the listeners themselves must be copied to the hybrid listener report by the caller.
+func matchedListeners(listener *v1.Listener, listenerReport *validationapi.ListenerReport) []matchedListener {
+	matchedListenerReports := listenerReport.GetHybridListenerReport().GetMatchedListenerReports()
+
+	var result []matchedListener
+	for i, matched := range listener.GetHybridListener().GetMatchedListeners() {
+		out := &v1.Listener{
+			Name:          fmt.Sprintf("%s-%d", listener.GetName(), i),
+			BindAddress:   listener.GetBindAddress(),
+			BindPort:      listener.GetBindPort(),
+			UseProxyProto: listener.GetUseProxyProto(),
+			Options:       listener.GetOptions(),
+			Metadata:      listener.GetMetadata(),
+			RouteOptions:  listener.GetRouteOptions(),
+		}
+		report := &validationapi.ListenerReport{}
+		sslConfig := matched.GetMatcher().GetSslConfig()
+
+		switch listenerType := matched.GetListenerType().(type) {
+		case *v1.MatchedListener_HttpListener:
+			out.ListenerType = &v1.Listener_HttpListener{
+				HttpListener: listenerType.HttpListener,
+			}
+			if sslConfig != nil {
+				out.SslConfigurations = []*v1.SslConfig{sslConfig}
+			}
+			report.ListenerTypeReport = &validationapi.ListenerReport_HttpListenerReport{
+				HttpListenerReport: matchedListenerReports[i].GetHttpListenerReport(),
+			}
+		case *v1.MatchedListener_TcpListener:
+			tcpListener := listenerType.TcpListener
+			if sslConfig != nil {
+				// the ssl config of the matcher applies to the tcp hosts which don't specify their own
+				tcpListener = proto.Clone(tcpListener).(*v1.TcpListener)
+				for _, host := range tcpListener.GetTcpHosts() {
+					if host.GetSslConfig() == nil {
+						host.SslConfig = sslConfig
+					}
+				}
+			}
+			out.ListenerType = &v1.Listener_TcpListener{
+				TcpListener: tcpListener,
+			}
+			report.ListenerTypeReport = &validationapi.ListenerReport_TcpListenerReport{
+				TcpListenerReport: matchedListenerReports[i].GetTcpListenerReport(),
+			}
+		default:
+			continue
+		}
+
+		result = append(result, matchedListener{
+			matcher:  matched.GetMatcher(),
+			listener: out,
+			report:   report,
+		})
+	}
+	return result
+}
+
+func applyMatcherToFilterChain(matcher *v1.Matcher, filterChain *envoy_config_listener_v3.FilterChain) {
+	if len(matcher.GetSourcePrefixRanges()) == 0 &&
+		len(matcher.GetApplicationProtocols()) == 0 &&
+		matcher.GetDestinationPort() == nil {
+		return
+	}
+	if filterChain.GetFilterChainMatch() == nil {
+		filterChain.FilterChainMatch = &envoy_config_listener_v3.FilterChainMatch{}
+	}
+	for _, prefixRange := range matcher.GetSourcePrefixRanges() {
+		filterChain.FilterChainMatch.SourcePrefixRanges = append(filterChain.FilterChainMatch.SourcePrefixRanges,
+			&envoy_config_core_v3.CidrRange{
+				AddressPrefix: prefixRange.GetAddressPrefix(),
+				PrefixLen:     prefixRange.GetPrefixLen(),
+			})
+	}
+	filterChain.FilterChainMatch.ApplicationProtocols = matcher.GetApplicationProtocols()
+	filterChain.FilterChainMatch.DestinationPort = matcher.GetDestinationPort()
+}
@@ -29,6 +29,7 @@ func (t *translatorInstance) computeListener(
 
 	validateListenerPorts(proxy, listenerReport)
 	var filterChains []*envoy_config_listener_v3.FilterChain
+	protocol := envoy_config_core_v3.SocketAddress_TCP
 	switch listener.GetListenerType().(type) {
 	case *v1.Listener_HttpListener:
 		// run the http filter chain plugins and listener plugins
@@ -38,21 +39,12 @@ func (t *translatorInstance) computeListener(
 		}
 		filterChains = t.computeFilterChainsFromSslConfig(params.Snapshot, listener, listenerFilters, listenerReport)
 	case *v1.Listener_TcpListener:
-		// run the tcp filter chain plugins
-		for _, plug := range t.plugins {
-			listenerPlugin, ok := plug.(plugins.ListenerFilterChainPlugin)
-			if !ok {
-				continue
-			}
-			result, err := listenerPlugin.ProcessListenerFilterChain(params, listener)
-			if err != nil {
-				validation.AppendListenerError(listenerReport,
-					validationapi.ListenerReport_Error_ProcessingError,
-					err.Error())
-				continue
-			}
-			filterChains = append(filterChains, result...)
-		}
+		filterChains = t.computeTcpFilterChains(params, listener, listenerReport)
+	case *v1.Listener_UdpListener:
+		// udp listeners have no filter chains, the udp proxy listener filter is added by the listener plugins
+		protocol = envoy_config_core_v3.SocketAddress_UDP
+	case *v1.Listener_HybridListener:
+		filterChains = t.computeHybridFilterChains(params, listener, listenerReport)
 	}
 
 	CheckForDuplicateFilterChainMatches(filterChains, listenerReport)
@@ -62,7 +54,7 @@ func (t *translatorInstance) computeListener(
 		Address: &envoy_config_core_v3.Address{
 			Address: &envoy_config_core_v3.Address_SocketAddress{
 				SocketAddress: &envoy_config_core_v3.SocketAddress{
-					Protocol: envoy_config_core_v3.SocketAddress_TCP,
+					Protocol: protocol,
 					Address:  listener.BindAddress,
 					PortSpecifier: &envoy_config_core_v3.SocketAddress_PortValue{
 						PortValue: listener.BindPort,
@@ -73,7 +65,22 @@ func (t *translatorInstance) computeListener(
 		},
 		FilterChains: filterChains,
 	}
+	if protocol == envoy_config_core_v3.SocketAddress_UDP {
+		// datagrams of the same session must be handled by the same worker
+		out.ReusePort = true
+	}
+
+	t.runListenerPlugins(params, listener, out, listenerReport)
 
+	return out
+}
+
+func (t *translatorInstance) runListenerPlugins(
+	params plugins.Params,
+	listener *v1.Listener,
+	out *envoy_config_listener_v3.Listener,
+	listenerReport *validationapi.ListenerReport,
+) {
 	// run the Listener Plugins
 	for _, plug := range t.plugins {
 		listenerPlugin, ok := plug.(plugins.ListenerPlugin)
@@ -86,8 +93,30 @@ func (t *translatorInstance) computeListener(
 				err.Error())
 		}
 	}
+}
 
-	return out
+func (t *translatorInstance) computeTcpFilterChains(
+	params plugins.Params,
+	listener *v1.Listener,
+	listenerReport *validationapi.ListenerReport,
+) []*envoy_config_listener_v3.FilterChain {
+	var filterChains []*envoy_config_listener_v3.FilterChain
+	// run the tcp filter chain plugins
+	for _, plug := range t.plugins {
+		listenerPlugin, ok := plug.(plugins.ListenerFilterChainPlugin)
+		if !ok {
+			continue
+		}
+		result, err := listenerPlugin.ProcessListenerFilterChain(params, listener)
+		if err != nil {
+			validation.AppendListenerError(listenerReport,
+				validationapi.ListenerReport_Error_ProcessingError,
+				err.Error())
+			continue
+		}
+		filterChains = append(filterChains, result...)
+	}
+	return filterChains
 }
 
 func (t *translatorInstance) computeListenerFilters(params plugins.Params, listener *v1.Listener, listenerReport *validationapi.ListenerReport) []*envoy_config_listener_v3.Filter {
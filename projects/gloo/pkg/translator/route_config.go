@@ -687,6 +687,10 @@ func ValidateTcpRouteDestinations(snap *v1.ApiSnapshot, action *v1.TcpHost_TcpAc
 	return errors.Errorf("must specify either 'singleDestination', 'multipleDestinations', 'upstreamGroup' or 'forwardSniClusterName' for action")
 }
 
+func ValidateUdpListenerDestination(snap *v1.ApiSnapshot, destination *v1.Destination) error {
+	return validateSingleDestination(snap.Upstreams, destination)
+}
+
 func validateUpstreamGroup(snap *v1.ApiSnapshot, ref *core.ResourceRef) error {
 
 	upstreamGroup, err := snap.UpstreamGroups.Find(ref.Namespace, ref.Name)
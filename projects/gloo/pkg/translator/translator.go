@@ -179,9 +179,7 @@ ClusterLoop:
 		envoyResources := t.computeListenerResources(params, proxy, listener, listenerReport)
 		if envoyResources != nil {
 			listeners = append(listeners, envoyResources.listener)
-			if envoyResources.routeConfig != nil {
-				routeConfigs = append(routeConfigs, envoyResources.routeConfig)
-			}
+			routeConfigs = append(routeConfigs, envoyResources.routeConfigs...)
 		}
 	}
 
@@ -220,8 +218,8 @@ ClusterLoop:
 // the set of resources returned by one iteration for a single v1.Listener
 // the top level Translate function should aggregate these into a finished snapshot
 type listenerResources struct {
-	routeConfig *envoy_config_route_v3.RouteConfiguration
-	listener    *envoy_config_listener_v3.Listener
+	routeConfigs []*envoy_config_route_v3.RouteConfiguration
+	listener     *envoy_config_listener_v3.Listener
 }
 
 func (t *translatorInstance) computeListenerResources(
@@ -237,7 +235,12 @@ func (t *translatorInstance) computeListenerResources(
 	rdsName := routeConfigName(listener)
 
 	// Calculate routes before listeners, so that HttpFilters is called after ProcessVirtualHost\ProcessRoute
-	routeConfig := t.computeRouteConfig(params, proxy, listener, rdsName, listenerReport)
+	var routeConfigs []*envoy_config_route_v3.RouteConfiguration
+	if listener.GetHybridListener() != nil {
+		routeConfigs = t.computeHybridRouteConfigs(params, proxy, listener, listenerReport)
+	} else if routeConfig := t.computeRouteConfig(params, proxy, listener, rdsName, listenerReport); routeConfig != nil {
+		routeConfigs = append(routeConfigs, routeConfig)
+	}
 
 	envoyListener := t.computeListener(params, proxy, listener, listenerReport)
 	if envoyListener == nil {
@@ -245,8 +248,8 @@ func (t *translatorInstance) computeListenerResources(
 	}
 
 	return &listenerResources{
-		listener:    envoyListener,
-		routeConfig: routeConfig,
+		listener:     envoyListener,
+		routeConfigs: routeConfigs,
 	}
 }
 
@@ -13,11 +13,13 @@ import (
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	envoytcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
+	envoyudp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/udp/udp_proxy/v3"
 	envoyauth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/golang/mock/gomock"
 	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/duration"
 	structpb "github.com/golang/protobuf/ptypes/struct"
 	"github.com/golang/protobuf/ptypes/wrappers"
@@ -27,6 +29,7 @@ import (
 	"github.com/solo-io/gloo/pkg/utils/settingsutil"
 	"github.com/solo-io/gloo/projects/gloo/constants"
 	gloo_envoy_core "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/api/v2/core"
+	gloo_envoy_core_v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
@@ -1273,6 +1276,37 @@ var _ = Describe("Translator", func() {
 			Expect(filterMetadata[SoloAnnotations].Fields).To(HaveKey("testkey"))
 			Expect(filterMetadata[SoloAnnotations].Fields["testkey"].GetStringValue()).To(Equal("testvalue"))
 		})
+
+		It("should group endpoints by locality", func() {
+			ref := upstream.Metadata.Ref()
+			params.Snapshot.Endpoints[0].Locality = &v1.Locality{Zone: "zone-a"}
+			params.Snapshot.Endpoints = append(params.Snapshot.Endpoints,
+				&v1.Endpoint{
+					Metadata:  &core.Metadata{Name: "test-2", Namespace: "gloo-system"},
+					Upstreams: []*core.ResourceRef{ref},
+					Address:   "1.2.3.5",
+					Port:      1234,
+					Locality:  &v1.Locality{Zone: "zone-b"},
+				},
+				&v1.Endpoint{
+					Metadata:  &core.Metadata{Name: "test-3", Namespace: "gloo-system"},
+					Upstreams: []*core.ResourceRef{ref},
+					Address:   "1.2.3.6",
+					Port:      1234,
+					Locality:  &v1.Locality{Zone: "zone-a"},
+				},
+			)
+			translate()
+
+			clusterName := getEndpointClusterName(upstream)
+			endpointsResource := snapshot.GetResources(resource.EndpointTypeV3).Items[clusterName]
+			claConfiguration = endpointsResource.ResourceProto().(*envoy_config_endpoint_v3.ClusterLoadAssignment)
+			Expect(claConfiguration.Endpoints).To(HaveLen(2))
+			Expect(claConfiguration.Endpoints[0].Locality).To(MatchProto(&envoy_config_core_v3.Locality{Zone: "zone-a"}))
+			Expect(claConfiguration.Endpoints[0].LbEndpoints).To(HaveLen(2))
+			Expect(claConfiguration.Endpoints[1].Locality).To(MatchProto(&envoy_config_core_v3.Locality{Zone: "zone-b"}))
+			Expect(claConfiguration.Endpoints[1].LbEndpoints).To(HaveLen(1))
+		})
 	})
 
 	Context("when handling subsets", func() {
@@ -2077,6 +2111,171 @@ var _ = Describe("Translator", func() {
 		})
 	})
 
+	Context("UDP", func() {
+		var udpListener *v1.Listener
+
+		BeforeEach(func() {
+			udpListener = &v1.Listener{
+				Name:        "udp-listener",
+				BindAddress: "127.0.0.1",
+				BindPort:    5353,
+				ListenerType: &v1.Listener_UdpListener{
+					UdpListener: &v1.UdpListener{
+						Destination: &v1.Destination{
+							DestinationType: &v1.Destination_Upstream{
+								Upstream: upName.Ref(),
+							},
+						},
+					},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			proxy.Listeners = append(proxy.Listeners, udpListener)
+		})
+
+		It("can properly create a udp listener", func() {
+			translate()
+			listeners := snapshot.GetResources(resource.ListenerTypeV3).Items
+			val, found := listeners["udp-listener"]
+			Expect(found).To(BeTrue())
+			listener, ok := val.ResourceProto().(*envoy_config_listener_v3.Listener)
+			Expect(ok).To(BeTrue())
+			Expect(listener.GetAddress().GetSocketAddress().GetProtocol()).To(Equal(envoy_config_core_v3.SocketAddress_UDP))
+			Expect(listener.GetReusePort()).To(BeTrue())
+			Expect(listener.GetFilterChains()).To(BeEmpty())
+			Expect(listener.GetListenerFilters()).To(HaveLen(1))
+			udpFilter := listener.GetListenerFilters()[0]
+			Expect(udpFilter.GetName()).To(Equal("envoy.filters.udp_listener.udp_proxy"))
+			var typedCfg envoyudp.UdpProxyConfig
+			Expect(ptypes.UnmarshalAny(udpFilter.GetTypedConfig(), &typedCfg)).NotTo(HaveOccurred())
+			Expect(typedCfg.GetCluster()).To(Equal("test_gloo-system"))
+		})
+
+		It("reports a conflict when the udp listener binds to the port of a tcp listener", func() {
+			udpListener.BindPort = 8080
+
+			report := translateWithError()
+			errs := validationutils.GetProxyError(report)
+			Expect(errs).To(HaveOccurred())
+			Expect(errs.Error()).To(ContainSubstring("port 8080 is shared by listeners"))
+		})
+	})
+
+	Context("Hybrid", func() {
+		var (
+			hybridListener   *v1.Listener
+			passthroughMatch *v1.MatchedListener
+		)
+
+		BeforeEach(func() {
+			passthroughMatch = &v1.MatchedListener{
+				Matcher: &v1.Matcher{
+					SslConfig: &v1.SslConfig{
+						SniDomains: []string{"passthrough.example.com"},
+					},
+				},
+				ListenerType: &v1.MatchedListener_TcpListener{
+					TcpListener: &v1.TcpListener{
+						TcpHosts: []*v1.TcpHost{{
+							Name: "passthrough",
+							Destination: &v1.TcpHost_TcpAction{
+								Destination: &v1.TcpHost_TcpAction_Single{
+									Single: &v1.Destination{
+										DestinationType: &v1.Destination_Upstream{
+											Upstream: upName.Ref(),
+										},
+									},
+								},
+							},
+						}},
+					},
+				},
+			}
+			hybridListener = &v1.Listener{
+				Name:        "hybrid-listener",
+				BindAddress: "127.0.0.1",
+				BindPort:    8443,
+				ListenerType: &v1.Listener_HybridListener{
+					HybridListener: &v1.HybridListener{
+						MatchedListeners: []*v1.MatchedListener{
+							{
+								Matcher: &v1.Matcher{
+									SslConfig: &v1.SslConfig{
+										SslSecrets: &v1.SslConfig_SslFiles{
+											SslFiles: &v1.SSLFiles{
+												TlsCert: "cert1",
+												TlsKey:  "key1",
+											},
+										},
+										SniDomains: []string{"secure.example.com"},
+									},
+									SourcePrefixRanges: []*gloo_envoy_core_v3.CidrRange{{
+										AddressPrefix: "10.0.0.0",
+										PrefixLen:     &wrappers.UInt32Value{Value: 8},
+									}},
+								},
+								ListenerType: &v1.MatchedListener_HttpListener{
+									HttpListener: &v1.HttpListener{
+										VirtualHosts: []*v1.VirtualHost{{
+											Name:    "secure",
+											Domains: []string{"*"},
+											Routes:  routes,
+										}},
+									},
+								},
+							},
+							passthroughMatch,
+						},
+					},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			proxy.Listeners = append(proxy.Listeners, hybridListener)
+		})
+
+		It("can properly create a hybrid listener", func() {
+			translate()
+			listeners := snapshot.GetResources(resource.ListenerTypeV3).Items
+			val, found := listeners["hybrid-listener"]
+			Expect(found).To(BeTrue())
+			listener, ok := val.ResourceProto().(*envoy_config_listener_v3.Listener)
+			Expect(ok).To(BeTrue())
+			Expect(listener.GetListenerFilters()).To(HaveLen(1))
+			Expect(listener.GetListenerFilters()[0].GetName()).To(Equal(wellknown.TlsInspector))
+			Expect(listener.GetFilterChains()).To(HaveLen(2))
+
+			httpChain := listener.GetFilterChains()[0]
+			Expect(httpChain.GetFilterChainMatch().GetServerNames()).To(Equal([]string{"secure.example.com"}))
+			Expect(httpChain.GetFilterChainMatch().GetSourcePrefixRanges()).To(HaveLen(1))
+			Expect(httpChain.GetFilterChainMatch().GetSourcePrefixRanges()[0].GetAddressPrefix()).To(Equal("10.0.0.0"))
+			Expect(httpChain.GetFilterChainMatch().GetSourcePrefixRanges()[0].GetPrefixLen().GetValue()).To(Equal(uint32(8)))
+			Expect(httpChain.GetTransportSocket()).NotTo(BeNil())
+			var hcm envoyhttp.HttpConnectionManager
+			Expect(ParseTypedConfig(httpChain.GetFilters()[0], &hcm)).NotTo(HaveOccurred())
+			Expect(hcm.GetRds().GetRouteConfigName()).To(Equal("hybrid-listener-0-routes"))
+			Expect(snapshot.GetResources(resource.RouteTypeV3).Items).To(HaveKey("hybrid-listener-0-routes"))
+
+			tcpChain := listener.GetFilterChains()[1]
+			Expect(tcpChain.GetFilterChainMatch().GetServerNames()).To(Equal([]string{"passthrough.example.com"}))
+			Expect(tcpChain.GetFilterChainMatch().GetSourcePrefixRanges()).To(BeEmpty())
+			Expect(tcpChain.GetTransportSocket()).To(BeNil())
+			Expect(tcpChain.GetFilters()[0].GetName()).To(Equal(wellknown.TCPProxy))
+		})
+
+		It("reports matched listeners with the same filter chain match", func() {
+			hybridListener.GetHybridListener().MatchedListeners = append(hybridListener.GetHybridListener().MatchedListeners, passthroughMatch)
+
+			report := translateWithError()
+			errs := validationutils.GetProxyError(report)
+			Expect(errs).To(HaveOccurred())
+			Expect(errs.Error()).To(ContainSubstring("Tried to apply multiple filter chains with the same FilterChainMatch"))
+		})
+	})
+
 	Context("Ssl - cluster", func() {
 
 		var (
@@ -0,0 +1,148 @@
+package consul
+
+import (
+	"context"
+	"time"
+
+	consulapi "github.com/hashicorp/consul/api"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+)
+
+const (
+	// The delay before retrying the first failed query of a watch
+	DefaultWatchInitialBackoff = 100 * time.Millisecond
+	// The maximum delay between retries of failed queries
+	DefaultWatchMaxBackoff = 30 * time.Second
+	// The number of consecutive failed queries after which errors are reported.
+	// Transient errors (e.g. a leader election) are expected and are just retried.
+	DefaultWatchErrorThreshold = 6
+)
+
+var (
+	watchNameKey, _ = tag.NewKey("watch")
+
+	mWatchDeliveryTime = stats.Float64("gloo.solo.io/consul/watch_delivery_time",
+		"The time between a blocking query observing a new index and the change having been delivered by the watch", stats.UnitMilliseconds)
+	mWatchErrors = stats.Int64("gloo.solo.io/consul/watch_errors", "The number of failed Consul blocking queries", stats.UnitDimensionless)
+
+	watchDeliveryTimeView = &view.View{
+		Name:        mWatchDeliveryTime.Name(),
+		Measure:     mWatchDeliveryTime,
+		Description: mWatchDeliveryTime.Description(),
+		Aggregation: view.Distribution(1, 5, 10, 50, 100, 500, 1000, 5000, 10000, 30000),
+		TagKeys:     []tag.Key{watchNameKey},
+	}
+	watchErrorsView = &view.View{
+		Name:        mWatchErrors.Name(),
+		Measure:     mWatchErrors,
+		Description: mWatchErrors.Description(),
+		Aggregation: view.Sum(),
+		TagKeys:     []tag.Key{watchNameKey},
+	}
+)
+
+func init() {
+	_ = view.Register(watchDeliveryTimeView, watchErrorsView)
+}
+
+// Executes a blocking query (see [here](https://www.consul.io/api/features/blocking.html) for more info)
+// with the given options and returns the resulting query metadata.
+type BlockingQueryFunc func(q *consulapi.QueryOptions) (*consulapi.QueryMeta, error)
+
+type BlockingWatchOpts struct {
+	// Identifies the watch in metrics
+	Name string
+	// Datacenter to run the queries against
+	Datacenter string
+	// The delay before retrying the first failed query. Defaults to DefaultWatchInitialBackoff.
+	InitialBackoff time.Duration
+	// The maximum delay between retries of failed queries. Defaults to DefaultWatchMaxBackoff.
+	MaxBackoff time.Duration
+	// The number of consecutive failed queries after which errors are reported. Defaults to DefaultWatchErrorThreshold.
+	ErrorThreshold int
+}
+
+// Runs the given blocking query in a loop until the context is cancelled, passing the index of the last result
+// to each query so that it only returns once the result changes (or the wait time elapses).
+// onChange is invoked after every query that returned a new index, including the first one, and is expected to
+// deliver the result of the query. onError is invoked for failed queries once ErrorThreshold consecutive queries
+// have failed. Failed queries are retried with an exponential backoff, so an unavailable agent is not flooded
+// with requests.
+func RunBlockingWatch(ctx context.Context, opts BlockingWatchOpts, query BlockingQueryFunc, onChange func(), onError func(error)) {
+	initialBackoff := opts.InitialBackoff
+	if initialBackoff <= 0 {
+		initialBackoff = DefaultWatchInitialBackoff
+	}
+	maxBackoff := opts.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = DefaultWatchMaxBackoff
+	}
+	errorThreshold := opts.ErrorThreshold
+	if errorThreshold <= 0 {
+		errorThreshold = DefaultWatchErrorThreshold
+	}
+	metricsCtx, err := tag.New(ctx, tag.Upsert(watchNameKey, opts.Name))
+	if err != nil {
+		metricsCtx = ctx
+	}
+
+	var (
+		lastIndex uint64
+		failures  int
+		backoff   = initialBackoff
+	)
+	for ctx.Err() == nil {
+		queryMeta, err := query((&consulapi.QueryOptions{
+			Datacenter:        opts.Datacenter,
+			RequireConsistent: true,
+			WaitIndex:         lastIndex,
+		}).WithContext(ctx))
+		observed := time.Now()
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err != nil {
+			stats.Record(metricsCtx, mWatchErrors.M(1))
+			failures++
+			if failures >= errorThreshold {
+				onError(err)
+			}
+
+			select {
+			case <-time.After(backoff):
+			case <-ctx.Done():
+				return
+			}
+			backoff *= 2
+			if backoff > maxBackoff {
+				backoff = maxBackoff
+			}
+			continue
+		}
+		failures = 0
+		backoff = initialBackoff
+
+		// If the index is the same, there have been no changes since the last query
+		newIndex := queryMeta.LastIndex
+		if newIndex == lastIndex && lastIndex != 0 {
+			continue
+		}
+
+		// onChange blocks until the change has been delivered
+		onChange()
+		stats.Record(metricsCtx, mWatchDeliveryTime.M(float64(time.Since(observed))/float64(time.Millisecond)))
+
+		// Indexes are not guaranteed to increase monotonically (e.g. after a snapshot restore).
+		// Start over if the index went backwards, and never block on an index of zero, which returns immediately.
+		if newIndex < lastIndex {
+			newIndex = 0
+		}
+		if newIndex == 0 {
+			newIndex = 1
+		}
+		lastIndex = newIndex
+	}
+}
@@ -0,0 +1,148 @@
+package consul_test
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	consulapi "github.com/hashicorp/consul/api"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rotisserie/eris"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
+	"go.opencensus.io/stats/view"
+)
+
+var _ = Describe("RunBlockingWatch", func() {
+
+	type queryResult struct {
+		index uint64
+		err   error
+	}
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+
+		lock        sync.Mutex
+		waitIndexes []uint64
+		results     []queryResult
+		changes     chan uint64
+		errs        chan error
+	)
+
+	// Returns the given results in order, then blocks until the watch is cancelled
+	query := func(q *consulapi.QueryOptions) (*consulapi.QueryMeta, error) {
+		lock.Lock()
+		waitIndexes = append(waitIndexes, q.WaitIndex)
+		if len(results) == 0 {
+			lock.Unlock()
+			<-q.Context().Done()
+			return nil, q.Context().Err()
+		}
+		result := results[0]
+		results = results[1:]
+		lock.Unlock()
+		if result.err != nil {
+			return nil, result.err
+		}
+		return &consulapi.QueryMeta{LastIndex: result.index}, nil
+	}
+
+	run := func(opts BlockingWatchOpts) {
+		var lastIndex uint64
+		go RunBlockingWatch(ctx, opts, func(q *consulapi.QueryOptions) (*consulapi.QueryMeta, error) {
+			meta, err := query(q)
+			if err == nil {
+				lastIndex = meta.LastIndex
+			}
+			return meta, err
+		}, func() {
+			changes <- lastIndex
+		}, func(err error) {
+			errs <- err
+		})
+	}
+
+	queriedIndexes := func() []uint64 {
+		lock.Lock()
+		defer lock.Unlock()
+		return append([]uint64{}, waitIndexes...)
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		waitIndexes = nil
+		changes = make(chan uint64, 10)
+		errs = make(chan error, 10)
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("waits on the index of the last result and only reports changes", func() {
+		results = []queryResult{{index: 10}, {index: 10}, {index: 12}}
+		run(BlockingWatchOpts{Name: "test", Datacenter: "dc1"})
+
+		Eventually(changes).Should(Receive(Equal(uint64(10))))
+		Eventually(changes).Should(Receive(Equal(uint64(12))))
+		Consistently(changes).ShouldNot(Receive())
+		Expect(queriedIndexes()).To(Equal([]uint64{0, 10, 10, 12}))
+	})
+
+	It("starts over if the index goes backwards", func() {
+		results = []queryResult{{index: 10}, {index: 3}, {index: 0}}
+		run(BlockingWatchOpts{Name: "test"})
+
+		Eventually(changes).Should(Receive(Equal(uint64(10))))
+		Eventually(changes).Should(Receive(Equal(uint64(3))))
+		Eventually(changes).Should(Receive(Equal(uint64(0))))
+		// never block on index zero, as that returns immediately
+		Eventually(queriedIndexes).Should(Equal([]uint64{0, 10, 1, 1}))
+	})
+
+	It("backs off and only reports errors after the threshold is reached", func() {
+		flake := eris.New("flake")
+		results = []queryResult{{index: 10}, {err: flake}, {err: flake}, {err: flake}, {index: 11}}
+		start := time.Now()
+		run(BlockingWatchOpts{Name: "test", InitialBackoff: 20 * time.Millisecond, ErrorThreshold: 3})
+
+		Eventually(changes).Should(Receive(Equal(uint64(10))))
+		Eventually(errs).Should(Receive(Equal(flake)))
+		Eventually(changes).Should(Receive(Equal(uint64(11))))
+		// 20ms + 40ms + 80ms of backoff
+		Expect(time.Since(start)).To(BeNumerically(">=", 140*time.Millisecond))
+		Expect(errs).NotTo(Receive())
+	})
+
+	It("caps the backoff", func() {
+		flake := eris.New("flake")
+		results = []queryResult{{err: flake}, {err: flake}, {err: flake}, {err: flake}, {index: 1}}
+		start := time.Now()
+		run(BlockingWatchOpts{Name: "test", InitialBackoff: 20 * time.Millisecond, MaxBackoff: 20 * time.Millisecond})
+
+		Eventually(changes).Should(Receive(Equal(uint64(1))))
+		Expect(time.Since(start)).To(BeNumerically("<", 140*time.Millisecond))
+		Expect(errs).NotTo(Receive())
+	})
+
+	It("records the time it takes to deliver a change", func() {
+		results = []queryResult{{index: 10}}
+		go RunBlockingWatch(ctx, BlockingWatchOpts{Name: "delivery-test"}, query, func() {
+			time.Sleep(50 * time.Millisecond)
+		}, func(err error) {})
+
+		deliveryTime := func() float64 {
+			rows, err := view.RetrieveData("gloo.solo.io/consul/watch_delivery_time")
+			Expect(err).NotTo(HaveOccurred())
+			for _, row := range rows {
+				if len(row.Tags) == 1 && row.Tags[0].Value == "delivery-test" {
+					return row.Data.(*view.DistributionData).Min
+				}
+			}
+			return 0
+		}
+		Eventually(deliveryTime).Should(BeNumerically(">=", 50))
+	})
+})
@@ -24,6 +24,10 @@ type ConsulClient interface {
 	Service(service, tag string, q *consulapi.QueryOptions) ([]*consulapi.CatalogService, *consulapi.QueryMeta, error)
 	// Connect is used to query catalog entries for a given Connect-enabled service
 	Connect(service, tag string, q *consulapi.QueryOptions) ([]*consulapi.CatalogService, *consulapi.QueryMeta, error)
+	// ConnectCARoots is used to query the root certificates of the Connect CA from the local agent
+	ConnectCARoots(q *consulapi.QueryOptions) (*consulapi.CARootList, *consulapi.QueryMeta, error)
+	// ConnectCALeaf is used to query the local agent for a Connect leaf certificate for the given service
+	ConnectCALeaf(service string, q *consulapi.QueryOptions) (*consulapi.LeafCert, *consulapi.QueryMeta, error)
 }
 
 func NewConsulClient(client *consulapi.Client, dataCenters []string) (ConsulClient, error) {
@@ -73,6 +77,14 @@ func (c *consul) Connect(service, tag string, q *consulapi.QueryOptions) ([]*con
 	return c.api.Catalog().Connect(service, tag, q)
 }
 
+func (c *consul) ConnectCARoots(q *consulapi.QueryOptions) (*consulapi.CARootList, *consulapi.QueryMeta, error) {
+	return c.api.Agent().ConnectCARoots(q)
+}
+
+func (c *consul) ConnectCALeaf(service string, q *consulapi.QueryOptions) (*consulapi.LeafCert, *consulapi.QueryMeta, error) {
+	return c.api.Agent().ConnectCALeaf(service, q)
+}
+
 // Filters out the data centers not listed in the config
 func (c *consul) filter(dataCenters []string) []string {
 
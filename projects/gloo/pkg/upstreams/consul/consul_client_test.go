@@ -0,0 +1,144 @@
+package consul_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+
+	consulapi "github.com/hashicorp/consul/api"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
+)
+
+// Stands in for a local Consul agent, recording the query of each request it receives
+type fakeAgent struct {
+	server *httptest.Server
+
+	lock    sync.Mutex
+	queries map[string]url.Values
+}
+
+func newFakeAgent() *fakeAgent {
+	agent := &fakeAgent{queries: map[string]url.Values{}}
+
+	mux := http.NewServeMux()
+	respond := func(path string, body interface{}) {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			agent.lock.Lock()
+			agent.queries[r.URL.Path] = r.URL.Query()
+			agent.lock.Unlock()
+			w.Header().Set("X-Consul-Index", "1")
+			_ = json.NewEncoder(w).Encode(body)
+		})
+	}
+	respond("/v1/catalog/services", map[string][]string{"svc-1": {"tag-1"}})
+	respond("/v1/catalog/connect/svc-1", []*consulapi.CatalogService{{ServiceName: "svc-1-sidecar-proxy", ServicePort: 21000}})
+	respond("/v1/agent/connect/ca/roots", &consulapi.CARootList{
+		TrustDomain: "11111111-2222-3333-4444-555555555555.consul",
+		Roots:       []*consulapi.CARoot{{ID: "root-1", RootCertPEM: "root-pem", Active: true}},
+	})
+	respond("/v1/agent/connect/ca/leaf/gloo", &consulapi.LeafCert{Service: "gloo", CertPEM: "cert-pem", PrivateKeyPEM: "key-pem"})
+
+	agent.server = httptest.NewServer(mux)
+	return agent
+}
+
+func (a *fakeAgent) query(path string) url.Values {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	return a.queries[path]
+}
+
+var _ = Describe("Consul API client", func() {
+
+	var (
+		agent  *fakeAgent
+		client ConsulClient
+	)
+
+	newClient := func(defaults Scope) ConsulClient {
+		cfg := consulapi.DefaultConfig()
+		cfg.Address = agent.server.URL
+		cfg.HttpClient = &http.Client{Transport: NewScopedRoundTripper(http.DefaultTransport, defaults)}
+		apiClient, err := consulapi.NewClient(cfg)
+		Expect(err).NotTo(HaveOccurred())
+		consulClient, err := NewConsulClient(apiClient, nil)
+		Expect(err).NotTo(HaveOccurred())
+		return consulClient
+	}
+
+	BeforeEach(func() {
+		agent = newFakeAgent()
+	})
+
+	AfterEach(func() {
+		agent.server.Close()
+	})
+
+	Context("namespaces and partitions", func() {
+
+		It("does not scope requests if no scope is configured", func() {
+			client = newClient(Scope{})
+
+			_, _, err := client.Services(&consulapi.QueryOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			query := agent.query("/v1/catalog/services")
+			Expect(query).NotTo(HaveKey("ns"))
+			Expect(query).NotTo(HaveKey("partition"))
+		})
+
+		It("scopes requests to the default namespace and partition", func() {
+			client = newClient(Scope{Namespace: "team-a", Partition: "part-a"})
+
+			services, _, err := client.Services(&consulapi.QueryOptions{Datacenter: "dc1"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(services).To(HaveKey("svc-1"))
+
+			query := agent.query("/v1/catalog/services")
+			Expect(query.Get("ns")).To(Equal("team-a"))
+			Expect(query.Get("partition")).To(Equal("part-a"))
+			Expect(query.Get("dc")).To(Equal("dc1"))
+		})
+
+		It("lets the scope of a request override the defaults", func() {
+			client = newClient(Scope{Namespace: "team-a", Partition: "part-a"})
+
+			ctx := WithScope(context.Background(), Scope{Namespace: "team-b"})
+			_, _, err := client.Connect("svc-1", "", (&consulapi.QueryOptions{}).WithContext(ctx))
+			Expect(err).NotTo(HaveOccurred())
+
+			query := agent.query("/v1/catalog/connect/svc-1")
+			Expect(query.Get("ns")).To(Equal("team-b"))
+			Expect(query.Get("partition")).To(Equal("part-a"))
+		})
+	})
+
+	Context("connect CA", func() {
+
+		BeforeEach(func() {
+			client = newClient(Scope{Namespace: "team-a"})
+		})
+
+		It("fetches the CA roots", func() {
+			roots, _, err := client.ConnectCARoots(&consulapi.QueryOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(roots.TrustDomain).To(Equal("11111111-2222-3333-4444-555555555555.consul"))
+			Expect(roots.Roots).To(HaveLen(1))
+			Expect(roots.Roots[0].RootCertPEM).To(Equal("root-pem"))
+		})
+
+		It("fetches leaf certificates in the configured namespace", func() {
+			leaf, _, err := client.ConnectCALeaf("gloo", &consulapi.QueryOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(leaf.CertPEM).To(Equal("cert-pem"))
+			Expect(leaf.PrivateKeyPEM).To(Equal("key-pem"))
+
+			Expect(agent.query("/v1/agent/connect/ca/leaf/gloo").Get("ns")).To(Equal("team-a"))
+		})
+	})
+})
@@ -0,0 +1,77 @@
+package consul
+
+import (
+	"context"
+	"net/http"
+)
+
+const (
+	namespaceQueryParam = "ns"
+	partitionQueryParam = "partition"
+
+	// Name of the namespace/partition Consul uses when none is specified.
+	DefaultScopeName = "default"
+)
+
+// Scope identifies the Consul Enterprise namespace and admin partition a request is evaluated in.
+// Empty fields leave the choice to the Consul agent, which infers them from the ACL token.
+type Scope struct {
+	Namespace string
+	Partition string
+}
+
+type scopeKey struct{}
+
+// The version of the Consul API client we depend on has no notion of namespaces and partitions,
+// so the scope of a request travels in its context and is applied by the round tripper returned by
+// NewScopedRoundTripper. Use it like this:
+//
+//   queryOpts.WithContext(consul.WithScope(ctx, scope))
+func WithScope(ctx context.Context, scope Scope) context.Context {
+	return context.WithValue(ctx, scopeKey{}, scope)
+}
+
+// Returns the scope set on the context via WithScope, if any.
+func ScopeFromContext(ctx context.Context) Scope {
+	scope, _ := ctx.Value(scopeKey{}).(Scope)
+	return scope
+}
+
+// Returns an http.RoundTripper that adds the namespace and partition query parameters to each request
+// sent to Consul. The scope set on the request context via WithScope takes precedence over the defaults.
+func NewScopedRoundTripper(base http.RoundTripper, defaults Scope) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &scopedRoundTripper{base: base, defaults: defaults}
+}
+
+type scopedRoundTripper struct {
+	base     http.RoundTripper
+	defaults Scope
+}
+
+func (t *scopedRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	scope := ScopeFromContext(req.Context())
+	if scope.Namespace == "" {
+		scope.Namespace = t.defaults.Namespace
+	}
+	if scope.Partition == "" {
+		scope.Partition = t.defaults.Partition
+	}
+	if scope.Namespace == "" && scope.Partition == "" {
+		return t.base.RoundTrip(req)
+	}
+
+	// Round trippers must not modify the original request
+	scoped := req.Clone(req.Context())
+	query := scoped.URL.Query()
+	if scope.Namespace != "" {
+		query.Set(namespaceQueryParam, scope.Namespace)
+	}
+	if scope.Partition != "" {
+		query.Set(partitionQueryParam, scope.Partition)
+	}
+	scoped.URL.RawQuery = query.Encode()
+	return t.base.RoundTrip(scoped)
+}
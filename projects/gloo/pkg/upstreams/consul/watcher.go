@@ -2,9 +2,7 @@ package consul
 
 import (
 	"context"
-	"time"
 
-	"github.com/avast/retry-go"
 	consulapi "github.com/hashicorp/consul/api"
 	"github.com/solo-io/go-utils/errutils"
 	"golang.org/x/sync/errgroup"
@@ -121,64 +119,32 @@ func (c *consulWatcher) watchServicesInDataCenter(ctx context.Context, dataCente
 	go func(dataCenter string) {
 		defer close(servicesChan)
 		defer close(errsChan)
-		lastIndex := uint64(0)
 
-		for {
-			select {
-			default:
+		var services map[string][]string
 
+		RunBlockingWatch(ctx, BlockingWatchOpts{Name: "catalog_services", Datacenter: dataCenter},
+			func(q *consulapi.QueryOptions) (*consulapi.QueryMeta, error) {
 				var (
-					services  map[string][]string
 					queryMeta *consulapi.QueryMeta
+					err       error
 				)
-
-				// Use a back-off retry strategy to avoid flooding the error channel
-				err := retry.Do(
-					func() error {
-						var err error
-
-						// This is a blocking query (see [here](https://www.consul.io/api/features/blocking.html) for more info)
-						// The first invocation (with lastIndex equal to zero) will return immediately
-						services, queryMeta, err = c.Services((&consulapi.QueryOptions{
-							Datacenter:        dataCenter,
-							RequireConsistent: true,
-							WaitIndex:         lastIndex,
-						}).WithContext(ctx))
-
-						return err
-					},
-					retry.Attempts(6),
-					//  Last delay is 2^6 * 100ms = 3.2s
-					retry.Delay(100*time.Millisecond),
-					retry.DelayType(retry.BackOffDelay),
-				)
-
-				if err != nil {
-					errsChan <- err
-					continue
-				}
-
-				// If index is the same, there have been no changes since last query
-				if queryMeta.LastIndex == lastIndex {
-					continue
-				}
-				tuple := &dataCenterServicesTuple{
-					dataCenter: dataCenter,
-					services:   services,
+				// The first invocation (with a wait index equal to zero) will return immediately
+				services, queryMeta, err = c.Services(q)
+				return queryMeta, err
+			},
+			func() {
+				select {
+				case servicesChan <- &dataCenterServicesTuple{dataCenter: dataCenter, services: services}:
+				case <-ctx.Done():
 				}
-
+			},
+			func(err error) {
 				select {
-				case servicesChan <- tuple:
+				case errsChan <- err:
 				case <-ctx.Done():
-					return
 				}
-				// Update the last index
-				lastIndex = queryMeta.LastIndex
-
-			case <-ctx.Done():
-				return
-			}
-		}
+			},
+		)
 	}(dataCenter)
 
 	return servicesChan, errsChan
@@ -21,36 +21,39 @@ func MakeReport(proxy *v1.Proxy) *validation.ProxyReport {
 	for i, listener := range listeners {
 		switch listenerType := listener.GetListenerType().(type) {
 		case *v1.Listener_HttpListener:
-
-			vhostReports := make([]*validation.VirtualHostReport, len(listenerType.HttpListener.GetVirtualHosts()))
-
-			for j, vh := range listenerType.HttpListener.GetVirtualHosts() {
-				routeReports := make([]*validation.RouteReport, len(vh.GetRoutes()))
-				for k := range vh.GetRoutes() {
-					routeReports[k] = &validation.RouteReport{}
-				}
-
-				vhostReports[j] = &validation.VirtualHostReport{
-					RouteReports: routeReports,
-				}
-			}
-
 			listenerReports[i] = &validation.ListenerReport{
 				ListenerTypeReport: &validation.ListenerReport_HttpListenerReport{
-					HttpListenerReport: &validation.HttpListenerReport{
-						VirtualHostReports: vhostReports,
-					},
+					HttpListenerReport: makeHttpListenerReport(listenerType.HttpListener),
 				},
 			}
 		case *v1.Listener_TcpListener:
-			tcpHostReports := make([]*validation.TcpHostReport, len(listenerType.TcpListener.GetTcpHosts()))
-			for j := range listenerType.TcpListener.GetTcpHosts() {
-				tcpHostReports[j] = &validation.TcpHostReport{}
-			}
 			listenerReports[i] = &validation.ListenerReport{
 				ListenerTypeReport: &validation.ListenerReport_TcpListenerReport{
-					TcpListenerReport: &validation.TcpListenerReport{
-						TcpHostReports: tcpHostReports,
+					TcpListenerReport: makeTcpListenerReport(listenerType.TcpListener),
+				},
+			}
+		case *v1.Listener_UdpListener:
+			// udp listeners have no sub-resources, so all of their errors are reported on the listener itself
+			listenerReports[i] = &validation.ListenerReport{}
+		case *v1.Listener_HybridListener:
+			matchedListenerReports := make([]*validation.MatchedListenerReport, len(listenerType.HybridListener.GetMatchedListeners()))
+			for j, matchedListener := range listenerType.HybridListener.GetMatchedListeners() {
+				matchedListenerReports[j] = &validation.MatchedListenerReport{}
+				switch matchedListenerType := matchedListener.GetListenerType().(type) {
+				case *v1.MatchedListener_HttpListener:
+					matchedListenerReports[j].ListenerTypeReport = &validation.MatchedListenerReport_HttpListenerReport{
+						HttpListenerReport: makeHttpListenerReport(matchedListenerType.HttpListener),
+					}
+				case *v1.MatchedListener_TcpListener:
+					matchedListenerReports[j].ListenerTypeReport = &validation.MatchedListenerReport_TcpListenerReport{
+						TcpListenerReport: makeTcpListenerReport(matchedListenerType.TcpListener),
+					}
+				}
+			}
+			listenerReports[i] = &validation.ListenerReport{
+				ListenerTypeReport: &validation.ListenerReport_HybridListenerReport{
+					HybridListenerReport: &validation.HybridListenerReport{
+						MatchedListenerReports: matchedListenerReports,
 					},
 				},
 			}
@@ -62,6 +65,36 @@ func MakeReport(proxy *v1.Proxy) *validation.ProxyReport {
 	}
 }
 
+func makeHttpListenerReport(httpListener *v1.HttpListener) *validation.HttpListenerReport {
+	vhostReports := make([]*validation.VirtualHostReport, len(httpListener.GetVirtualHosts()))
+
+	for j, vh := range httpListener.GetVirtualHosts() {
+		routeReports := make([]*validation.RouteReport, len(vh.GetRoutes()))
+		for k := range vh.GetRoutes() {
+			routeReports[k] = &validation.RouteReport{}
+		}
+
+		vhostReports[j] = &validation.VirtualHostReport{
+			RouteReports: routeReports,
+		}
+	}
+
+	return &validation.HttpListenerReport{
+		VirtualHostReports: vhostReports,
+	}
+}
+
+func makeTcpListenerReport(tcpListener *v1.TcpListener) *validation.TcpListenerReport {
+	tcpHostReports := make([]*validation.TcpHostReport, len(tcpListener.GetTcpHosts()))
+	for j := range tcpListener.GetTcpHosts() {
+		tcpHostReports[j] = &validation.TcpHostReport{}
+	}
+
+	return &validation.TcpListenerReport{
+		TcpHostReports: tcpHostReports,
+	}
+}
+
 func mkErr(level, errType, reason string) error {
 	return errors.Errorf("%v Error: %v. Reason: %v", level, errType, reason)
 }
@@ -136,28 +169,16 @@ func GetProxyError(proxyRpt *validation.ProxyReport) error {
 		}
 		switch listenerType := listener.ListenerTypeReport.(type) {
 		case *validation.ListenerReport_HttpListenerReport:
-			httpListener := listenerType.HttpListenerReport
-			if err := GetHttpListenerErr(httpListener); err != nil {
-				errs = append(errs, err...)
-			}
-			for _, vhReport := range httpListener.GetVirtualHostReports() {
-				if err := GetVirtualHostErr(vhReport); err != nil {
-					errs = append(errs, err...)
-				}
-				for _, routeReport := range vhReport.GetRouteReports() {
-					if err := GetRouteErr(routeReport); err != nil {
-						errs = append(errs, err...)
-					}
-				}
-			}
+			errs = append(errs, getHttpListenerReportErrs(listenerType.HttpListenerReport)...)
 		case *validation.ListenerReport_TcpListenerReport:
-			tcpListener := listenerType.TcpListenerReport
-			if err := GetTcpListenerErr(tcpListener); err != nil {
-				errs = append(errs, err...)
-			}
-			for _, hostReport := range tcpListener.GetTcpHostReports() {
-				if err := GetTcpHostErr(hostReport); err != nil {
-					errs = append(errs, err...)
+			errs = append(errs, getTcpListenerReportErrs(listenerType.TcpListenerReport)...)
+		case *validation.ListenerReport_HybridListenerReport:
+			for _, matchedListener := range listenerType.HybridListenerReport.GetMatchedListenerReports() {
+				switch matchedListenerType := matchedListener.ListenerTypeReport.(type) {
+				case *validation.MatchedListenerReport_HttpListenerReport:
+					errs = append(errs, getHttpListenerReportErrs(matchedListenerType.HttpListenerReport)...)
+				case *validation.MatchedListenerReport_TcpListenerReport:
+					errs = append(errs, getTcpListenerReportErrs(matchedListenerType.TcpListenerReport)...)
 				}
 			}
 		}
@@ -171,13 +192,30 @@ func GetProxyError(proxyRpt *validation.ProxyReport) error {
 	return combinedErr
 }
 
+func getHttpListenerReportErrs(httpListener *validation.HttpListenerReport) []error {
+	errs := GetHttpListenerErr(httpListener)
+	for _, vhReport := range httpListener.GetVirtualHostReports() {
+		errs = append(errs, GetVirtualHostErr(vhReport)...)
+		for _, routeReport := range vhReport.GetRouteReports() {
+			errs = append(errs, GetRouteErr(routeReport)...)
+		}
+	}
+	return errs
+}
+
+func getTcpListenerReportErrs(tcpListener *validation.TcpListenerReport) []error {
+	errs := GetTcpListenerErr(tcpListener)
+	for _, hostReport := range tcpListener.GetTcpHostReports() {
+		errs = append(errs, GetTcpHostErr(hostReport)...)
+	}
+	return errs
+}
+
 func GetProxyWarning(proxyRpt *validation.ProxyReport) []string {
 	var warnings []string
 
 	for _, listener := range proxyRpt.GetListenerReports() {
-		switch listenerType := listener.ListenerTypeReport.(type) {
-		case *validation.ListenerReport_HttpListenerReport:
-			httpListener := listenerType.HttpListenerReport
+		for _, httpListener := range GetHttpListenerReports(listener) {
 			for _, vhReport := range httpListener.GetVirtualHostReports() {
 				for _, routeReport := range vhReport.GetRouteReports() {
 					if warns := GetRouteWarning(routeReport); len(warns) > 0 {
@@ -191,6 +229,24 @@ func GetProxyWarning(proxyRpt *validation.ProxyReport) []string {
 	return warnings
 }
 
+// GetHttpListenerReports returns the report of an http listener, or the reports of the http listeners matched
+// by a hybrid listener
+func GetHttpListenerReports(listenerReport *validation.ListenerReport) []*validation.HttpListenerReport {
+	switch listenerType := listenerReport.GetListenerTypeReport().(type) {
+	case *validation.ListenerReport_HttpListenerReport:
+		return []*validation.HttpListenerReport{listenerType.HttpListenerReport}
+	case *validation.ListenerReport_HybridListenerReport:
+		var httpListenerReports []*validation.HttpListenerReport
+		for _, matchedListener := range listenerType.HybridListenerReport.GetMatchedListenerReports() {
+			if httpListenerReport := matchedListener.GetHttpListenerReport(); httpListenerReport != nil {
+				httpListenerReports = append(httpListenerReports, httpListenerReport)
+			}
+		}
+		return httpListenerReports
+	}
+	return nil
+}
+
 func AppendListenerError(listenerReport *validation.ListenerReport, errType validation.ListenerReport_Error_Type, reason string) {
 	listenerReport.Errors = append(listenerReport.Errors, &validation.ListenerReport_Error{
 		Type:   errType,
@@ -19,6 +19,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/syncer/sanitizer"
 	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	validationutils "github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 	"google.golang.org/grpc"
 )
@@ -211,23 +212,25 @@ func routeErrorToWarnings(resourceReport reporter.ResourceReports, validationRep
 	}
 
 	for _, listenerReport := range validationReport.GetListenerReports() {
-		for _, virtualHostReport := range listenerReport.GetHttpListenerReport().GetVirtualHostReports() {
-			for _, routeReport := range virtualHostReport.GetRouteReports() {
-				modifiedErrors := make([]*validation.RouteReport_Error, 0)
-				for _, rError := range routeReport.GetErrors() {
-					if _, inErrors := resourceReportErrors[rError.String()]; !inErrors {
-						if _, inWarnings := resourceReportWarnings[rError.String()]; inWarnings {
-							warning := &validation.RouteReport_Warning{
-								Type:   validation.RouteReport_Warning_Type(rError.GetType()),
-								Reason: rError.GetReason(),
+		for _, httpListenerReport := range validationutils.GetHttpListenerReports(listenerReport) {
+			for _, virtualHostReport := range httpListenerReport.GetVirtualHostReports() {
+				for _, routeReport := range virtualHostReport.GetRouteReports() {
+					modifiedErrors := make([]*validation.RouteReport_Error, 0)
+					for _, rError := range routeReport.GetErrors() {
+						if _, inErrors := resourceReportErrors[rError.String()]; !inErrors {
+							if _, inWarnings := resourceReportWarnings[rError.String()]; inWarnings {
+								warning := &validation.RouteReport_Warning{
+									Type:   validation.RouteReport_Warning_Type(rError.GetType()),
+									Reason: rError.GetReason(),
+								}
+								routeReport.Warnings = append(routeReport.Warnings, warning)
 							}
-							routeReport.Warnings = append(routeReport.Warnings, warning)
+						} else {
+							modifiedErrors = append(modifiedErrors, rError)
 						}
-					} else {
-						modifiedErrors = append(modifiedErrors, rError)
 					}
+					routeReport.Errors = modifiedErrors
 				}
-				routeReport.Errors = modifiedErrors
 			}
 		}
 	}
@@ -8,6 +8,7 @@ import (
 	"hash/fnv"
 	"log"
 
+	gateway_solo_io "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	gloo_solo_io "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 
 	"github.com/rotisserie/eris"
@@ -16,16 +17,18 @@ import (
 )
 
 type TranslatorSnapshot struct {
-	Upstreams gloo_solo_io.UpstreamList
-	Services  KubeServiceList
-	Ingresses IngressList
+	Upstreams    gloo_solo_io.UpstreamList
+	Services     KubeServiceList
+	Ingresses    IngressList
+	RouteOptions gateway_solo_io.RouteOptionList
 }
 
 func (s TranslatorSnapshot) Clone() TranslatorSnapshot {
 	return TranslatorSnapshot{
-		Upstreams: s.Upstreams.Clone(),
-		Services:  s.Services.Clone(),
-		Ingresses: s.Ingresses.Clone(),
+		Upstreams:    s.Upstreams.Clone(),
+		Services:     s.Services.Clone(),
+		Ingresses:    s.Ingresses.Clone(),
+		RouteOptions: s.RouteOptions.Clone(),
 	}
 }
 
@@ -42,6 +45,9 @@ func (s TranslatorSnapshot) Hash(hasher hash.Hash64) (uint64, error) {
 	if _, err := s.hashIngresses(hasher); err != nil {
 		return 0, err
 	}
+	if _, err := s.hashRouteOptions(hasher); err != nil {
+		return 0, err
+	}
 	return hasher.Sum64(), nil
 }
 
@@ -57,6 +63,10 @@ func (s TranslatorSnapshot) hashIngresses(hasher hash.Hash64) (uint64, error) {
 	return hashutils.HashAllSafe(hasher, s.Ingresses.AsInterfaces()...)
 }
 
+func (s TranslatorSnapshot) hashRouteOptions(hasher hash.Hash64) (uint64, error) {
+	return hashutils.HashAllSafe(hasher, s.RouteOptions.AsInterfaces()...)
+}
+
 func (s TranslatorSnapshot) HashFields() []zap.Field {
 	var fields []zap.Field
 	hasher := fnv.New64()
@@ -75,6 +85,11 @@ func (s TranslatorSnapshot) HashFields() []zap.Field {
 		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
 	}
 	fields = append(fields, zap.Uint64("ingresses", IngressesHash))
+	RouteOptionsHash, err := s.hashRouteOptions(hasher)
+	if err != nil {
+		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
+	}
+	fields = append(fields, zap.Uint64("routeOptions", RouteOptionsHash))
 	snapshotHash, err := s.Hash(hasher)
 	if err != nil {
 		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
@@ -83,10 +98,11 @@ func (s TranslatorSnapshot) HashFields() []zap.Field {
 }
 
 type TranslatorSnapshotStringer struct {
-	Version   uint64
-	Upstreams []string
-	Services  []string
-	Ingresses []string
+	Version      uint64
+	Upstreams    []string
+	Services     []string
+	Ingresses    []string
+	RouteOptions []string
 }
 
 func (ss TranslatorSnapshotStringer) String() string {
@@ -107,6 +123,11 @@ func (ss TranslatorSnapshotStringer) String() string {
 		s += fmt.Sprintf("    %v\n", name)
 	}
 
+	s += fmt.Sprintf("  RouteOptions %v\n", len(ss.RouteOptions))
+	for _, name := range ss.RouteOptions {
+		s += fmt.Sprintf("    %v\n", name)
+	}
+
 	return s
 }
 
@@ -116,9 +137,10 @@ func (s TranslatorSnapshot) Stringer() TranslatorSnapshotStringer {
 		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
 	}
 	return TranslatorSnapshotStringer{
-		Version:   snapshotHash,
-		Upstreams: s.Upstreams.NamespacesDotNames(),
-		Services:  s.Services.NamespacesDotNames(),
-		Ingresses: s.Ingresses.NamespacesDotNames(),
+		Version:      snapshotHash,
+		Upstreams:    s.Upstreams.NamespacesDotNames(),
+		Services:     s.Services.NamespacesDotNames(),
+		Ingresses:    s.Ingresses.NamespacesDotNames(),
+		RouteOptions: s.RouteOptions.NamespacesDotNames(),
 	}
 }
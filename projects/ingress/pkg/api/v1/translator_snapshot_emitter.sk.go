@@ -6,6 +6,7 @@ import (
 	"sync"
 	"time"
 
+	gateway_solo_io "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	gloo_solo_io "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 
 	"go.opencensus.io/stats"
@@ -85,17 +86,19 @@ type TranslatorEmitter interface {
 	Upstream() gloo_solo_io.UpstreamClient
 	KubeService() KubeServiceClient
 	Ingress() IngressClient
+	RouteOption() gateway_solo_io.RouteOptionClient
 }
 
-func NewTranslatorEmitter(upstreamClient gloo_solo_io.UpstreamClient, kubeServiceClient KubeServiceClient, ingressClient IngressClient) TranslatorEmitter {
-	return NewTranslatorEmitterWithEmit(upstreamClient, kubeServiceClient, ingressClient, make(chan struct{}))
+func NewTranslatorEmitter(upstreamClient gloo_solo_io.UpstreamClient, kubeServiceClient KubeServiceClient, ingressClient IngressClient, routeOptionClient gateway_solo_io.RouteOptionClient) TranslatorEmitter {
+	return NewTranslatorEmitterWithEmit(upstreamClient, kubeServiceClient, ingressClient, routeOptionClient, make(chan struct{}))
 }
 
-func NewTranslatorEmitterWithEmit(upstreamClient gloo_solo_io.UpstreamClient, kubeServiceClient KubeServiceClient, ingressClient IngressClient, emit <-chan struct{}) TranslatorEmitter {
+func NewTranslatorEmitterWithEmit(upstreamClient gloo_solo_io.UpstreamClient, kubeServiceClient KubeServiceClient, ingressClient IngressClient, routeOptionClient gateway_solo_io.RouteOptionClient, emit <-chan struct{}) TranslatorEmitter {
 	return &translatorEmitter{
 		upstream:    upstreamClient,
 		kubeService: kubeServiceClient,
 		ingress:     ingressClient,
+		routeOption: routeOptionClient,
 		forceEmit:   emit,
 	}
 }
@@ -105,6 +108,7 @@ type translatorEmitter struct {
 	upstream    gloo_solo_io.UpstreamClient
 	kubeService KubeServiceClient
 	ingress     IngressClient
+	routeOption gateway_solo_io.RouteOptionClient
 }
 
 func (c *translatorEmitter) Register() error {
@@ -117,6 +121,9 @@ func (c *translatorEmitter) Register() error {
 	if err := c.ingress.Register(); err != nil {
 		return err
 	}
+	if err := c.routeOption.Register(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -132,6 +139,10 @@ func (c *translatorEmitter) Ingress() IngressClient {
 	return c.ingress
 }
 
+func (c *translatorEmitter) RouteOption() gateway_solo_io.RouteOptionClient {
+	return c.routeOption
+}
+
 func (c *translatorEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts) (<-chan *TranslatorSnapshot, <-chan error, error) {
 
 	if len(watchNamespaces) == 0 {
@@ -172,6 +183,14 @@ func (c *translatorEmitter) Snapshots(watchNamespaces []string, opts clients.Wat
 	ingressChan := make(chan ingressListWithNamespace)
 
 	var initialIngressList IngressList
+	/* Create channel for RouteOption */
+	type routeOptionListWithNamespace struct {
+		list      gateway_solo_io.RouteOptionList
+		namespace string
+	}
+	routeOptionChan := make(chan routeOptionListWithNamespace)
+
+	var initialRouteOptionList gateway_solo_io.RouteOptionList
 
 	currentSnapshot := TranslatorSnapshot{}
 
@@ -230,6 +249,24 @@ func (c *translatorEmitter) Snapshots(watchNamespaces []string, opts clients.Wat
 			defer done.Done()
 			errutils.AggregateErrs(ctx, errs, ingressErrs, namespace+"-ingresses")
 		}(namespace)
+		/* Setup namespaced watch for RouteOption */
+		{
+			routeOptions, err := c.routeOption.List(namespace, clients.ListOpts{Ctx: opts.Ctx, Selector: opts.Selector})
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "initial RouteOption list")
+			}
+			initialRouteOptionList = append(initialRouteOptionList, routeOptions...)
+		}
+		routeOptionNamespacesChan, routeOptionErrs, err := c.routeOption.Watch(namespace, opts)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "starting RouteOption watch")
+		}
+
+		done.Add(1)
+		go func(namespace string) {
+			defer done.Done()
+			errutils.AggregateErrs(ctx, errs, routeOptionErrs, namespace+"-routeOptions")
+		}(namespace)
 
 		/* Watch for changes and update snapshot */
 		go func(namespace string) {
@@ -264,6 +301,15 @@ func (c *translatorEmitter) Snapshots(watchNamespaces []string, opts clients.Wat
 						return
 					case ingressChan <- ingressListWithNamespace{list: ingressList, namespace: namespace}:
 					}
+				case routeOptionList, ok := <-routeOptionNamespacesChan:
+					if !ok {
+						return
+					}
+					select {
+					case <-ctx.Done():
+						return
+					case routeOptionChan <- routeOptionListWithNamespace{list: routeOptionList, namespace: namespace}:
+					}
 				}
 			}
 		}(namespace)
@@ -274,6 +320,8 @@ func (c *translatorEmitter) Snapshots(watchNamespaces []string, opts clients.Wat
 	currentSnapshot.Services = initialKubeServiceList.Sort()
 	/* Initialize snapshot for Ingresses */
 	currentSnapshot.Ingresses = initialIngressList.Sort()
+	/* Initialize snapshot for RouteOptions */
+	currentSnapshot.RouteOptions = initialRouteOptionList.Sort()
 
 	snapshots := make(chan *TranslatorSnapshot)
 	go func() {
@@ -308,6 +356,7 @@ func (c *translatorEmitter) Snapshots(watchNamespaces []string, opts clients.Wat
 		upstreamsByNamespace := make(map[string]gloo_solo_io.UpstreamList)
 		servicesByNamespace := make(map[string]KubeServiceList)
 		ingressesByNamespace := make(map[string]IngressList)
+		routeOptionsByNamespace := make(map[string]gateway_solo_io.RouteOptionList)
 		defer func() {
 			close(snapshots)
 			// we must wait for done before closing the error chan,
@@ -392,6 +441,28 @@ func (c *translatorEmitter) Snapshots(watchNamespaces []string, opts clients.Wat
 					ingressList = append(ingressList, ingresses...)
 				}
 				currentSnapshot.Ingresses = ingressList.Sort()
+			case routeOptionNamespacedList, ok := <-routeOptionChan:
+				if !ok {
+					return
+				}
+				record()
+
+				namespace := routeOptionNamespacedList.namespace
+
+				skstats.IncrementResourceCount(
+					ctx,
+					namespace,
+					"route_option",
+					mTranslatorResourcesIn,
+				)
+
+				// merge lists by namespace
+				routeOptionsByNamespace[namespace] = routeOptionNamespacedList.list
+				var routeOptionList gateway_solo_io.RouteOptionList
+				for _, routeOptions := range routeOptionsByNamespace {
+					routeOptionList = append(routeOptionList, routeOptions...)
+				}
+				currentSnapshot.RouteOptions = routeOptionList.Sort()
 			}
 		}
 	}()
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"time"
 
+	gateway_solo_io "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	gloo_solo_io "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 
 	"go.opencensus.io/stats"
@@ -96,6 +97,8 @@ func (c *translatorSimpleEmitter) Snapshots(ctx context.Context) (<-chan *Transl
 						currentSnapshot.Services = append(currentSnapshot.Services, typed)
 					case *Ingress:
 						currentSnapshot.Ingresses = append(currentSnapshot.Ingresses, typed)
+					case *gateway_solo_io.RouteOption:
+						currentSnapshot.RouteOptions = append(currentSnapshot.RouteOptions, typed)
 					default:
 						select {
 						case errs <- fmt.Errorf("TranslatorSnapshotEmitter "+
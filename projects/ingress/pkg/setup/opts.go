@@ -1,6 +1,7 @@
 package setup
 
 import (
+	"github.com/solo-io/gloo/projects/ingress/pkg/translator"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
 )
@@ -13,6 +14,7 @@ type Opts struct {
 	WatchNamespaces             []string
 	Proxies                     factory.ResourceClientFactory
 	Upstreams                   factory.ResourceClientFactory
+	RouteOptions                factory.ResourceClientFactory
 	Secrets                     factory.ResourceClientFactory
 	WatchOpts                   clients.WatchOpts
 	EnableKnative               bool
@@ -20,5 +22,9 @@ type Opts struct {
 	DisableKubeIngress          bool
 	RequireIngressClass         bool
 	CustomIngressClass          string
+	IngressControllerName       string
 	IngressProxyLabel           string
+	ProxyConfig                 translator.ProxyConfig
+	EnableGatewayApi            bool
+	GatewayApiControllerName    string
 }
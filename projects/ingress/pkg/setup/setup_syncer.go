@@ -12,6 +12,8 @@ import (
 	clusteringressv1alpha1 "github.com/solo-io/gloo/projects/clusteringress/pkg/api/external/knative"
 	clusteringressv1 "github.com/solo-io/gloo/projects/clusteringress/pkg/api/v1"
 	clusteringresstranslator "github.com/solo-io/gloo/projects/clusteringress/pkg/translator"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	gatewayapisetup "github.com/solo-io/gloo/projects/gatewayapi/pkg/setup"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap"
 	gloodefaults "github.com/solo-io/gloo/projects/gloo/pkg/defaults"
@@ -32,8 +34,14 @@ import (
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/cache"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
 	"github.com/solo-io/solo-kit/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
 	knativeclientset "knative.dev/networking/pkg/client/clientset/versioned"
 	"knative.dev/pkg/network"
 )
@@ -68,6 +76,11 @@ func Setup(ctx context.Context, kubeCache kube.SharedCache, inMemoryCache memory
 		return err
 	}
 
+	routeOptionFactory, err := bootstrap.ConfigFactoryForSettings(params, gatewayv1.RouteOptionCrd)
+	if err != nil {
+		return err
+	}
+
 	secretFactory, err := bootstrap.SecretFactoryForSettings(
 		ctx,
 		settings,
@@ -101,8 +114,37 @@ func Setup(ctx context.Context, kubeCache kube.SharedCache, inMemoryCache memory
 	requireIngressClass := envTrue("REQUIRE_INGRESS_CLASS")
 	enableKnative := envTrue("ENABLE_KNATIVE_INGRESS")
 	customIngressClass := os.Getenv("CUSTOM_INGRESS_CLASS")
+	ingressControllerName := os.Getenv("INGRESS_CONTROLLER_NAME")
 	knativeVersion := os.Getenv("KNATIVE_VERSION")
 	ingressProxyLabel := os.Getenv("INGRESS_PROXY_LABEL")
+	forceSslRedirect := envTrue("FORCE_SSL_REDIRECT")
+	enableGatewayApi := envTrue("ENABLE_GATEWAY_API")
+	gatewayApiControllerName := os.Getenv("GATEWAY_API_CONTROLLER_NAME")
+
+	envPort := func(name string, defaultPort uint32) (uint32, error) {
+		value := os.Getenv(name)
+		if value == "" {
+			return defaultPort, nil
+		}
+		port, err := strconv.ParseUint(value, 10, 16)
+		if err != nil {
+			return 0, errors.Wrapf(err, "parsing %v", name)
+		}
+		return uint32(port), nil
+	}
+
+	httpPort, err := envPort("INGRESS_PROXY_HTTP_PORT", translator.DefaultHttpPort)
+	if err != nil {
+		return err
+	}
+	httpsPort, err := envPort("INGRESS_PROXY_HTTPS_PORT", translator.DefaultHttpsPort)
+	if err != nil {
+		return err
+	}
+	tlsPassthroughPort, err := envPort("INGRESS_PROXY_TLS_PASSTHROUGH_PORT", 0)
+	if err != nil {
+		return err
+	}
 
 	clusterIngressProxyAddress := defaultClusterIngressProxyAddress
 	if settings.Knative != nil && settings.Knative.ClusterIngressProxyAddress != "" {
@@ -131,17 +173,27 @@ func Setup(ctx context.Context, kubeCache kube.SharedCache, inMemoryCache memory
 		WatchNamespaces:             watchNamespaces,
 		Proxies:                     proxyFactory,
 		Upstreams:                   upstreamFactory,
+		RouteOptions:                routeOptionFactory,
 		Secrets:                     secretFactory,
 		WatchOpts: clients.WatchOpts{
 			Ctx:         ctx,
 			RefreshRate: refreshRate,
 		},
-		EnableKnative:       enableKnative,
-		KnativeVersion:      knativeVersion,
-		DisableKubeIngress:  disableKubeIngress,
-		RequireIngressClass: requireIngressClass,
-		CustomIngressClass:  customIngressClass,
-		IngressProxyLabel:   ingressProxyLabel,
+		EnableKnative:         enableKnative,
+		KnativeVersion:        knativeVersion,
+		DisableKubeIngress:    disableKubeIngress,
+		RequireIngressClass:   requireIngressClass,
+		CustomIngressClass:    customIngressClass,
+		IngressControllerName: ingressControllerName,
+		IngressProxyLabel:     ingressProxyLabel,
+		ProxyConfig: translator.ProxyConfig{
+			HttpPort:           httpPort,
+			HttpsPort:          httpsPort,
+			TlsPassthroughPort: tlsPassthroughPort,
+			ForceSslRedirect:   forceSslRedirect,
+		},
+		EnableGatewayApi:         enableGatewayApi,
+		GatewayApiControllerName: gatewayApiControllerName,
 	}
 
 	return RunIngress(opts)
@@ -151,9 +203,10 @@ func RunIngress(opts Opts) error {
 	opts.WatchOpts = opts.WatchOpts.WithDefaults()
 	opts.WatchOpts.Ctx = contextutils.WithLogger(opts.WatchOpts.Ctx, "ingress")
 
-	if opts.DisableKubeIngress && !opts.EnableKnative {
-		return errors.Errorf("ingress controller must be enabled for either Knative (clusteringress) or " +
-			"basic kubernetes ingress. set DISABLE_KUBE_INGRESS=0 or ENABLE_KNATIVE_INGRESS=1")
+	if opts.DisableKubeIngress && !opts.EnableKnative && !opts.EnableGatewayApi {
+		return errors.Errorf("ingress controller must be enabled for either Knative (clusteringress), " +
+			"basic kubernetes ingress or the Gateway API. set DISABLE_KUBE_INGRESS=0, ENABLE_KNATIVE_INGRESS=1 " +
+			"or ENABLE_GATEWAY_API=1")
 	}
 
 	cfg, err := kubeutils.GetConfig("", "")
@@ -184,14 +237,33 @@ func RunIngress(opts Opts) error {
 			return err
 		}
 
+		routeOptionClient, err := gatewayv1.NewRouteOptionClient(opts.WatchOpts.Ctx, opts.RouteOptions)
+		if err != nil {
+			return err
+		}
+		if err := routeOptionClient.Register(); err != nil {
+			return err
+		}
+
 		baseIngressClient := ingress.NewResourceClient(kube, &v1.Ingress{})
 		ingressClient := v1.NewIngressClientWithBase(baseIngressClient)
 
 		baseKubeServiceClient := service.NewResourceClient(kube, &v1.KubeService{})
 		kubeServiceClient := v1.NewKubeServiceClientWithBase(baseKubeServiceClient)
 
-		translatorEmitter := v1.NewTranslatorEmitter(upstreamClient, kubeServiceClient, ingressClient)
-		translatorSync := translator.NewSyncer(opts.WriteNamespace, proxyClient, ingressClient, writeErrs, opts.RequireIngressClass, opts.CustomIngressClass)
+		eventBroadcaster := record.NewBroadcaster()
+		eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: kube.CoreV1().Events("")})
+		recorder := eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: "gloo-ingress"})
+
+		// IngressClasses are cluster scoped and not part of the snapshot
+		informerFactory := informers.NewSharedInformerFactory(kube, opts.WatchOpts.RefreshRate)
+		ingressClasses := informerFactory.Networking().V1().IngressClasses()
+
+		translatorEmitter := v1.NewTranslatorEmitter(upstreamClient, kubeServiceClient, ingressClient, routeOptionClient)
+		translatorSync := translator.NewSyncer(opts.WatchOpts.Ctx, opts.WriteNamespace, proxyClient, ingressClient, writeErrs, opts.RequireIngressClass, opts.CustomIngressClass, opts.IngressControllerName, ingressClasses, recorder, kube.NetworkingV1(), opts.ProxyConfig)
+		if opts.RequireIngressClass {
+			informerFactory.Start(opts.WatchOpts.Ctx.Done())
+		}
 		translatorEventLoop := v1.NewTranslatorEventLoop(translatorEmitter, translatorSync)
 		translatorEventLoopErrs, err := translatorEventLoop.Run(opts.WatchNamespaces, opts.WatchOpts)
 		if err != nil {
@@ -248,7 +320,11 @@ func RunIngress(opts Opts) error {
 			go errutils.AggregateErrs(opts.WatchOpts.Ctx, writeErrs, clusterIngTranslatorEventLoopErrs, "cluster_ingress_translator_event_loop")
 		} else {
 			logger.Infof("starting Ingress with KNative (Ingress) support enabled")
-			knativeCache, err := knativeclient.NewIngressCache(opts.WatchOpts.Ctx, knative)
+			dynamicClient, err := dynamic.NewForConfig(cfg)
+			if err != nil {
+				return errors.Wrapf(err, "creating dynamic kube client")
+			}
+			knativeCache, err := knativeclient.NewIngressCache(opts.WatchOpts.Ctx, knative, dynamicClient)
 			if err != nil {
 				return errors.Wrapf(err, "creating knative cache")
 			}
@@ -273,6 +349,18 @@ func RunIngress(opts Opts) error {
 		}
 	}
 
+	if opts.EnableGatewayApi {
+		logger.Infof("starting Ingress with Gateway API support enabled")
+		if err := gatewayapisetup.RunGatewayApi(opts.WatchOpts.Ctx, cfg, proxyClient, gatewayapisetup.Opts{
+			WriteNamespace:  opts.WriteNamespace,
+			WatchNamespaces: opts.WatchNamespaces,
+			ControllerName:  opts.GatewayApiControllerName,
+			ResyncPeriod:    opts.WatchOpts.RefreshRate,
+		}); err != nil {
+			return err
+		}
+	}
+
 	go func() {
 		for {
 			select {
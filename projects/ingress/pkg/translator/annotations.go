@@ -0,0 +1,237 @@
+package translator
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hashicorp/go-multierror"
+	errors "github.com/rotisserie/eris"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	extauthv1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/cors"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+// Annotations configuring the routes of an ingress
+const (
+	// The timeout of requests, e.g. "15s"
+	TimeoutAnnotation = "gloo.solo.io/timeout"
+	// The number of retries of failed requests
+	RetriesAnnotation = "gloo.solo.io/retries"
+	// The conditions to retry on, e.g. "5xx,connect-failure"
+	RetryOnAnnotation = "gloo.solo.io/retry-on"
+	// The timeout of each retry, e.g. "5s"
+	PerTryTimeoutAnnotation = "gloo.solo.io/per-try-timeout"
+	// The prefix the matched path is replaced with
+	PrefixRewriteAnnotation = "gloo.solo.io/prefix-rewrite"
+	// The value the host header is replaced with
+	HostRewriteAnnotation = "gloo.solo.io/host-rewrite"
+	// The AuthConfig securing the routes, as "name" or "namespace/name"
+	AuthConfigRefAnnotation = "gloo.solo.io/auth-config-ref"
+	// A RouteOption whose options the routes inherit, as "name" or "namespace/name".
+	// Options set with annotations override the options of the RouteOption.
+	RouteOptionsRefAnnotation = "gloo.solo.io/route-options-ref"
+)
+
+// Annotations configuring TLS for the hosts of an ingress
+const (
+	// "true" to redirect HTTP requests to HTTPS, "false" to serve them even if a redirect is forced for all ingresses
+	ForceSslRedirectAnnotation = "gloo.solo.io/force-ssl-redirect"
+	// "true" to pass TLS connections for the hosts of the ingress through to their backend without terminating them
+	TlsPassthroughAnnotation = "gloo.solo.io/tls-passthrough"
+)
+
+// Annotations configuring the CORS policy of the virtual hosts of an ingress
+const (
+	// Comma-separated origins
+	CorsAllowOriginAnnotation = "gloo.solo.io/cors-allow-origin"
+	// Comma-separated regexes matching origins
+	CorsAllowOriginRegexAnnotation = "gloo.solo.io/cors-allow-origin-regex"
+	// Comma-separated methods
+	CorsAllowMethodsAnnotation = "gloo.solo.io/cors-allow-methods"
+	// Comma-separated headers
+	CorsAllowHeadersAnnotation = "gloo.solo.io/cors-allow-headers"
+	// Comma-separated headers
+	CorsExposeHeadersAnnotation = "gloo.solo.io/cors-expose-headers"
+	// The number of seconds preflight requests may be cached for
+	CorsMaxAgeAnnotation = "gloo.solo.io/cors-max-age"
+	// "true" to allow credentials
+	CorsAllowCredentialsAnnotation = "gloo.solo.io/cors-allow-credentials"
+)
+
+var (
+	InvalidAnnotationErr = func(err error, annotation, value string) error {
+		return errors.Wrapf(err, "invalid value %q for annotation %v", value, annotation)
+	}
+	TlsPassthroughDisabledErr = errors.Errorf("TLS passthrough is disabled, as no port is configured for it")
+	RouteOptionNotFoundErr    = func(ref *core.ResourceRef) error {
+		return errors.Errorf("RouteOption %v.%v referenced by annotation %v not found", ref.GetNamespace(), ref.GetName(), RouteOptionsRefAnnotation)
+	}
+)
+
+// Returns the route options configured by the annotations of the ingress, or nil if there are none.
+// Invalid annotations are ignored and returned as an error.
+func routeOptionsFromAnnotations(ingress *networkingv1.Ingress, routeOptions gatewayv1.RouteOptionList) (*gloov1.RouteOptions, error) {
+	annotations := ingress.Annotations
+	var errs *multierror.Error
+	opts := &gloov1.RouteOptions{}
+
+	if value, ok := annotations[RouteOptionsRefAnnotation]; ok {
+		ref := parseRef(value, ingress.Namespace)
+		routeOption, err := routeOptions.Find(ref.GetNamespace(), ref.GetName())
+		if err != nil {
+			errs = multierror.Append(errs, RouteOptionNotFoundErr(ref))
+		} else if routeOption.GetOptions() != nil {
+			opts = proto.Clone(routeOption.GetOptions()).(*gloov1.RouteOptions)
+		}
+	}
+
+	if value, ok := annotations[TimeoutAnnotation]; ok {
+		timeout, err := parseDuration(value)
+		if err != nil {
+			errs = multierror.Append(errs, InvalidAnnotationErr(err, TimeoutAnnotation, value))
+		} else {
+			opts.Timeout = timeout
+		}
+	}
+
+	if value, ok := annotations[RetriesAnnotation]; ok {
+		numRetries, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			errs = multierror.Append(errs, InvalidAnnotationErr(err, RetriesAnnotation, value))
+		} else {
+			retryPolicy(opts).NumRetries = uint32(numRetries)
+		}
+	}
+	if value, ok := annotations[RetryOnAnnotation]; ok {
+		retryPolicy(opts).RetryOn = value
+	}
+	if value, ok := annotations[PerTryTimeoutAnnotation]; ok {
+		perTryTimeout, err := parseDuration(value)
+		if err != nil {
+			errs = multierror.Append(errs, InvalidAnnotationErr(err, PerTryTimeoutAnnotation, value))
+		} else {
+			retryPolicy(opts).PerTryTimeout = perTryTimeout
+		}
+	}
+
+	if value, ok := annotations[PrefixRewriteAnnotation]; ok {
+		opts.PrefixRewrite = &wrappers.StringValue{Value: value}
+	}
+	if value, ok := annotations[HostRewriteAnnotation]; ok {
+		opts.HostRewriteType = &gloov1.RouteOptions_HostRewrite{HostRewrite: value}
+	}
+	if value, ok := annotations[AuthConfigRefAnnotation]; ok {
+		opts.Extauth = &extauthv1.ExtAuthExtension{
+			Spec: &extauthv1.ExtAuthExtension_ConfigRef{
+				ConfigRef: parseRef(value, ingress.Namespace),
+			},
+		}
+	}
+
+	if proto.Equal(opts, &gloov1.RouteOptions{}) {
+		opts = nil
+	}
+	return opts, errs.ErrorOrNil()
+}
+
+// Returns the virtual host options configured by the annotations of the ingress, or nil if there are none.
+// Invalid annotations are ignored and returned as an error.
+func virtualHostOptionsFromAnnotations(ingress *networkingv1.Ingress) (*gloov1.VirtualHostOptions, error) {
+	annotations := ingress.Annotations
+	var errs *multierror.Error
+	corsPolicy := &cors.CorsPolicy{}
+
+	if value, ok := annotations[CorsAllowOriginAnnotation]; ok {
+		corsPolicy.AllowOrigin = splitList(value)
+	}
+	if value, ok := annotations[CorsAllowOriginRegexAnnotation]; ok {
+		corsPolicy.AllowOriginRegex = splitList(value)
+	}
+	if value, ok := annotations[CorsAllowMethodsAnnotation]; ok {
+		corsPolicy.AllowMethods = splitList(value)
+	}
+	if value, ok := annotations[CorsAllowHeadersAnnotation]; ok {
+		corsPolicy.AllowHeaders = splitList(value)
+	}
+	if value, ok := annotations[CorsExposeHeadersAnnotation]; ok {
+		corsPolicy.ExposeHeaders = splitList(value)
+	}
+	if value, ok := annotations[CorsMaxAgeAnnotation]; ok {
+		if _, err := strconv.ParseUint(value, 10, 32); err != nil {
+			errs = multierror.Append(errs, InvalidAnnotationErr(err, CorsMaxAgeAnnotation, value))
+		} else {
+			corsPolicy.MaxAge = value
+		}
+	}
+	if value, ok := annotations[CorsAllowCredentialsAnnotation]; ok {
+		allowCredentials, err := strconv.ParseBool(value)
+		if err != nil {
+			errs = multierror.Append(errs, InvalidAnnotationErr(err, CorsAllowCredentialsAnnotation, value))
+		} else {
+			corsPolicy.AllowCredentials = allowCredentials
+		}
+	}
+
+	if proto.Equal(corsPolicy, &cors.CorsPolicy{}) {
+		return nil, errs.ErrorOrNil()
+	}
+	return &gloov1.VirtualHostOptions{Cors: corsPolicy}, errs.ErrorOrNil()
+}
+
+func boolAnnotation(ingress *networkingv1.Ingress, annotation string, defaultValue bool) (bool, error) {
+	value, ok := ingress.Annotations[annotation]
+	if !ok {
+		return defaultValue, nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return defaultValue, InvalidAnnotationErr(err, annotation, value)
+	}
+	return b, nil
+}
+
+func retryPolicy(opts *gloov1.RouteOptions) *retries.RetryPolicy {
+	if opts.GetRetries() == nil {
+		opts.Retries = &retries.RetryPolicy{}
+	}
+	return opts.GetRetries()
+}
+
+func parseDuration(value string) (*duration.Duration, error) {
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return nil, err
+	}
+	if d < 0 {
+		return nil, errors.Errorf("duration must not be negative")
+	}
+	return ptypes.DurationProto(d), nil
+}
+
+// Parses "name" or "namespace/name"
+func parseRef(value, defaultNamespace string) *core.ResourceRef {
+	namespaceAndName := strings.SplitN(value, "/", 2)
+	if len(namespaceAndName) == 1 {
+		return &core.ResourceRef{Name: value, Namespace: defaultNamespace}
+	}
+	return &core.ResourceRef{Name: namespaceAndName[1], Namespace: namespaceAndName[0]}
+}
+
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
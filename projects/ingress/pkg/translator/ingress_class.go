@@ -0,0 +1,59 @@
+package translator
+
+import (
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+const defaultIngressClass = "gloo"
+
+const IngressClassKey = "kubernetes.io/ingress.class"
+
+// The controller name IngressClasses handled by Gloo Edge specify by default
+const DefaultIngressControllerName = "solo.io/gloo-edge"
+
+// The annotation marking the IngressClass of ingresses that do not specify one
+const DefaultIngressClassKey = "ingressclass.kubernetes.io/is-default-class"
+
+// Returns whether the ingress should be translated
+type ingressFilter func(ingress *networkingv1.Ingress) bool
+
+// Selects the ingresses of our ingress class. Ingresses name their class with spec.ingressClassName, which is ours
+// if the IngressClass of that name has our controller, or with the legacy kubernetes.io/ingress.class annotation.
+// If no class is required, all ingresses are ours.
+func newIngressFilter(requireIngressClass bool, ingressClass, controllerName string, ingressClasses []networkingv1.IngressClass) ingressFilter {
+	if !requireIngressClass {
+		return func(*networkingv1.Ingress) bool {
+			return true
+		}
+	}
+	if ingressClass == "" {
+		ingressClass = defaultIngressClass
+	}
+	if controllerName == "" {
+		controllerName = DefaultIngressControllerName
+	}
+
+	ourClasses := make(map[string]bool)
+	var defaultClass bool
+	for _, class := range ingressClasses {
+		ours := class.Spec.Controller == controllerName
+		ourClasses[class.Name] = ours
+		if ours && class.Annotations[DefaultIngressClassKey] == "true" {
+			defaultClass = true
+		}
+	}
+
+	return func(ingress *networkingv1.Ingress) bool {
+		if className := ingress.Spec.IngressClassName; className != nil {
+			if ours, found := ourClasses[*className]; found {
+				return ours
+			}
+			// allow using our class without creating an IngressClass for it
+			return *className == ingressClass
+		}
+		if annotation, ok := ingress.Annotations[IngressClassKey]; ok {
+			return annotation == ingressClass
+		}
+		return defaultClass
+	}
+}
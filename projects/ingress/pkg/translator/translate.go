@@ -3,35 +3,55 @@ package translator
 import (
 	"context"
 	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/solo-io/gloo/projects/ingress/pkg/api/service"
 	"github.com/solo-io/go-utils/contextutils"
 	kubev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/hashicorp/go-multierror"
 	errors "github.com/rotisserie/eris"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	glooutils "github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/gloo/projects/ingress/pkg/api/ingress"
 	v1 "github.com/solo-io/gloo/projects/ingress/pkg/api/v1"
 	"github.com/solo-io/go-utils/log"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
-	"k8s.io/api/extensions/v1beta1"
+	networkingv1 "k8s.io/api/networking/v1"
 )
 
-const defaultIngressClass = "gloo"
+const (
+	DefaultHttpPort  = 8080
+	DefaultHttpsPort = 8443
+)
 
-const IngressClassKey = "kubernetes.io/ingress.class"
+// Configures the listeners of the ingress proxy
+type ProxyConfig struct {
+	// The ports of the HTTP and HTTPS listeners, which must match the ports exposed by the proxy
+	HttpPort  uint32
+	HttpsPort uint32
+	// The port of the listener passing TLS connections through to the backends of ingresses annotated with
+	// gloo.solo.io/tls-passthrough. TLS passthrough is disabled if not set.
+	TlsPassthroughPort uint32
+	// Redirect HTTP requests for hosts with TLS to HTTPS, unless disabled with the gloo.solo.io/force-ssl-redirect annotation
+	ForceSslRedirect bool
+}
 
-func translateProxy(ctx context.Context, namespace string, snap *v1.TranslatorSnapshot, requireIngressClass bool, ingressClass string) *gloov1.Proxy {
+// Errors of ingresses that were translated despite them, e.g. invalid annotations
+type ingressErrors map[*networkingv1.Ingress]error
 
-	if ingressClass == "" {
-		ingressClass = defaultIngressClass
-	}
+func (e ingressErrors) add(ingress *networkingv1.Ingress, err error) {
+	e[ingress] = multierror.Append(e[ingress], err)
+}
 
-	var ingresses []*v1beta1.Ingress
+func translateProxy(ctx context.Context, namespace string, snap *v1.TranslatorSnapshot, isOurIngress ingressFilter, config ProxyConfig) (*gloov1.Proxy, ingressErrors) {
+
+	var ingresses []*networkingv1.Ingress
 	for _, ig := range snap.Ingresses {
 		kubeIngress, err := ingress.ToKube(ig)
 		if err != nil {
@@ -53,26 +73,21 @@ func translateProxy(ctx context.Context, namespace string, snap *v1.TranslatorSn
 
 	upstreams := snap.Upstreams
 
-	virtualHostsHttp, secureVirtualHosts := virtualHosts(ctx, ingresses, upstreams, services, requireIngressClass, ingressClass)
+	errs := make(ingressErrors)
+	virtualHostsHttp, secureVirtualHosts, passthroughHosts := virtualHosts(ctx, ingresses, upstreams, services, snap.RouteOptions, isOurIngress, config, errs)
 
 	var virtualHostsHttps []*gloov1.VirtualHost
-	var sslConfigs []*gloov1.SslConfig
 	for _, svh := range secureVirtualHosts {
-		svh := svh
 		virtualHostsHttps = append(virtualHostsHttps, svh.vh)
-		sslConfigs = append(sslConfigs, &gloov1.SslConfig{
-			SslSecrets: &gloov1.SslConfig_SecretRef{
-				SecretRef: &svh.secret,
-			},
-			SniDomains: svh.vh.Domains,
-		})
 	}
+	sslConfigs := mergeSslConfigs(secureVirtualHosts)
+
 	var listeners []*gloov1.Listener
 	if len(virtualHostsHttp) > 0 {
 		listeners = append(listeners, &gloov1.Listener{
 			Name:        "http",
 			BindAddress: "::",
-			BindPort:    8080,
+			BindPort:    config.HttpPort,
 			ListenerType: &gloov1.Listener_HttpListener{
 				HttpListener: &gloov1.HttpListener{
 					VirtualHosts: virtualHostsHttp,
@@ -84,7 +99,7 @@ func translateProxy(ctx context.Context, namespace string, snap *v1.TranslatorSn
 		listeners = append(listeners, &gloov1.Listener{
 			Name:        "https",
 			BindAddress: "::",
-			BindPort:    8443,
+			BindPort:    config.HttpsPort,
 			ListenerType: &gloov1.Listener_HttpListener{
 				HttpListener: &gloov1.HttpListener{
 					VirtualHosts: virtualHostsHttps,
@@ -93,17 +108,37 @@ func translateProxy(ctx context.Context, namespace string, snap *v1.TranslatorSn
 			SslConfigurations: sslConfigs,
 		})
 	}
+	if len(passthroughHosts) > 0 {
+		listeners = append(listeners, &gloov1.Listener{
+			Name:        "https-passthrough",
+			BindAddress: "::",
+			BindPort:    config.TlsPassthroughPort,
+			ListenerType: &gloov1.Listener_TcpListener{
+				TcpListener: &gloov1.TcpListener{
+					TcpHosts: passthroughHosts,
+				},
+			},
+		})
+	}
 	return &gloov1.Proxy{
 		Metadata: &core.Metadata{
 			Name:      "ingress-proxy", // must match envoy role
 			Namespace: namespace,
 		},
 		Listeners: listeners,
-	}
+	}, errs
 }
 
-func upstreamForBackend(upstreams gloov1.UpstreamList, services []*kubev1.Service, ingressNamespace string, backend v1beta1.IngressBackend) (*gloov1.Upstream, error) {
-	servicePort, err := getServicePort(services, backend.ServiceName, ingressNamespace, backend.ServicePort)
+func upstreamForBackend(upstreams gloov1.UpstreamList, services []*kubev1.Service, ingressNamespace string, backend networkingv1.IngressBackend) (*gloov1.Upstream, error) {
+	if backend.Resource != nil {
+		return upstreamForResourceBackend(upstreams, ingressNamespace, backend.Resource)
+	}
+	if backend.Service == nil {
+		return nil, errors.Errorf("backend has neither a service nor a resource")
+	}
+
+	serviceName := backend.Service.Name
+	servicePort, err := getServicePort(services, serviceName, ingressNamespace, backend.Service.Port)
 	if err != nil {
 		return nil, err
 	}
@@ -115,7 +150,7 @@ func upstreamForBackend(upstreams gloov1.UpstreamList, services []*kubev1.Servic
 		switch spec := us.UpstreamType.(type) {
 		case *gloov1.Upstream_Kube:
 			if spec.Kube.ServiceNamespace == ingressNamespace &&
-				spec.Kube.ServiceName == backend.ServiceName &&
+				spec.Kube.ServiceName == serviceName &&
 				spec.Kube.ServicePort == uint32(servicePort) {
 				if matchingUpstream != nil {
 					originalSelectorLength := len(matchingUpstream.UpstreamType.(*gloov1.Upstream_Kube).Kube.Selector)
@@ -129,16 +164,28 @@ func upstreamForBackend(upstreams gloov1.UpstreamList, services []*kubev1.Servic
 		}
 	}
 	if matchingUpstream == nil {
-		return nil, errors.Errorf("discovery failure: upstream not found for kube service %v with port %v", backend.ServiceName, backend.ServicePort)
+		return nil, errors.Errorf("discovery failure: upstream not found for kube service %v with port %v", serviceName, servicePort)
 	}
 	return matchingUpstream, nil
 }
 
-func getServicePort(services []*kubev1.Service, name, namespace string, servicePort intstr.IntOrString) (int32, error) {
-	if servicePort.Type == intstr.Int {
-		return servicePort.IntVal, nil
+// resource backends may point at a Gloo Upstream in the namespace of the ingress
+func upstreamForResourceBackend(upstreams gloov1.UpstreamList, ingressNamespace string, resource *kubev1.TypedLocalObjectReference) (*gloov1.Upstream, error) {
+	if resource.APIGroup == nil || *resource.APIGroup != gloov1.UpstreamGVK.Group || resource.Kind != gloov1.UpstreamGVK.Kind {
+		return nil, errors.Errorf("unsupported resource backend %v, only %v.%v resources are supported", resource.Name, gloov1.UpstreamGVK.Kind, gloov1.UpstreamGVK.Group)
 	}
-	portName := servicePort.StrVal
+	upstream, err := upstreams.Find(ingressNamespace, resource.Name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "resource backend")
+	}
+	return upstream, nil
+}
+
+func getServicePort(services []*kubev1.Service, name, namespace string, servicePort networkingv1.ServiceBackendPort) (int32, error) {
+	if servicePort.Name == "" {
+		return servicePort.Number, nil
+	}
+	portName := servicePort.Name
 	for _, svc := range services {
 		if svc.Name == name && svc.Namespace == namespace {
 			for _, port := range svc.Spec.Ports {
@@ -154,25 +201,78 @@ func getServicePort(services []*kubev1.Service, name, namespace string, serviceP
 
 type secureVirtualHost struct {
 	vh     *gloov1.VirtualHost
-	secret core.ResourceRef
+	host   string
+	secret *core.ResourceRef
 }
 
-func virtualHosts(ctx context.Context, ingresses []*v1beta1.Ingress, upstreams gloov1.UpstreamList, services []*kubev1.Service, requireIngressClass bool, ingressClass string) ([]*gloov1.VirtualHost, []secureVirtualHost) {
+// Serves all hosts sharing a secret with a single ssl config, matching the SNI of their hosts
+func mergeSslConfigs(secureVirtualHosts []secureVirtualHost) []*gloov1.SslConfig {
+	var sslConfigs []*gloov1.SslConfig
+	sslConfigsBySecret := make(map[string]*gloov1.SslConfig)
+	for _, svh := range secureVirtualHosts {
+		key := svh.secret.Key()
+		sslConfig, ok := sslConfigsBySecret[key]
+		if !ok {
+			sslConfig = &gloov1.SslConfig{
+				SslSecrets: &gloov1.SslConfig_SecretRef{
+					SecretRef: svh.secret,
+				},
+			}
+			sslConfigsBySecret[key] = sslConfig
+			sslConfigs = append(sslConfigs, sslConfig)
+		}
+		sslConfig.SniDomains = append(sslConfig.SniDomains, svh.host)
+	}
+	sort.SliceStable(sslConfigs, func(i, j int) bool {
+		return sslConfigs[i].GetSecretRef().Key() < sslConfigs[j].GetSecretRef().Key()
+	})
+	return sslConfigs
+}
+
+func virtualHosts(ctx context.Context, ingresses []*networkingv1.Ingress, upstreams gloov1.UpstreamList, services []*kubev1.Service, routeOptions gatewayv1.RouteOptionList, isOurIngress ingressFilter, config ProxyConfig, errs ingressErrors) ([]*gloov1.VirtualHost, []secureVirtualHost, []*gloov1.TcpHost) {
 	routesByHostHttp := make(map[string][]*gloov1.Route)
 	routesByHostHttps := make(map[string][]*gloov1.Route)
 	secretsByHost := make(map[string]*core.ResourceRef)
-	var defaultBackend *v1beta1.IngressBackend
+	optionsByHost := make(map[string]*gloov1.VirtualHostOptions)
+	sslRedirectByHost := make(map[string]bool)
+	passthroughHostsByHost := make(map[string]*gloov1.TcpHost)
+	var defaultBackend *networkingv1.IngressBackend
 	for _, ing := range ingresses {
-		if requireIngressClass && !isOurIngress(ing, ingressClass) {
+		if !isOurIngress(ing) {
 			continue
 		}
 		spec := ing.Spec
-		if spec.Backend != nil {
+
+		passthrough, err := boolAnnotation(ing, TlsPassthroughAnnotation, false)
+		if err != nil {
+			errs.add(ing, err)
+		}
+		if passthrough {
+			// the connections to the hosts of the ingress are not terminated, so they cannot be routed by path
+			if config.TlsPassthroughPort == 0 {
+				errs.add(ing, TlsPassthroughDisabledErr)
+				continue
+			}
+			for _, tcpHost := range passthroughHosts(ing, upstreams, services, errs) {
+				if _, alreadySet := passthroughHostsByHost[tcpHost.GetName()]; alreadySet {
+					errs.add(ing, errors.Errorf("TLS passthrough for host %v was redefined, ignoring", tcpHost.GetName()))
+					continue
+				}
+				passthroughHostsByHost[tcpHost.GetName()] = tcpHost
+			}
+			continue
+		}
+
+		sslRedirect, err := boolAnnotation(ing, ForceSslRedirectAnnotation, config.ForceSslRedirect)
+		if err != nil {
+			errs.add(ing, err)
+		}
+		if spec.DefaultBackend != nil {
 			if defaultBackend != nil {
 				contextutils.LoggerFrom(ctx).Warnf("default backend was redeclared in ingress %v, ignoring", ing.Name)
 				continue
 			}
-			defaultBackend = spec.Backend
+			defaultBackend = spec.DefaultBackend
 		}
 		for _, tls := range spec.TLS {
 
@@ -188,14 +288,34 @@ func virtualHosts(ctx context.Context, ingresses []*v1beta1.Ingress, upstreams g
 					}
 				}
 				secretsByHost[host] = &ref
+				if sslRedirect {
+					sslRedirectByHost[host] = true
+				}
 			}
 		}
 
+		routeOpts, err := routeOptionsFromAnnotations(ing, routeOptions)
+		if err != nil {
+			errs.add(ing, err)
+		}
+		virtualHostOpts, err := virtualHostOptionsFromAnnotations(ing)
+		if err != nil {
+			errs.add(ing, err)
+		}
+
 		for i, rule := range spec.Rules {
 			host := rule.Host
 			if host == "" {
 				host = "*"
 			}
+			if virtualHostOpts != nil {
+				// virtual hosts are shared by the ingresses of the host, so their options must agree
+				if existing, alreadySet := optionsByHost[host]; alreadySet && !proto.Equal(existing, virtualHostOpts) {
+					errs.add(ing, errors.Errorf("virtual host options for host %v conflict with the options of another ingress, ignoring", host))
+				} else {
+					optionsByHost[host] = virtualHostOpts
+				}
+			}
 			// set a "default route"
 			if rule.HTTP == nil {
 				log.Warnf("rule %v in ingress %v is missing HTTP field", i, ing.Name)
@@ -208,16 +328,9 @@ func virtualHosts(ctx context.Context, ingresses []*v1beta1.Ingress, upstreams g
 					continue
 				}
 
-				pathRegex := route.Path
-				if pathRegex == "" {
-					pathRegex = ".*"
-				}
 				route := &gloov1.Route{
-					Matchers: []*matchers.Matcher{{
-						PathSpecifier: &matchers.Matcher_Regex{
-							Regex: pathRegex,
-						},
-					}},
+					Matchers: pathMatchers(route),
+					Options:  routeOpts,
 					Action: &gloov1.Route_RouteAction{
 						RouteAction: &gloov1.RouteAction{
 							Destination: &gloov1.RouteAction_Single{
@@ -244,10 +357,28 @@ func virtualHosts(ctx context.Context, ingresses []*v1beta1.Ingress, upstreams g
 
 	for host, routes := range routesByHostHttp {
 		glooutils.SortRoutesByPath(routes)
+		if _, useTls := routesByHostHttps[host]; useTls && sslRedirectByHost[host] {
+			// an earlier ingress routed plain HTTP for the host before TLS was enabled by another one,
+			// keep serving those routes and redirect all other requests
+			routes = append(routes, httpsRedirectRoute())
+		}
 		virtualHostsHttp = append(virtualHostsHttp, &gloov1.VirtualHost{
 			Name:    host + "-http",
-			Domains: []string{host, host + ":8080"},
+			Domains: domains(host, config.HttpPort),
 			Routes:  routes,
+			Options: optionsByHost[host],
+		})
+	}
+
+	for host := range routesByHostHttps {
+		if _, hasHttpRoutes := routesByHostHttp[host]; hasHttpRoutes || !sslRedirectByHost[host] {
+			continue
+		}
+		// all HTTP requests for the host can be redirected
+		virtualHostsHttp = append(virtualHostsHttp, &gloov1.VirtualHost{
+			Name:    host + "-http",
+			Domains: domains(host, config.HttpPort),
+			Routes:  []*gloov1.Route{httpsRedirectRoute()},
 		})
 	}
 
@@ -261,22 +392,123 @@ func virtualHosts(ctx context.Context, ingresses []*v1beta1.Ingress, upstreams g
 		virtualHostsHttps = append(virtualHostsHttps, secureVirtualHost{
 			vh: &gloov1.VirtualHost{
 				Name:    host + "-https",
-				Domains: []string{host, host + ":8443"},
+				Domains: domains(host, config.HttpsPort),
 				Routes:  routes,
+				Options: optionsByHost[host],
 			},
-			secret: *secret,
+			host:   host,
+			secret: secret,
 		})
 	}
 
+	var tcpHosts []*gloov1.TcpHost
+	for _, tcpHost := range passthroughHostsByHost {
+		tcpHosts = append(tcpHosts, tcpHost)
+	}
+
 	sort.SliceStable(virtualHostsHttp, func(i, j int) bool {
 		return virtualHostsHttp[i].Name < virtualHostsHttp[j].Name
 	})
 	sort.SliceStable(virtualHostsHttps, func(i, j int) bool {
 		return virtualHostsHttps[i].vh.Name < virtualHostsHttps[j].vh.Name
 	})
-	return virtualHostsHttp, virtualHostsHttps
+	sort.SliceStable(tcpHosts, func(i, j int) bool {
+		return tcpHosts[i].GetName() < tcpHosts[j].GetName()
+	})
+	return virtualHostsHttp, virtualHostsHttps, tcpHosts
+}
+
+// Redirects all requests to HTTPS
+func httpsRedirectRoute() *gloov1.Route {
+	return &gloov1.Route{
+		Matchers: []*matchers.Matcher{{
+			PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/"},
+		}},
+		Action: &gloov1.Route_RedirectAction{
+			RedirectAction: &gloov1.RedirectAction{
+				HttpsRedirect: true,
+			},
+		},
+	}
 }
 
-func isOurIngress(ingress *v1beta1.Ingress, ingressClassToUse string) bool {
-	return ingress.Annotations[IngressClassKey] == ingressClassToUse
+// Requests may specify the port in the host header
+func domains(host string, port uint32) []string {
+	return []string{host, host + ":" + strconv.Itoa(int(port))}
+}
+
+// Passes TLS connections for the hosts of the ingress through to the backend of their first path,
+// or the default backend of the ingress
+func passthroughHosts(ing *networkingv1.Ingress, upstreams gloov1.UpstreamList, services []*kubev1.Service, errs ingressErrors) []*gloov1.TcpHost {
+	var tcpHosts []*gloov1.TcpHost
+	for _, rule := range ing.Spec.Rules {
+		if rule.Host == "" {
+			errs.add(ing, errors.Errorf("TLS passthrough requires the host of every rule to be set"))
+			continue
+		}
+		backend := ing.Spec.DefaultBackend
+		if rule.HTTP != nil && len(rule.HTTP.Paths) > 0 {
+			backend = &rule.HTTP.Paths[0].Backend
+		}
+		if backend == nil {
+			errs.add(ing, errors.Errorf("no backend for TLS passthrough of host %v", rule.Host))
+			continue
+		}
+		upstream, err := upstreamForBackend(upstreams, services, ing.Namespace, *backend)
+		if err != nil {
+			errs.add(ing, errors.Wrapf(err, "lookup upstream for TLS passthrough of host %v", rule.Host))
+			continue
+		}
+		tcpHosts = append(tcpHosts, &gloov1.TcpHost{
+			Name: rule.Host,
+			SslConfig: &gloov1.SslConfig{
+				SniDomains: []string{rule.Host},
+			},
+			Destination: &gloov1.TcpHost_TcpAction{
+				Destination: &gloov1.TcpHost_TcpAction_Single{
+					Single: &gloov1.Destination{
+						DestinationType: &gloov1.Destination_Upstream{
+							Upstream: upstream.GetMetadata().Ref(),
+						},
+					},
+				},
+			},
+		})
+	}
+	return tcpHosts
+}
+
+// Exact and Prefix paths are matched as defined by Kubernetes,
+// while implementation specific paths are regexes.
+func pathMatchers(path networkingv1.HTTPIngressPath) []*matchers.Matcher {
+	pathType := networkingv1.PathTypeImplementationSpecific
+	if path.PathType != nil {
+		pathType = *path.PathType
+	}
+	switch pathType {
+	case networkingv1.PathTypeExact:
+		return []*matchers.Matcher{{
+			PathSpecifier: &matchers.Matcher_Exact{Exact: path.Path},
+		}}
+	case networkingv1.PathTypePrefix:
+		// prefixes match whole path elements, so /foo matches /foo and /foo/bar but not /foobar
+		prefix := strings.TrimSuffix(path.Path, "/")
+		if prefix == "" {
+			return []*matchers.Matcher{{
+				PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/"},
+			}}
+		}
+		return []*matchers.Matcher{
+			{PathSpecifier: &matchers.Matcher_Exact{Exact: prefix}},
+			{PathSpecifier: &matchers.Matcher_Prefix{Prefix: prefix + "/"}},
+		}
+	default:
+		pathRegex := path.Path
+		if pathRegex == "" {
+			pathRegex = ".*"
+		}
+		return []*matchers.Matcher{{
+			PathSpecifier: &matchers.Matcher_Regex{Regex: pathRegex},
+		}}
+	}
 }
@@ -2,27 +2,37 @@ package translator
 
 import (
 	"context"
+	"time"
 
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/wrappers"
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	extauthv1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/cors"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
 	ingresstype "github.com/solo-io/gloo/projects/ingress/pkg/api/ingress"
 	"github.com/solo-io/gloo/projects/ingress/pkg/api/service"
 	v1 "github.com/solo-io/gloo/projects/ingress/pkg/api/v1"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	. "github.com/solo-io/solo-kit/test/matchers"
 	kubev1 "k8s.io/api/core/v1"
-	extensions "k8s.io/api/extensions/v1beta1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/intstr"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	"sigs.k8s.io/yaml"
 )
 
 var _ = Describe("Translate", func() {
 	var (
-		ctx = context.Background()
+		ctx         = context.Background()
+		proxyConfig = ProxyConfig{HttpPort: DefaultHttpPort, HttpsPort: DefaultHttpsPort}
 	)
 
 	It("creates the appropriate proxy object for the provided ingress objects", func() {
@@ -32,7 +42,7 @@ var _ = Describe("Translate", func() {
 			serviceName := "wow-service"
 			servicePort := int32(8080)
 			secretName := "areallygreatsecret"
-			ingress := &extensions.Ingress{
+			ingress := &networkingv1.Ingress{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "ing",
 					Namespace: namespace,
@@ -40,20 +50,21 @@ var _ = Describe("Translate", func() {
 						IngressClassKey: "gloo",
 					},
 				},
-				Spec: extensions.IngressSpec{
-					Rules: []extensions.IngressRule{
+				Spec: networkingv1.IngressSpec{
+					Rules: []networkingv1.IngressRule{
 						{
 							Host: "wow.com",
-							IngressRuleValue: extensions.IngressRuleValue{
-								HTTP: &extensions.HTTPIngressRuleValue{
-									Paths: []extensions.HTTPIngressPath{
+							IngressRuleValue: networkingv1.IngressRuleValue{
+								HTTP: &networkingv1.HTTPIngressRuleValue{
+									Paths: []networkingv1.HTTPIngressPath{
 										{
 											Path: "/",
-											Backend: extensions.IngressBackend{
-												ServiceName: serviceName,
-												ServicePort: intstr.IntOrString{
-													Type:   intstr.Int,
-													IntVal: servicePort,
+											Backend: networkingv1.IngressBackend{
+												Service: &networkingv1.IngressServiceBackend{
+													Name: serviceName,
+													Port: networkingv1.ServiceBackendPort{
+														Number: servicePort,
+													},
 												},
 											},
 										},
@@ -64,7 +75,7 @@ var _ = Describe("Translate", func() {
 					},
 				},
 			}
-			ingressTls := &extensions.Ingress{
+			ingressTls := &networkingv1.Ingress{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "ing-tls",
 					Namespace: namespace,
@@ -72,26 +83,27 @@ var _ = Describe("Translate", func() {
 						IngressClassKey: "gloo",
 					},
 				},
-				Spec: extensions.IngressSpec{
-					TLS: []extensions.IngressTLS{
+				Spec: networkingv1.IngressSpec{
+					TLS: []networkingv1.IngressTLS{
 						{
 							Hosts:      []string{"wow.com"},
 							SecretName: secretName,
 						},
 					},
-					Rules: []extensions.IngressRule{
+					Rules: []networkingv1.IngressRule{
 						{
 							Host: "wow.com",
-							IngressRuleValue: extensions.IngressRuleValue{
-								HTTP: &extensions.HTTPIngressRuleValue{
-									Paths: []extensions.HTTPIngressPath{
+							IngressRuleValue: networkingv1.IngressRuleValue{
+								HTTP: &networkingv1.HTTPIngressRuleValue{
+									Paths: []networkingv1.HTTPIngressPath{
 										{
 											Path: "/basic",
-											Backend: extensions.IngressBackend{
-												ServiceName: serviceName,
-												ServicePort: intstr.IntOrString{
-													Type:   intstr.Int,
-													IntVal: servicePort,
+											Backend: networkingv1.IngressBackend{
+												Service: &networkingv1.IngressServiceBackend{
+													Name: serviceName,
+													Port: networkingv1.ServiceBackendPort{
+														Number: servicePort,
+													},
 												},
 											},
 										},
@@ -102,7 +114,7 @@ var _ = Describe("Translate", func() {
 					},
 				},
 			}
-			ingressTls2 := &extensions.Ingress{
+			ingressTls2 := &networkingv1.Ingress{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "ing-tls-2",
 					Namespace: namespace,
@@ -110,26 +122,27 @@ var _ = Describe("Translate", func() {
 						IngressClassKey: "gloo",
 					},
 				},
-				Spec: extensions.IngressSpec{
-					TLS: []extensions.IngressTLS{
+				Spec: networkingv1.IngressSpec{
+					TLS: []networkingv1.IngressTLS{
 						{
 							Hosts:      []string{"wow.com"},
 							SecretName: secretName,
 						},
 					},
-					Rules: []extensions.IngressRule{
+					Rules: []networkingv1.IngressRule{
 						{
 							Host: "wow.com",
-							IngressRuleValue: extensions.IngressRuleValue{
-								HTTP: &extensions.HTTPIngressRuleValue{
-									Paths: []extensions.HTTPIngressPath{
+							IngressRuleValue: networkingv1.IngressRuleValue{
+								HTTP: &networkingv1.HTTPIngressRuleValue{
+									Paths: []networkingv1.HTTPIngressPath{
 										{
 											Path: "/longestpathshouldcomesecond",
-											Backend: extensions.IngressBackend{
-												ServiceName: serviceName,
-												ServicePort: intstr.IntOrString{
-													Type:   intstr.Int,
-													IntVal: servicePort,
+											Backend: networkingv1.IngressBackend{
+												Service: &networkingv1.IngressServiceBackend{
+													Name: serviceName,
+													Port: networkingv1.ServiceBackendPort{
+														Number: servicePort,
+													},
 												},
 											},
 										},
@@ -187,7 +200,7 @@ var _ = Describe("Translate", func() {
 				Ingresses: v1.IngressList{ingressRes, ingressResTls, ingressResTls2},
 				Upstreams: gloov1.UpstreamList{us, usSubset},
 			}
-			proxy := translateProxy(ctx, namespace, snap, requireIngressClass, "")
+			proxy, _ := translateProxy(ctx, namespace, snap, newIngressFilter(requireIngressClass, "", "", nil), proxyConfig)
 
 			Expect(proxy.String()).To(Equal((&gloov1.Proxy{
 				Listeners: []*gloov1.Listener{
@@ -301,7 +314,7 @@ var _ = Describe("Translate", func() {
 										Namespace: "example",
 									},
 								},
-								SniDomains: []string{"wow.com", "wow.com:8443"},
+								SniDomains: []string{"wow.com"},
 							},
 						},
 					},
@@ -318,7 +331,7 @@ var _ = Describe("Translate", func() {
 
 	It("handles multiple secrets correctly", func() {
 		ingresses := func() v1.IngressList {
-			var ingressList extensions.IngressList
+			var ingressList networkingv1.IngressList
 			err := yaml.Unmarshal([]byte(ingressExampleYaml), &ingressList)
 			Expect(err).NotTo(HaveOccurred())
 
@@ -357,7 +370,7 @@ var _ = Describe("Translate", func() {
 			Upstreams: gloov1.UpstreamList{us1, us2},
 		}
 
-		proxy := translateProxy(ctx, "gloo-system", snap, false, "")
+		proxy, _ := translateProxy(ctx, "gloo-system", snap, newIngressFilter(false, "", "", nil), proxyConfig)
 
 		Expect(proxy.Listeners).To(HaveLen(1))
 		Expect(proxy.Listeners[0].SslConfigurations).To(Equal([]*gloov1.SslConfig{
@@ -370,7 +383,6 @@ var _ = Describe("Translate", func() {
 				},
 				SniDomains: []string{
 					"api-dev.intellishift.com",
-					"api-dev.intellishift.com:8443",
 				},
 			},
 			{
@@ -382,7 +394,6 @@ var _ = Describe("Translate", func() {
 				},
 				SniDomains: []string{
 					"ui-dev.intellishift.com",
-					"ui-dev.intellishift.com:8443",
 				},
 			},
 		}))
@@ -393,7 +404,7 @@ var _ = Describe("Translate", func() {
 		namespace := "ns"
 
 		svc := makeService("svc", namespace, "http", 8081)
-		port := intstr.IntOrString{Type: intstr.Int, IntVal: 8081}
+		port := networkingv1.ServiceBackendPort{Number: 8081}
 
 		us := makeUpstream("us", namespace, svc)
 
@@ -402,11 +413,11 @@ var _ = Describe("Translate", func() {
 		ing1 := makeIng("ing1", namespace, "", host1, "svc", port)
 		ing2 := makeIng("invalid-svc", namespace, "", "host2", "svc-that-doesnt-exist", port)
 
-		proxy := translateProxy(ctx, "write-namespace", &v1.TranslatorSnapshot{
+		proxy, _ := translateProxy(ctx, "write-namespace", &v1.TranslatorSnapshot{
 			Upstreams: []*gloov1.Upstream{us},
 			Services:  []*v1.KubeService{svc},
 			Ingresses: []*v1.Ingress{ing1, ing2},
-		}, false, "")
+		}, newIngressFilter(false, "", "", nil), proxyConfig)
 
 		Expect(proxy.Listeners).To(HaveLen(1))
 		vhosts := proxy.Listeners[0].GetHttpListener().GetVirtualHosts()
@@ -423,7 +434,7 @@ var _ = Describe("Translate", func() {
 		namespace := "ns"
 
 		svc := makeService("svc", namespace, "http", 8081)
-		port := intstr.IntOrString{Type: intstr.Int, IntVal: 8081}
+		port := networkingv1.ServiceBackendPort{Number: 8081}
 
 		us := makeUpstream("us", namespace, svc)
 
@@ -432,11 +443,11 @@ var _ = Describe("Translate", func() {
 		ing1 := makeIng("ing1", namespace, customClass1, host1, "svc", port)
 		ing2 := makeIng("ing2", namespace, customClass2, "host2", "svc", port)
 
-		proxy := translateProxy(ctx, "write-namespace", &v1.TranslatorSnapshot{
+		proxy, _ := translateProxy(ctx, "write-namespace", &v1.TranslatorSnapshot{
 			Upstreams: []*gloov1.Upstream{us},
 			Services:  []*v1.KubeService{svc},
 			Ingresses: []*v1.Ingress{ing1, ing2},
-		}, true, customClass1)
+		}, newIngressFilter(true, customClass1, "", nil), proxyConfig)
 
 		Expect(proxy.Listeners).To(HaveLen(1))
 		vhosts := proxy.Listeners[0].GetHttpListener().GetVirtualHosts()
@@ -450,31 +461,583 @@ var _ = Describe("Translate", func() {
 		namespace := "ns"
 
 		svc := makeService("svc", namespace, "http", 8081)
-		port := intstr.IntOrString{Type: intstr.String, StrVal: "http"}
+		port := networkingv1.ServiceBackendPort{Name: "http"}
 
 		us := makeUpstream("us", namespace, svc)
 
 		ing1 := makeIng("ing1", namespace, "", "host", "svc", port)
 
-		proxy := translateProxy(ctx, "write-namespace", &v1.TranslatorSnapshot{
+		proxy, _ := translateProxy(ctx, "write-namespace", &v1.TranslatorSnapshot{
 			Upstreams: []*gloov1.Upstream{us},
 			Services:  []*v1.KubeService{svc},
 			Ingresses: []*v1.Ingress{ing1},
-		}, false, "")
+		}, newIngressFilter(false, "", "", nil), proxyConfig)
 
 		Expect(proxy.Listeners).To(HaveLen(1))
 		vhosts := proxy.Listeners[0].GetHttpListener().GetVirtualHosts()
 		// successful translation
 		Expect(vhosts).To(HaveLen(1))
 	})
+
+	Context("path types", func() {
+
+		var (
+			namespace = "ns"
+			svc       *v1.KubeService
+			us        *gloov1.Upstream
+		)
+
+		BeforeEach(func() {
+			svc = makeService("svc", namespace, "http", 8081)
+			us = makeUpstream("us", namespace, svc)
+		})
+
+		translatePath := func(path string, pathType *networkingv1.PathType) []*matchers.Matcher {
+			ing := makeIng("ing", namespace, "", "host", "svc", networkingv1.ServiceBackendPort{Number: 8081})
+			kubeIng, err := ingresstype.ToKube(ing)
+			Expect(err).NotTo(HaveOccurred())
+			kubeIng.Spec.Rules[0].HTTP.Paths[0].Path = path
+			kubeIng.Spec.Rules[0].HTTP.Paths[0].PathType = pathType
+			ing, err = ingresstype.FromKube(kubeIng)
+			Expect(err).NotTo(HaveOccurred())
+
+			proxy, _ := translateProxy(ctx, "write-namespace", &v1.TranslatorSnapshot{
+				Upstreams: []*gloov1.Upstream{us},
+				Services:  []*v1.KubeService{svc},
+				Ingresses: []*v1.Ingress{ing},
+			}, newIngressFilter(false, "", "", nil), proxyConfig)
+
+			Expect(proxy.Listeners).To(HaveLen(1))
+			vhosts := proxy.Listeners[0].GetHttpListener().GetVirtualHosts()
+			Expect(vhosts).To(HaveLen(1))
+			Expect(vhosts[0].Routes).To(HaveLen(1))
+			return vhosts[0].Routes[0].Matchers
+		}
+
+		pathTypeRef := func(pathType networkingv1.PathType) *networkingv1.PathType {
+			return &pathType
+		}
+
+		DescribeTable("translates the path type to matchers",
+			func(path string, pathType *networkingv1.PathType, expected []*matchers.Matcher) {
+				Expect(translatePath(path, pathType)).To(Equal(expected))
+			},
+			Entry("exact", "/foo", pathTypeRef(networkingv1.PathTypeExact), []*matchers.Matcher{
+				{PathSpecifier: &matchers.Matcher_Exact{Exact: "/foo"}},
+			}),
+			Entry("prefix", "/foo/", pathTypeRef(networkingv1.PathTypePrefix), []*matchers.Matcher{
+				{PathSpecifier: &matchers.Matcher_Exact{Exact: "/foo"}},
+				{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/foo/"}},
+			}),
+			Entry("root prefix", "/", pathTypeRef(networkingv1.PathTypePrefix), []*matchers.Matcher{
+				{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/"}},
+			}),
+			Entry("implementation specific", "/foo/.*", pathTypeRef(networkingv1.PathTypeImplementationSpecific), []*matchers.Matcher{
+				{PathSpecifier: &matchers.Matcher_Regex{Regex: "/foo/.*"}},
+			}),
+			Entry("unspecified", "", nil, []*matchers.Matcher{
+				{PathSpecifier: &matchers.Matcher_Regex{Regex: ".*"}},
+			}),
+		)
+	})
+
+	Context("ingress classes", func() {
+
+		className := func(name string) *string {
+			return &name
+		}
+
+		makeClass := func(name, controller string, isDefault bool) networkingv1.IngressClass {
+			class := networkingv1.IngressClass{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec:       networkingv1.IngressClassSpec{Controller: controller},
+			}
+			if isDefault {
+				class.Annotations = map[string]string{DefaultIngressClassKey: "true"}
+			}
+			return class
+		}
+
+		makeKubeIng := func(ingressClassName *string, annotations map[string]string) *networkingv1.Ingress {
+			return &networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Annotations: annotations},
+				Spec:       networkingv1.IngressSpec{IngressClassName: ingressClassName},
+			}
+		}
+
+		It("accepts all ingresses when no ingress class is required", func() {
+			isOurIngress := newIngressFilter(false, "", "", nil)
+			Expect(isOurIngress(makeKubeIng(className("other"), nil))).To(BeTrue())
+			Expect(isOurIngress(makeKubeIng(nil, map[string]string{IngressClassKey: "other"}))).To(BeTrue())
+		})
+
+		It("resolves spec.ingressClassName using the controller of the IngressClass", func() {
+			isOurIngress := newIngressFilter(true, "", "", []networkingv1.IngressClass{
+				makeClass("ours", DefaultIngressControllerName, false),
+				makeClass("gloo", "example.com/other", false),
+			})
+			Expect(isOurIngress(makeKubeIng(className("ours"), nil))).To(BeTrue())
+			// the IngressClass takes precedence over the name of our class
+			Expect(isOurIngress(makeKubeIng(className("gloo"), nil))).To(BeFalse())
+			Expect(isOurIngress(makeKubeIng(className("unknown"), nil))).To(BeFalse())
+		})
+
+		It("accepts our ingress class name when no IngressClass exists for it", func() {
+			isOurIngress := newIngressFilter(true, "", "", nil)
+			Expect(isOurIngress(makeKubeIng(className(defaultIngressClass), nil))).To(BeTrue())
+		})
+
+		It("respects a custom controller name", func() {
+			isOurIngress := newIngressFilter(true, "", "example.com/custom", []networkingv1.IngressClass{
+				makeClass("custom", "example.com/custom", false),
+				makeClass("edge", DefaultIngressControllerName, false),
+			})
+			Expect(isOurIngress(makeKubeIng(className("custom"), nil))).To(BeTrue())
+			Expect(isOurIngress(makeKubeIng(className("edge"), nil))).To(BeFalse())
+		})
+
+		It("falls back to the ingress class annotation", func() {
+			isOurIngress := newIngressFilter(true, "fancy", "", nil)
+			Expect(isOurIngress(makeKubeIng(nil, map[string]string{IngressClassKey: "fancy"}))).To(BeTrue())
+			Expect(isOurIngress(makeKubeIng(nil, map[string]string{IngressClassKey: "pants"}))).To(BeFalse())
+		})
+
+		It("accepts ingresses without a class only if our IngressClass is the default", func() {
+			ours := makeClass("ours", DefaultIngressControllerName, true)
+			other := makeClass("other", "example.com/other", true)
+			Expect(newIngressFilter(true, "", "", nil)(makeKubeIng(nil, nil))).To(BeFalse())
+			Expect(newIngressFilter(true, "", "", []networkingv1.IngressClass{other})(makeKubeIng(nil, nil))).To(BeFalse())
+			Expect(newIngressFilter(true, "", "", []networkingv1.IngressClass{ours})(makeKubeIng(nil, nil))).To(BeTrue())
+		})
+	})
+
+	Context("annotations", func() {
+
+		var (
+			namespace = "ns"
+			svc       *v1.KubeService
+			us        *gloov1.Upstream
+			snap      *v1.TranslatorSnapshot
+		)
+
+		makeAnnotatedIng := func(name, host string, annotations map[string]string) *v1.Ingress {
+			ing := makeIng(name, namespace, "", host, "svc", networkingv1.ServiceBackendPort{Number: 8081})
+			for k, v := range annotations {
+				ing.Metadata.Annotations[k] = v
+			}
+			return ing
+		}
+
+		translate := func() ([]*gloov1.VirtualHost, ingressErrors) {
+			proxy, errs := translateProxy(ctx, "write-namespace", snap, newIngressFilter(false, "", "", nil), proxyConfig)
+			Expect(proxy.Listeners).To(HaveLen(1))
+			return proxy.Listeners[0].GetHttpListener().GetVirtualHosts(), errs
+		}
+
+		BeforeEach(func() {
+			svc = makeService("svc", namespace, "http", 8081)
+			us = makeUpstream("us", namespace, svc)
+			snap = &v1.TranslatorSnapshot{
+				Upstreams: []*gloov1.Upstream{us},
+				Services:  []*v1.KubeService{svc},
+			}
+		})
+
+		It("translates route annotations to route options", func() {
+			snap.Ingresses = []*v1.Ingress{makeAnnotatedIng("ing", "host", map[string]string{
+				TimeoutAnnotation:       "15s",
+				RetriesAnnotation:       "3",
+				RetryOnAnnotation:       "5xx",
+				PerTryTimeoutAnnotation: "5s",
+				PrefixRewriteAnnotation: "/api",
+				HostRewriteAnnotation:   "example.com",
+				AuthConfigRefAnnotation: "other-ns/auth",
+			})}
+
+			vhosts, errs := translate()
+			Expect(errs).To(BeEmpty())
+			Expect(vhosts).To(HaveLen(1))
+			Expect(vhosts[0].Options).To(BeNil())
+			Expect(vhosts[0].Routes[0].Options).To(MatchProto(&gloov1.RouteOptions{
+				Timeout: ptypes.DurationProto(15 * time.Second),
+				Retries: &retries.RetryPolicy{
+					RetryOn:       "5xx",
+					NumRetries:    3,
+					PerTryTimeout: ptypes.DurationProto(5 * time.Second),
+				},
+				PrefixRewrite:   &wrappers.StringValue{Value: "/api"},
+				HostRewriteType: &gloov1.RouteOptions_HostRewrite{HostRewrite: "example.com"},
+				Extauth: &extauthv1.ExtAuthExtension{
+					Spec: &extauthv1.ExtAuthExtension_ConfigRef{
+						ConfigRef: &core.ResourceRef{Name: "auth", Namespace: "other-ns"},
+					},
+				},
+			}))
+		})
+
+		It("overrides the options of a referenced RouteOption", func() {
+			snap.RouteOptions = gatewayv1.RouteOptionList{{
+				Metadata: &core.Metadata{Name: "opts", Namespace: namespace},
+				Options: &gloov1.RouteOptions{
+					Timeout:       ptypes.DurationProto(time.Minute),
+					PrefixRewrite: &wrappers.StringValue{Value: "/from-route-option"},
+				},
+			}}
+			snap.Ingresses = []*v1.Ingress{makeAnnotatedIng("ing", "host", map[string]string{
+				RouteOptionsRefAnnotation: "opts",
+				TimeoutAnnotation:         "15s",
+			})}
+
+			vhosts, errs := translate()
+			Expect(errs).To(BeEmpty())
+			Expect(vhosts[0].Routes[0].Options).To(MatchProto(&gloov1.RouteOptions{
+				Timeout:       ptypes.DurationProto(15 * time.Second),
+				PrefixRewrite: &wrappers.StringValue{Value: "/from-route-option"},
+			}))
+			// the RouteOption is not modified
+			Expect(snap.RouteOptions[0].Options.Timeout).To(MatchProto(ptypes.DurationProto(time.Minute)))
+		})
+
+		It("translates cors annotations to virtual host options", func() {
+			snap.Ingresses = []*v1.Ingress{makeAnnotatedIng("ing", "host", map[string]string{
+				CorsAllowOriginAnnotation:      "https://a.example.com, https://b.example.com",
+				CorsAllowMethodsAnnotation:     "GET,POST",
+				CorsAllowHeadersAnnotation:     "x-custom",
+				CorsMaxAgeAnnotation:           "600",
+				CorsAllowCredentialsAnnotation: "true",
+			})}
+
+			vhosts, errs := translate()
+			Expect(errs).To(BeEmpty())
+			Expect(vhosts[0].Routes[0].Options).To(BeNil())
+			Expect(vhosts[0].Options).To(MatchProto(&gloov1.VirtualHostOptions{
+				Cors: &cors.CorsPolicy{
+					AllowOrigin:      []string{"https://a.example.com", "https://b.example.com"},
+					AllowMethods:     []string{"GET", "POST"},
+					AllowHeaders:     []string{"x-custom"},
+					MaxAge:           "600",
+					AllowCredentials: true,
+				},
+			}))
+		})
+
+		It("reports invalid annotations and applies the valid ones", func() {
+			snap.Ingresses = []*v1.Ingress{makeAnnotatedIng("ing", "host", map[string]string{
+				TimeoutAnnotation:              "soon",
+				PrefixRewriteAnnotation:        "/api",
+				CorsAllowCredentialsAnnotation: "maybe",
+				RouteOptionsRefAnnotation:      "missing",
+			})}
+
+			vhosts, errs := translate()
+			Expect(errs).To(HaveLen(1))
+			for ing, err := range errs {
+				Expect(ing.Name).To(Equal("ing"))
+				Expect(err.Error()).To(ContainSubstring(`invalid value "soon" for annotation ` + TimeoutAnnotation))
+				Expect(err.Error()).To(ContainSubstring(`invalid value "maybe" for annotation ` + CorsAllowCredentialsAnnotation))
+				Expect(err.Error()).To(ContainSubstring("RouteOption ns.missing referenced by annotation " + RouteOptionsRefAnnotation + " not found"))
+			}
+			Expect(vhosts[0].Routes[0].Options).To(MatchProto(&gloov1.RouteOptions{
+				PrefixRewrite: &wrappers.StringValue{Value: "/api"},
+			}))
+		})
+
+		It("reports conflicting virtual host options of ingresses sharing a host", func() {
+			snap.Ingresses = []*v1.Ingress{
+				makeAnnotatedIng("ing1", "host", map[string]string{CorsAllowOriginAnnotation: "https://a.example.com"}),
+				makeAnnotatedIng("ing2", "host", map[string]string{CorsAllowOriginAnnotation: "https://b.example.com"}),
+			}
+
+			vhosts, errs := translate()
+			Expect(errs).To(HaveLen(1))
+			for ing, err := range errs {
+				Expect(ing.Name).To(Equal("ing2"))
+				Expect(err.Error()).To(ContainSubstring("virtual host options for host host conflict"))
+			}
+			Expect(vhosts).To(HaveLen(1))
+			Expect(vhosts[0].Options.Cors.AllowOrigin).To(Equal([]string{"https://a.example.com"}))
+			Expect(vhosts[0].Routes).To(HaveLen(2))
+		})
+	})
+
+	Context("tls", func() {
+
+		var (
+			namespace = "ns"
+			svc       *v1.KubeService
+			us        *gloov1.Upstream
+			snap      *v1.TranslatorSnapshot
+		)
+
+		makeTlsIng := func(name, host, secretName string, annotations map[string]string) *v1.Ingress {
+			ing := makeIng(name, namespace, "", host, "svc", networkingv1.ServiceBackendPort{Number: 8081})
+			kubeIng, err := ingresstype.ToKube(ing)
+			Expect(err).NotTo(HaveOccurred())
+			for k, v := range annotations {
+				kubeIng.Annotations[k] = v
+			}
+			if secretName != "" {
+				kubeIng.Spec.TLS = []networkingv1.IngressTLS{{Hosts: []string{host}, SecretName: secretName}}
+			}
+			ing, err = ingresstype.FromKube(kubeIng)
+			Expect(err).NotTo(HaveOccurred())
+			return ing
+		}
+
+		listenersByName := func(proxy *gloov1.Proxy) map[string]*gloov1.Listener {
+			listeners := make(map[string]*gloov1.Listener)
+			for _, l := range proxy.Listeners {
+				listeners[l.Name] = l
+			}
+			return listeners
+		}
+
+		BeforeEach(func() {
+			svc = makeService("svc", namespace, "http", 8081)
+			us = makeUpstream("us", namespace, svc)
+			snap = &v1.TranslatorSnapshot{
+				Upstreams: []*gloov1.Upstream{us},
+				Services:  []*v1.KubeService{svc},
+			}
+		})
+
+		It("serves the hosts sharing a secret with one ssl config", func() {
+			snap.Ingresses = []*v1.Ingress{
+				makeTlsIng("ing1", "a.example.com", "shared", nil),
+				makeTlsIng("ing2", "b.example.com", "shared", nil),
+				makeTlsIng("ing3", "c.example.com", "other", nil),
+			}
+
+			proxy, errs := translateProxy(ctx, "write-namespace", snap, newIngressFilter(false, "", "", nil), proxyConfig)
+			Expect(errs).To(BeEmpty())
+			Expect(proxy.Listeners).To(HaveLen(1))
+			Expect(proxy.Listeners[0].GetHttpListener().GetVirtualHosts()).To(HaveLen(3))
+			Expect(proxy.Listeners[0].SslConfigurations).To(Equal([]*gloov1.SslConfig{
+				{
+					SslSecrets: &gloov1.SslConfig_SecretRef{
+						SecretRef: &core.ResourceRef{Name: "other", Namespace: namespace},
+					},
+					SniDomains: []string{"c.example.com"},
+				},
+				{
+					SslSecrets: &gloov1.SslConfig_SecretRef{
+						SecretRef: &core.ResourceRef{Name: "shared", Namespace: namespace},
+					},
+					SniDomains: []string{"a.example.com", "b.example.com"},
+				},
+			}))
+		})
+
+		It("uses the configured ports", func() {
+			snap.Ingresses = []*v1.Ingress{
+				makeTlsIng("ing1", "plain.example.com", "", nil),
+				makeTlsIng("ing2", "secure.example.com", "secret", nil),
+			}
+
+			proxy, _ := translateProxy(ctx, "write-namespace", snap, newIngressFilter(false, "", "", nil), ProxyConfig{HttpPort: 80, HttpsPort: 443})
+			listeners := listenersByName(proxy)
+			Expect(listeners["http"].BindPort).To(Equal(uint32(80)))
+			Expect(listeners["http"].GetHttpListener().GetVirtualHosts()[0].Domains).To(Equal([]string{"plain.example.com", "plain.example.com:80"}))
+			Expect(listeners["https"].BindPort).To(Equal(uint32(443)))
+			Expect(listeners["https"].GetHttpListener().GetVirtualHosts()[0].Domains).To(Equal([]string{"secure.example.com", "secure.example.com:443"}))
+		})
+
+		Context("ssl redirect", func() {
+
+			redirectVirtualHost := &gloov1.VirtualHost{
+				Name:    "secure.example.com-http",
+				Domains: []string{"secure.example.com", "secure.example.com:8080"},
+				Routes: []*gloov1.Route{{
+					Matchers: []*matchers.Matcher{{
+						PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/"},
+					}},
+					Action: &gloov1.Route_RedirectAction{
+						RedirectAction: &gloov1.RedirectAction{HttpsRedirect: true},
+					},
+				}},
+			}
+
+			It("does not redirect by default", func() {
+				snap.Ingresses = []*v1.Ingress{makeTlsIng("ing", "secure.example.com", "secret", nil)}
+
+				proxy, _ := translateProxy(ctx, "write-namespace", snap, newIngressFilter(false, "", "", nil), proxyConfig)
+				Expect(listenersByName(proxy)).NotTo(HaveKey("http"))
+			})
+
+			It("redirects HTTP requests of annotated ingresses to HTTPS", func() {
+				snap.Ingresses = []*v1.Ingress{makeTlsIng("ing", "secure.example.com", "secret", map[string]string{
+					ForceSslRedirectAnnotation: "true",
+				})}
+
+				proxy, errs := translateProxy(ctx, "write-namespace", snap, newIngressFilter(false, "", "", nil), proxyConfig)
+				Expect(errs).To(BeEmpty())
+				http := listenersByName(proxy)["http"]
+				Expect(http.GetHttpListener().GetVirtualHosts()).To(HaveLen(1))
+				Expect(http.GetHttpListener().GetVirtualHosts()[0]).To(MatchProto(redirectVirtualHost))
+			})
+
+			It("redirects HTTP requests of all ingresses if configured, unless disabled by annotation", func() {
+				snap.Ingresses = []*v1.Ingress{
+					makeTlsIng("ing1", "secure.example.com", "secret", nil),
+					makeTlsIng("ing2", "other.example.com", "secret", map[string]string{
+						ForceSslRedirectAnnotation: "false",
+					}),
+				}
+
+				config := proxyConfig
+				config.ForceSslRedirect = true
+				proxy, errs := translateProxy(ctx, "write-namespace", snap, newIngressFilter(false, "", "", nil), config)
+				Expect(errs).To(BeEmpty())
+				http := listenersByName(proxy)["http"]
+				Expect(http.GetHttpListener().GetVirtualHosts()).To(HaveLen(1))
+				Expect(http.GetHttpListener().GetVirtualHosts()[0]).To(MatchProto(redirectVirtualHost))
+			})
+
+			It("adds the redirect to the HTTP virtual host of an earlier ingress for the same host", func() {
+				plainIng := makeTlsIng("ing1", "secure.example.com", "", nil)
+				kubeIng, err := ingresstype.ToKube(plainIng)
+				Expect(err).NotTo(HaveOccurred())
+				kubeIng.Spec.Rules[0].HTTP.Paths[0].Path = "/plain"
+				plainIng, err = ingresstype.FromKube(kubeIng)
+				Expect(err).NotTo(HaveOccurred())
+				snap.Ingresses = []*v1.Ingress{
+					plainIng,
+					makeTlsIng("ing2", "secure.example.com", "secret", map[string]string{
+						ForceSslRedirectAnnotation: "true",
+					}),
+				}
+
+				proxy, errs := translateProxy(ctx, "write-namespace", snap, newIngressFilter(false, "", "", nil), proxyConfig)
+				Expect(errs).To(BeEmpty())
+				virtualHosts := listenersByName(proxy)["http"].GetHttpListener().GetVirtualHosts()
+				Expect(virtualHosts).To(HaveLen(1))
+				Expect(virtualHosts[0].GetName()).To(Equal("secure.example.com-http"))
+				routes := virtualHosts[0].GetRoutes()
+				Expect(routes).To(HaveLen(2))
+				Expect(routes[0].GetRouteAction().GetSingle().GetUpstream()).To(MatchProto(us.Metadata.Ref()))
+				Expect(routes[1]).To(MatchProto(redirectVirtualHost.Routes[0]))
+			})
+		})
+
+		Context("tls passthrough", func() {
+
+			It("passes TLS connections through to the backend", func() {
+				snap.Ingresses = []*v1.Ingress{
+					makeTlsIng("ing1", "passthrough.example.com", "", map[string]string{TlsPassthroughAnnotation: "true"}),
+					makeTlsIng("ing2", "plain.example.com", "", nil),
+				}
+
+				config := proxyConfig
+				config.TlsPassthroughPort = 9443
+				proxy, errs := translateProxy(ctx, "write-namespace", snap, newIngressFilter(false, "", "", nil), config)
+				Expect(errs).To(BeEmpty())
+				listeners := listenersByName(proxy)
+				Expect(listeners).To(HaveLen(2))
+				Expect(listeners["http"].GetHttpListener().GetVirtualHosts()).To(HaveLen(1))
+				Expect(listeners["https-passthrough"]).To(MatchProto(&gloov1.Listener{
+					Name:        "https-passthrough",
+					BindAddress: "::",
+					BindPort:    9443,
+					ListenerType: &gloov1.Listener_TcpListener{
+						TcpListener: &gloov1.TcpListener{
+							TcpHosts: []*gloov1.TcpHost{{
+								Name: "passthrough.example.com",
+								SslConfig: &gloov1.SslConfig{
+									SniDomains: []string{"passthrough.example.com"},
+								},
+								Destination: &gloov1.TcpHost_TcpAction{
+									Destination: &gloov1.TcpHost_TcpAction_Single{
+										Single: &gloov1.Destination{
+											DestinationType: &gloov1.Destination_Upstream{
+												Upstream: us.Metadata.Ref(),
+											},
+										},
+									},
+								},
+							}},
+						},
+					},
+				}))
+			})
+
+			It("reports passthrough ingresses if passthrough is disabled", func() {
+				snap.Ingresses = []*v1.Ingress{
+					makeTlsIng("ing", "passthrough.example.com", "", map[string]string{TlsPassthroughAnnotation: "true"}),
+				}
+
+				proxy, errs := translateProxy(ctx, "write-namespace", snap, newIngressFilter(false, "", "", nil), proxyConfig)
+				Expect(proxy.Listeners).To(BeEmpty())
+				Expect(errs).To(HaveLen(1))
+				for _, err := range errs {
+					Expect(err.Error()).To(ContainSubstring(TlsPassthroughDisabledErr.Error()))
+				}
+			})
+		})
+	})
+
+	It("routes resource backends to gloo upstreams", func() {
+
+		namespace := "ns"
+
+		us := &gloov1.Upstream{
+			Metadata: &core.Metadata{
+				Namespace: namespace,
+				Name:      "us",
+			},
+			UpstreamType: &gloov1.Upstream_Static{
+				Static: &static.UpstreamSpec{
+					Hosts: []*static.Host{{Addr: "example.com", Port: 80}},
+				},
+			},
+		}
+
+		apiGroup := gloov1.UpstreamGVK.Group
+		ing, err := ingresstype.FromKube(&networkingv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ing",
+				Namespace: namespace,
+			},
+			Spec: networkingv1.IngressSpec{
+				Rules: []networkingv1.IngressRule{{
+					Host: "host",
+					IngressRuleValue: networkingv1.IngressRuleValue{
+						HTTP: &networkingv1.HTTPIngressRuleValue{
+							Paths: []networkingv1.HTTPIngressPath{{
+								Path: "/",
+								Backend: networkingv1.IngressBackend{
+									Resource: &kubev1.TypedLocalObjectReference{
+										APIGroup: &apiGroup,
+										Kind:     gloov1.UpstreamGVK.Kind,
+										Name:     "us",
+									},
+								},
+							}},
+						},
+					},
+				}},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		proxy, _ := translateProxy(ctx, "write-namespace", &v1.TranslatorSnapshot{
+			Upstreams: []*gloov1.Upstream{us},
+			Ingresses: []*v1.Ingress{ing},
+		}, newIngressFilter(false, "", "", nil), proxyConfig)
+
+		Expect(proxy.Listeners).To(HaveLen(1))
+		vhosts := proxy.Listeners[0].GetHttpListener().GetVirtualHosts()
+		Expect(vhosts).To(HaveLen(1))
+		Expect(vhosts[0].Routes).To(HaveLen(1))
+		Expect(vhosts[0].Routes[0].GetRouteAction().GetSingle().GetUpstream()).To(MatchProto(us.Metadata.Ref()))
+	})
 })
 
 func getFirstPort(svc *kubev1.Service) int32 {
 	return svc.Spec.Ports[0].Port
 }
 
-func makeIng(name, namespace, ingressClass, host string, svcName string, servicePort intstr.IntOrString) *v1.Ingress {
-	ing := &extensions.Ingress{
+func makeIng(name, namespace, ingressClass, host string, svcName string, servicePort networkingv1.ServiceBackendPort) *v1.Ingress {
+	ing := &networkingv1.Ingress{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      name,
 			Namespace: namespace,
@@ -482,18 +1045,20 @@ func makeIng(name, namespace, ingressClass, host string, svcName string, service
 				IngressClassKey: ingressClass,
 			},
 		},
-		Spec: extensions.IngressSpec{
-			Rules: []extensions.IngressRule{
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{
 				{
 					Host: host,
-					IngressRuleValue: extensions.IngressRuleValue{
-						HTTP: &extensions.HTTPIngressRuleValue{
-							Paths: []extensions.HTTPIngressPath{
+					IngressRuleValue: networkingv1.IngressRuleValue{
+						HTTP: &networkingv1.HTTPIngressRuleValue{
+							Paths: []networkingv1.HTTPIngressPath{
 								{
 									Path: "/",
-									Backend: extensions.IngressBackend{
-										ServiceName: svcName,
-										ServicePort: servicePort,
+									Backend: networkingv1.IngressBackend{
+										Service: &networkingv1.IngressServiceBackend{
+											Name: svcName,
+											Port: servicePort,
+										},
 									},
 								},
 							},
@@ -543,7 +1108,7 @@ func makeUpstream(name, namespace string, svc *v1.KubeService) *gloov1.Upstream
 
 const ingressExampleYaml = `
 items:
-- apiVersion: extensions/v1beta1
+- apiVersion: networking.k8s.io/v1
   kind: Ingress
   metadata:
     annotations:
@@ -554,7 +1119,7 @@ items:
     name: amoeba-api-ingress
     namespace: amoeba-dev
     resourceVersion: "26972626"
-    selfLink: /apis/extensions/v1beta1/namespaces/amoeba-dev/ingresses/amoeba-api-ingress
+    selfLink: /apis/networking.k8s.io/v1/namespaces/amoeba-dev/ingresses/amoeba-api-ingress
     uid: 02c06c8f-d329-11e9-bc54-ce36377988a4
   spec:
     rules:
@@ -562,16 +1127,19 @@ items:
       http:
         paths:
         - backend:
-            serviceName: api-gateway-amoeba-dev
-            servicePort: 8080
+            service:
+              name: api-gateway-amoeba-dev
+              port:
+                number: 8080
           path: /
+          pathType: ImplementationSpecific
     tls:
     - hosts:
       - api-dev.intellishift.com
       secretName: amoeba-api-ingress-secret
   status:
     loadBalancer: {}
-- apiVersion: extensions/v1beta1
+- apiVersion: networking.k8s.io/v1
   kind: Ingress
   metadata:
     annotations:
@@ -582,7 +1150,7 @@ items:
     name: amoeba-ui-ingress
     namespace: amoeba-dev
     resourceVersion: "26972628"
-    selfLink: /apis/extensions/v1beta1/namespaces/amoeba-dev/ingresses/amoeba-ui-ingress
+    selfLink: /apis/networking.k8s.io/v1/namespaces/amoeba-dev/ingresses/amoeba-ui-ingress
     uid: 02c9b69a-d329-11e9-bc54-ce36377988a4
   spec:
     rules:
@@ -590,9 +1158,12 @@ items:
       http:
         paths:
         - backend:
-            serviceName: amoeba-ui
-            servicePort: 8080
+            service:
+              name: amoeba-ui
+              port:
+                number: 8080
           path: /
+          pathType: ImplementationSpecific
     tls:
     - hosts:
       - ui-dev.intellishift.com
@@ -2,6 +2,7 @@ package translator
 
 import (
 	"context"
+	"sync"
 
 	"github.com/solo-io/gloo/pkg/utils/syncutil"
 	"github.com/solo-io/go-utils/hashutils"
@@ -12,6 +13,15 @@ import (
 	v1 "github.com/solo-io/gloo/projects/ingress/pkg/api/v1"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	kubev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	networkingv1informers "k8s.io/client-go/informers/networking/v1"
+	networkingv1client "k8s.io/client-go/kubernetes/typed/networking/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 )
 
 type translatorSyncer struct {
@@ -26,22 +36,97 @@ type translatorSyncer struct {
 	// only relevant when requireIngressClass is true.
 	// defaults to 'gloo'
 	customIngressClass string
+
+	// IngressClasses with this controller are ours.
+	// only relevant when requireIngressClass is true.
+	ingressControllerName string
+	ingressClasses        networkingv1informers.IngressClassInformer
+
+	// IngressClasses are not part of the snapshot, so the last snapshot is synced again when they change
+	syncLock              sync.Mutex
+	lastSnapshot          *v1.TranslatorSnapshot
+	ingressClassesChanged chan struct{}
+
+	// records invalid configuration of ingresses as events
+	recorder record.EventRecorder
+	// events are recorded against the live ingresses, as the ingresses of the snapshot lack their UID
+	ingresses networkingv1client.IngressesGetter
+	// the errors of the ingresses in the last sync, so that events are only recorded when they change
+	lastIngressErrors map[types.NamespacedName]string
+
+	proxyConfig ProxyConfig
 }
 
-func NewSyncer(writeNamespace string, proxyClient gloov1.ProxyClient, ingressClient v1.IngressClient, writeErrs chan error, requireIngressClass bool, customIngressClass string) v1.TranslatorSyncer {
-	return &translatorSyncer{
-		writeNamespace:      writeNamespace,
-		writeErrs:           writeErrs,
-		proxyClient:         proxyClient,
-		ingressClient:       ingressClient,
-		proxyReconciler:     gloov1.NewProxyReconciler(proxyClient),
-		requireIngressClass: requireIngressClass,
-		customIngressClass:  customIngressClass,
+// The reason of the events recorded for ingresses with invalid configuration, e.g. annotations
+const InvalidIngressReason = "InvalidConfiguration"
+
+// If ingressClasses is non-nil and an ingress class is required, the last snapshot is synced again whenever an
+// IngressClass changes, until ctx is cancelled. The informer must be started by the caller.
+func NewSyncer(ctx context.Context, writeNamespace string, proxyClient gloov1.ProxyClient, ingressClient v1.IngressClient, writeErrs chan error, requireIngressClass bool, customIngressClass string, ingressControllerName string, ingressClasses networkingv1informers.IngressClassInformer, recorder record.EventRecorder, ingresses networkingv1client.IngressesGetter, proxyConfig ProxyConfig) v1.TranslatorSyncer {
+	s := &translatorSyncer{
+		writeNamespace:        writeNamespace,
+		writeErrs:             writeErrs,
+		proxyClient:           proxyClient,
+		ingressClient:         ingressClient,
+		proxyReconciler:       gloov1.NewProxyReconciler(proxyClient),
+		requireIngressClass:   requireIngressClass,
+		customIngressClass:    customIngressClass,
+		ingressControllerName: ingressControllerName,
+		recorder:              recorder,
+		ingresses:             ingresses,
+		proxyConfig:           proxyConfig,
+		ingressClassesChanged: make(chan struct{}, 1),
+	}
+	if requireIngressClass && ingressClasses != nil {
+		s.ingressClasses = ingressClasses
+		ingressClasses.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    func(interface{}) { s.notifyIngressClassesChanged() },
+			UpdateFunc: func(interface{}, interface{}) { s.notifyIngressClassesChanged() },
+			DeleteFunc: func(interface{}) { s.notifyIngressClassesChanged() },
+		})
+		go s.resyncOnIngressClassChanges(ctx)
+	}
+	return s
+}
+
+// Changes are coalesced, so that IngressClasses changing while syncing are synced once
+func (s *translatorSyncer) notifyIngressClassesChanged() {
+	select {
+	case s.ingressClassesChanged <- struct{}{}:
+	default:
+	}
+}
+
+func (s *translatorSyncer) resyncOnIngressClassChanges(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.ingressClassesChanged:
+			s.syncLock.Lock()
+			snap := s.lastSnapshot
+			s.syncLock.Unlock()
+			// nothing to do before the first sync, which lists the IngressClasses itself
+			if snap == nil {
+				continue
+			}
+			if err := s.Sync(ctx, snap); err != nil {
+				select {
+				case s.writeErrs <- err:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
 	}
 }
 
 // TODO (ilackarms): make sure that sync happens if proxies get updated as well; may need to resync
 func (s *translatorSyncer) Sync(ctx context.Context, snap *v1.TranslatorSnapshot) error {
+	s.syncLock.Lock()
+	defer s.syncLock.Unlock()
+	s.lastSnapshot = snap
+
 	ctx = contextutils.WithLogger(ctx, "translatorSyncer")
 
 	snapHash := hashutils.MustHash(snap)
@@ -56,25 +141,59 @@ func (s *translatorSyncer) Sync(ctx context.Context, snap *v1.TranslatorSnapshot
 		logger.Debug(syncutil.StringifySnapshot(snap))
 	}
 
-	proxy := translateProxy(ctx, s.writeNamespace, snap, s.requireIngressClass, s.customIngressClass)
+	var ingressClasses []networkingv1.IngressClass
+	if s.ingressClasses != nil {
+		classes, err := s.ingressClasses.Lister().List(labels.Everything())
+		if err != nil {
+			logger.Warnf("failed to list IngressClasses, only selecting ingresses by class name: %v", err)
+		}
+		for _, class := range classes {
+			ingressClasses = append(ingressClasses, *class)
+		}
+	}
+
+	isOurIngress := newIngressFilter(s.requireIngressClass, s.customIngressClass, s.ingressControllerName, ingressClasses)
+	proxy, ingressErrs := translateProxy(ctx, s.writeNamespace, snap, isOurIngress, s.proxyConfig)
+	errorsByIngress := make(map[types.NamespacedName]string)
+	for ingress, err := range ingressErrs {
+		logger.Warnf("ingress %v.%v: %v", ingress.Namespace, ingress.Name, err)
+		key := types.NamespacedName{Namespace: ingress.Namespace, Name: ingress.Name}
+		errorsByIngress[key] = err.Error()
+		if s.lastIngressErrors[key] != err.Error() {
+			s.recordInvalidIngress(ctx, key, err)
+		}
+	}
+	s.lastIngressErrors = errorsByIngress
 
-	labels := map[string]string{
+	proxyLabels := map[string]string{
 		"created_by": "ingress",
 	}
 
 	var desiredResources gloov1.ProxyList
 	if proxy != nil {
 		logger.Infof("creating proxy %v", proxy.Metadata.Ref())
-		proxy.Metadata.Labels = labels
+		proxy.Metadata.Labels = proxyLabels
 		desiredResources = gloov1.ProxyList{proxy}
 	}
 
 	if err := s.proxyReconciler.Reconcile(s.writeNamespace, desiredResources, utils.TransitionFunction, clients.ListOpts{
 		Ctx:      ctx,
-		Selector: labels,
+		Selector: proxyLabels,
 	}); err != nil {
 		return err
 	}
 
 	return nil
 }
+
+func (s *translatorSyncer) recordInvalidIngress(ctx context.Context, key types.NamespacedName, err error) {
+	if s.recorder == nil || s.ingresses == nil {
+		return
+	}
+	ingress, getErr := s.ingresses.Ingresses(key.Namespace).Get(ctx, key.Name, metav1.GetOptions{})
+	if getErr != nil {
+		contextutils.LoggerFrom(ctx).Warnf("failed to get ingress %v to record an event: %v", key, getErr)
+		return
+	}
+	s.recorder.Event(ingress, kubev1.EventTypeWarning, InvalidIngressReason, err.Error())
+}
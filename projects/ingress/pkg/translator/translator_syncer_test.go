@@ -0,0 +1,142 @@
+package translator
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
+	ingresstype "github.com/solo-io/gloo/projects/ingress/pkg/api/ingress"
+	v1 "github.com/solo-io/gloo/projects/ingress/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	kubev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+var _ = Describe("TranslatorSyncer", func() {
+	var (
+		ctx         context.Context
+		cancel      context.CancelFunc
+		kube        *fake.Clientset
+		proxyClient gloov1.ProxyClient
+		kubeIngress *networkingv1.Ingress
+		snap        *v1.TranslatorSnapshot
+	)
+
+	className := "custom"
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		kube = fake.NewSimpleClientset()
+
+		var err error
+		proxyClient, err = gloov1.NewProxyClient(ctx, &factory.MemoryResourceClientFactory{Cache: memory.NewInMemoryResourceCache()})
+		Expect(err).NotTo(HaveOccurred())
+
+		apiGroup := gloov1.UpstreamGVK.Group
+		kubeIngress = &networkingv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{Name: "ing", Namespace: "default", UID: "ing-uid"},
+			Spec: networkingv1.IngressSpec{
+				IngressClassName: &className,
+				Rules: []networkingv1.IngressRule{{
+					Host: "a.com",
+					IngressRuleValue: networkingv1.IngressRuleValue{
+						HTTP: &networkingv1.HTTPIngressRuleValue{
+							Paths: []networkingv1.HTTPIngressPath{{
+								Path: "/",
+								Backend: networkingv1.IngressBackend{
+									Resource: &kubev1.TypedLocalObjectReference{APIGroup: &apiGroup, Kind: gloov1.UpstreamGVK.Kind, Name: "us"},
+								},
+							}},
+						},
+					},
+				}},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		ingress, err := ingresstype.FromKube(kubeIngress)
+		Expect(err).NotTo(HaveOccurred())
+		snap = &v1.TranslatorSnapshot{
+			Ingresses: v1.IngressList{ingress},
+			Upstreams: gloov1.UpstreamList{{
+				Metadata:     &core.Metadata{Name: "us", Namespace: "default"},
+				UpstreamType: &gloov1.Upstream_Static{Static: &static.UpstreamSpec{}},
+			}},
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	proxyListeners := func() []*gloov1.Listener {
+		proxy, err := proxyClient.Read("gloo-system", "ingress-proxy", clients.ReadOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		return proxy.GetListeners()
+	}
+
+	It("syncs again when an IngressClass changes", func() {
+		informerFactory := informers.NewSharedInformerFactory(kube, 0)
+		syncer := NewSyncer(ctx, "gloo-system", proxyClient, nil, make(chan error, 1), true, "", "", informerFactory.Networking().V1().IngressClasses(), nil, nil, ProxyConfig{HttpPort: DefaultHttpPort})
+		informerFactory.Start(ctx.Done())
+		informerFactory.WaitForCacheSync(ctx.Done())
+
+		Expect(syncer.Sync(ctx, snap)).To(Succeed())
+		Expect(proxyListeners()).To(BeEmpty())
+
+		_, err := kube.NetworkingV1().IngressClasses().Create(ctx, &networkingv1.IngressClass{
+			ObjectMeta: metav1.ObjectMeta{Name: className},
+			Spec:       networkingv1.IngressClassSpec{Controller: DefaultIngressControllerName},
+		}, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Eventually(proxyListeners).Should(HaveLen(1))
+	})
+
+	Context("invalid ingress", func() {
+
+		BeforeEach(func() {
+			kubeIngress.Annotations = map[string]string{TimeoutAnnotation: "bogus"}
+			_, err := kube.NetworkingV1().Ingresses("default").Create(ctx, kubeIngress, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("records an event against the ingress only when its errors change", func() {
+			broadcaster := record.NewBroadcaster()
+			broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: kube.CoreV1().Events("default")})
+			defer broadcaster.Shutdown()
+			recorder := broadcaster.NewRecorder(scheme.Scheme, kubev1.EventSource{Component: "gloo-ingress"})
+
+			informerFactory := informers.NewSharedInformerFactory(kube, 0)
+			syncer := NewSyncer(ctx, "gloo-system", proxyClient, nil, make(chan error, 1), false, "", "", informerFactory.Networking().V1().IngressClasses(), recorder, kube.NetworkingV1(), ProxyConfig{HttpPort: DefaultHttpPort})
+
+			events := func() []kubev1.Event {
+				list, err := kube.CoreV1().Events("default").List(ctx, metav1.ListOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				return list.Items
+			}
+
+			Expect(syncer.Sync(ctx, snap)).To(Succeed())
+			Eventually(events).Should(HaveLen(1))
+			event := events()[0]
+			Expect(event.Reason).To(Equal(InvalidIngressReason))
+			Expect(event.InvolvedObject.Name).To(Equal("ing"))
+			Expect(event.InvolvedObject.UID).To(BeEquivalentTo("ing-uid"))
+
+			Expect(syncer.Sync(ctx, snap)).To(Succeed())
+			Consistently(events, "200ms").Should(HaveLen(1))
+		})
+	})
+})
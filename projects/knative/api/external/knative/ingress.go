@@ -10,6 +10,27 @@ import (
 
 type Ingress v1alpha1.Ingress
 
+// Whether HTTP requests for the hosts of an Ingress with TLS are served or redirected to HTTPS
+type HTTPOption string
+
+const (
+	HTTPOptionEnabled    HTTPOption = "Enabled"
+	HTTPOptionRedirected HTTPOption = "Redirected"
+)
+
+// The `spec.httpOption` field was added to Knative Ingresses after the knative.dev/networking version Gloo depends on,
+// so the Ingress client reads it from the raw object and records it in this annotation. The annotation is not
+// written back to the cluster.
+const HTTPOptionAnnotation = "gloo.networking.knative.dev/http-option"
+
+// Returns the httpOption recorded in the annotations of an ingress, defaults to HTTPOptionEnabled
+func HTTPOptionFromAnnotations(annotations map[string]string) HTTPOption {
+	if HTTPOption(annotations[HTTPOptionAnnotation]) == HTTPOptionRedirected {
+		return HTTPOptionRedirected
+	}
+	return HTTPOptionEnabled
+}
+
 func (p *Ingress) GetMetadata() *core.Metadata {
 	return kubeutils.FromKubeMeta(p.ObjectMeta)
 }
@@ -29,7 +50,39 @@ func (p *Ingress) Clone() *Ingress {
 	return &newIng
 }
 
-// todo (mholland) we should eventually update this, and any of our dependant logic, to use non-deprecated values
+// An ingress is public if any of its rules is. Ingresses without rules fall back to their deprecated visibility.
 func (p *Ingress) IsPublic() bool {
-	return p.Spec.DeprecatedVisibility == "" || p.Spec.DeprecatedVisibility == v1alpha1.IngressVisibilityExternalIP
+	if len(p.Spec.Rules) == 0 {
+		return isPublic(p.Spec.DeprecatedVisibility)
+	}
+	for _, rule := range p.Spec.Rules {
+		if p.IsPublicRule(rule) {
+			return true
+		}
+	}
+	return false
+}
+
+// Rules without visibility fall back to the deprecated visibility of the ingress
+func (p *Ingress) IsPublicRule(rule v1alpha1.IngressRule) bool {
+	if rule.Visibility == "" {
+		return isPublic(p.Spec.DeprecatedVisibility)
+	}
+	return isPublic(rule.Visibility)
+}
+
+// Returns a copy of the ingress with only its public rules
+func (p *Ingress) PublicRules() *Ingress {
+	public := p.Clone()
+	public.Spec.Rules = nil
+	for _, rule := range p.Spec.Rules {
+		if p.IsPublicRule(rule) {
+			public.Spec.Rules = append(public.Spec.Rules, *rule.DeepCopy())
+		}
+	}
+	return public
+}
+
+func isPublic(visibility v1alpha1.IngressVisibility) bool {
+	return visibility == "" || visibility == v1alpha1.IngressVisibilityExternalIP
 }
@@ -6,19 +6,29 @@ import (
 	"time"
 
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/controller"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+	knativev1alpha1 "knative.dev/networking/pkg/apis/networking/v1alpha1"
 	knativeclient "knative.dev/networking/pkg/client/clientset/versioned"
 	knativeinformers "knative.dev/networking/pkg/client/informers/externalversions"
 	knativelisters "knative.dev/networking/pkg/client/listers/networking/v1alpha1"
 )
 
+var ingressGVR = knativev1alpha1.SchemeGroupVersion.WithResource("ingresses")
+
 type Cache interface {
 	IngressLister() knativelisters.IngressLister
+	// Returns the `spec.httpOption` of the ingress, which the typed ingresses of our knative.dev/networking version drop
+	IngressHTTPOption(namespace, name string) string
 	Subscribe() <-chan struct{}
 	Unsubscribe(<-chan struct{})
 }
 
 type knativeCache struct {
-	ingress knativelisters.IngressLister
+	ingress    knativelisters.IngressLister
+	rawIngress cache.GenericLister
 
 	cacheUpdatedWatchers      []chan struct{}
 	cacheUpdatedWatchersMutex sync.Mutex
@@ -26,19 +36,21 @@ type knativeCache struct {
 
 // This context should live as long as the cache is desired. i.e. if the cache is shared
 // across clients, it should get a context that has a longer lifetime than the clients themselves
-func NewIngressCache(ctx context.Context, knativeClient knativeclient.Interface) (*knativeCache, error) {
+func NewIngressCache(ctx context.Context, knativeClient knativeclient.Interface, dynamicClient dynamic.Interface) (*knativeCache, error) {
 	resyncDuration := 12 * time.Hour
 	sharedInformerFactory := knativeinformers.NewSharedInformerFactory(knativeClient, resyncDuration)
 
 	ingress := sharedInformerFactory.Networking().V1alpha1().Ingresses()
+	rawIngress := dynamicinformer.NewDynamicSharedInformerFactory(dynamicClient, resyncDuration).ForResource(ingressGVR)
 
 	k := &knativeCache{
-		ingress: ingress.Lister(),
+		ingress:    ingress.Lister(),
+		rawIngress: rawIngress.Lister(),
 	}
 
 	kubeController := controller.NewController("knative-resources-cache",
 		controller.NewLockingSyncHandler(k.updatedOccurred),
-		ingress.Informer())
+		ingress.Informer(), rawIngress.Informer())
 
 	stop := ctx.Done()
 	err := kubeController.Run(2, stop)
@@ -53,6 +65,19 @@ func (k *knativeCache) IngressLister() knativelisters.IngressLister {
 	return k.ingress
 }
 
+func (k *knativeCache) IngressHTTPOption(namespace, name string) string {
+	obj, err := k.rawIngress.ByNamespace(namespace).Get(name)
+	if err != nil {
+		return ""
+	}
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return ""
+	}
+	httpOption, _, _ := unstructured.NestedString(u.Object, "spec", "httpOption")
+	return httpOption
+}
+
 func (k *knativeCache) Subscribe() <-chan struct{} {
 	k.cacheUpdatedWatchersMutex.Lock()
 	defer k.cacheUpdatedWatchersMutex.Unlock()
@@ -89,6 +89,12 @@ func (rc *ResourceClient) List(namespace string, opts clients.ListOpts) (resourc
 		if resource == nil {
 			continue
 		}
+		if httpOption := rc.cache.IngressHTTPOption(IngressObj.Namespace, IngressObj.Name); httpOption != "" {
+			if resource.Annotations == nil {
+				resource.Annotations = make(map[string]string)
+			}
+			resource.Annotations[knative.HTTPOptionAnnotation] = httpOption
+		}
 		resourceList = append(resourceList, resource)
 	}
 
@@ -18,6 +18,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 
+	"github.com/solo-io/gloo/projects/knative/api/external/knative"
 	v1alpha1 "github.com/solo-io/gloo/projects/knative/pkg/api/external/knative"
 	"github.com/solo-io/go-utils/contextutils"
 
@@ -171,6 +172,9 @@ func routingConfig(ctx context.Context, ingresses map[*core.Metadata]knativev1al
 			sslConfigs = append(sslConfigs, customSsl)
 		}
 
+		// HTTP requests for the hosts of ingresses with TLS are redirected to HTTPS if requested
+		redirectHttp := useTls && knative.HTTPOptionFromAnnotations(ing.Annotations) == knative.HTTPOptionRedirected
+
 		for i, rule := range spec.Rules {
 			var routes []*gloov1.Route
 			if rule.HTTP == nil {
@@ -204,26 +208,31 @@ func routingConfig(ctx context.Context, ingresses map[*core.Metadata]knativev1al
 					return nil, nil, nil, errors.Wrapf(err, "")
 				}
 
-				route := &gloov1.Route{
+				options := &gloov1.RouteOptions{
+					HeaderManipulation: getHeaderManipulation(route.AppendHeaders),
+					Timeout:            ptypes.DurationProto(timeout),
+					Retries:            retryPolicy,
+				}
+				if route.RewriteHost != "" {
+					options.HostRewriteType = &gloov1.RouteOptions_HostRewrite{HostRewrite: route.RewriteHost}
+				}
+
+				routes = append(routes, &gloov1.Route{
 					Matchers: []*matchers.Matcher{{
 						PathSpecifier: &matchers.Matcher_Regex{
 							Regex: pathRegex,
 						},
+						Headers: headerMatchers(route.Headers),
 					}},
 					Action: &gloov1.Route_RouteAction{
 						RouteAction: action,
 					},
-					Options: &gloov1.RouteOptions{
-						HeaderManipulation: getHeaderManipulation(route.AppendHeaders),
-						Timeout:            ptypes.DurationProto(timeout),
-						Retries:            retryPolicy,
-					},
-				}
-				routes = append(routes, route)
+					Options: options,
+				})
 
 			}
 
-			var hosts []string
+			var hosts, redirectHosts []string
 			for _, host := range expandHosts(rule.Hosts) {
 				hosts = append(hosts, host)
 				if useTls {
@@ -231,6 +240,7 @@ func routingConfig(ctx context.Context, ingresses map[*core.Metadata]knativev1al
 				} else {
 					hosts = append(hosts, fmt.Sprintf("%v:%v", host, bindPortHttp))
 				}
+				redirectHosts = append(redirectHosts, host, fmt.Sprintf("%v:%v", host, bindPortHttp))
 			}
 
 			vh := &gloov1.VirtualHost{
@@ -241,6 +251,22 @@ func routingConfig(ctx context.Context, ingresses map[*core.Metadata]knativev1al
 
 			if useTls {
 				virtualHostsHttps = append(virtualHostsHttps, vh)
+				if redirectHttp {
+					virtualHostsHttp = append(virtualHostsHttp, &gloov1.VirtualHost{
+						Name:    vh.Name + "-redirect",
+						Domains: redirectHosts,
+						Routes: []*gloov1.Route{{
+							Matchers: []*matchers.Matcher{{
+								PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/"},
+							}},
+							Action: &gloov1.Route_RedirectAction{
+								RedirectAction: &gloov1.RedirectAction{
+									HttpsRedirect: true,
+								},
+							},
+						}},
+					})
+				}
 			} else {
 				virtualHostsHttp = append(virtualHostsHttp, vh)
 			}
@@ -300,13 +326,36 @@ func serviceForSplit(split knativev1alpha1.IngressBackendSplit) *gloov1.Destinat
 	}
 }
 
+// only exact header matches are supported by knative
+func headerMatchers(headerMatches map[string]knativev1alpha1.HeaderMatch) []*matchers.HeaderMatcher {
+	var headerMatchers []*matchers.HeaderMatcher
+	names := make([]string, 0, len(headerMatches))
+	for name := range headerMatches {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		headerMatchers = append(headerMatchers, &matchers.HeaderMatcher{
+			Name:  name,
+			Value: headerMatches[name].Exact,
+		})
+	}
+	return headerMatchers
+}
+
 func getHeaderManipulation(headersToAppend map[string]string) *headers.HeaderManipulation {
 	if len(headersToAppend) == 0 {
 		return nil
 	}
+	// sorted so that the proxy does not change between syncs
+	names := make([]string, 0, len(headersToAppend))
+	for name := range headersToAppend {
+		names = append(names, name)
+	}
+	sort.Strings(names)
 	var headersToAdd []*envoycore_sk.HeaderValueOption
-	for name, value := range headersToAppend {
-		headersToAdd = append(headersToAdd, &envoycore_sk.HeaderValueOption{HeaderOption: &envoycore_sk.HeaderValueOption_Header{Header: &envoycore_sk.HeaderValue{Key: name, Value: value}}})
+	for _, name := range names {
+		headersToAdd = append(headersToAdd, &envoycore_sk.HeaderValueOption{HeaderOption: &envoycore_sk.HeaderValueOption_Header{Header: &envoycore_sk.HeaderValue{Key: name, Value: headersToAppend[name]}}})
 	}
 	return &headers.HeaderManipulation{
 		RequestHeadersToAdd: headersToAdd,
@@ -419,6 +419,156 @@ var _ = Describe("Translate", func() {
 		Expect(proxy.Listeners[0].SslConfigurations[0].SslSecrets).To(Equal(&gloov1.SslConfig_SecretRef{SecretRef: &core.ResourceRef{Name: secretName, Namespace: secretNamespace}}))
 		Expect(proxy.Listeners[0].SslConfigurations[0].SniDomains).To(Equal([]string{"domain.com", "domain.io"}))
 	})
+
+	It("redirects HTTP requests for the hosts of Redirected ingresses to HTTPS", func() {
+		ingress := &v1alpha12.Ingress{Ingress: knative.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "redirected",
+				Namespace:   "example",
+				Annotations: map[string]string{knative.HTTPOptionAnnotation: string(knative.HTTPOptionRedirected)},
+			},
+			Spec: v1alpha1.IngressSpec{
+				TLS: []v1alpha1.IngressTLS{{
+					Hosts:      []string{"domain.com"},
+					SecretName: "secret",
+				}},
+				Rules: []v1alpha1.IngressRule{
+					{
+						Hosts: []string{"domain.com"},
+						HTTP: &v1alpha1.HTTPIngressRuleValue{
+							Paths: []v1alpha1.HTTPIngressPath{
+								{
+									Splits: []v1alpha1.IngressBackendSplit{
+										{
+											IngressBackend: v1alpha1.IngressBackend{
+												ServiceName:      "peteszah",
+												ServiceNamespace: "example",
+												ServicePort:      intstr.FromInt(80),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}}
+		proxy, errs := translateProxy(context.TODO(), "test", "example", v1alpha12.IngressList{ingress})
+		Expect(errs).NotTo(HaveOccurred())
+		Expect(proxy.Listeners).To(HaveLen(2))
+		Expect(proxy.Listeners[0].Name).To(Equal("http"))
+		Expect(proxy.Listeners[0].GetHttpListener().GetVirtualHosts()).To(Equal([]*gloov1.VirtualHost{{
+			Name:    "example.redirected-0-redirect",
+			Domains: []string{"domain.com", "domain.com:8080"},
+			Routes: []*gloov1.Route{{
+				Matchers: []*matchers.Matcher{{
+					PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/"},
+				}},
+				Action: &gloov1.Route_RedirectAction{
+					RedirectAction: &gloov1.RedirectAction{HttpsRedirect: true},
+				},
+			}},
+		}}))
+		Expect(proxy.Listeners[1].Name).To(Equal("https"))
+		Expect(proxy.Listeners[1].GetHttpListener().GetVirtualHosts()).To(HaveLen(1))
+		Expect(proxy.Listeners[1].GetHttpListener().GetVirtualHosts()[0].Domains).To(Equal([]string{"domain.com", "domain.com:8443"}))
+	})
+
+	It("does not redirect HTTP requests of ingresses without TLS", func() {
+		ingress := &v1alpha12.Ingress{Ingress: knative.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "redirected",
+				Namespace:   "example",
+				Annotations: map[string]string{knative.HTTPOptionAnnotation: string(knative.HTTPOptionRedirected)},
+			},
+			Spec: v1alpha1.IngressSpec{
+				Rules: []v1alpha1.IngressRule{
+					{
+						Hosts: []string{"domain.com"},
+						HTTP: &v1alpha1.HTTPIngressRuleValue{
+							Paths: []v1alpha1.HTTPIngressPath{
+								{
+									Splits: []v1alpha1.IngressBackendSplit{
+										{
+											IngressBackend: v1alpha1.IngressBackend{
+												ServiceName:      "peteszah",
+												ServiceNamespace: "example",
+												ServicePort:      intstr.FromInt(80),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}}
+		proxy, errs := translateProxy(context.TODO(), "test", "example", v1alpha12.IngressList{ingress})
+		Expect(errs).NotTo(HaveOccurred())
+		Expect(proxy.Listeners).To(HaveLen(1))
+		routes := proxy.Listeners[0].GetHttpListener().GetVirtualHosts()[0].GetRoutes()
+		Expect(routes).To(HaveLen(1))
+		Expect(routes[0].GetRouteAction()).NotTo(BeNil())
+	})
+
+	It("matches headers, rewrites the host and appends headers to splits", func() {
+		ingress := &v1alpha12.Ingress{Ingress: knative.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "domain-mapping",
+				Namespace: "example",
+			},
+			Spec: v1alpha1.IngressSpec{
+				Rules: []v1alpha1.IngressRule{
+					{
+						Hosts: []string{"domain.com"},
+						HTTP: &v1alpha1.HTTPIngressRuleValue{
+							Paths: []v1alpha1.HTTPIngressPath{
+								{
+									Headers: map[string]v1alpha1.HeaderMatch{
+										"K-Network-Probe": {Exact: "probe"},
+										"Canary":          {Exact: "true"},
+									},
+									RewriteHost: "peteszah.example.svc.cluster.local",
+									Splits: []v1alpha1.IngressBackendSplit{
+										{
+											IngressBackend: v1alpha1.IngressBackend{
+												ServiceName:      "peteszah",
+												ServiceNamespace: "example",
+												ServicePort:      intstr.FromInt(80),
+											},
+											AppendHeaders: map[string]string{
+												"K-Original-Host":           "domain.com",
+												"Knative-Serving-Namespace": "example",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}}
+		proxy, errs := translateProxy(context.TODO(), "test", "example", v1alpha12.IngressList{ingress})
+		Expect(errs).NotTo(HaveOccurred())
+		routes := proxy.Listeners[0].GetHttpListener().GetVirtualHosts()[0].GetRoutes()
+		Expect(routes).To(HaveLen(1))
+		Expect(routes[0].GetMatchers()[0].GetHeaders()).To(Equal([]*matchers.HeaderMatcher{
+			{Name: "Canary", Value: "true"},
+			{Name: "K-Network-Probe", Value: "probe"},
+		}))
+		Expect(routes[0].GetOptions().GetHostRewrite()).To(Equal("peteszah.example.svc.cluster.local"))
+		destinations := routes[0].GetRouteAction().GetMulti().GetDestinations()
+		Expect(destinations).To(HaveLen(1))
+		Expect(destinations[0].GetOptions().GetHeaderManipulation()).To(MatchProto(&headers.HeaderManipulation{
+			RequestHeadersToAdd: []*envoycore_sk.HeaderValueOption{
+				{HeaderOption: &envoycore_sk.HeaderValueOption_Header{Header: &envoycore_sk.HeaderValue{Key: "K-Original-Host", Value: "domain.com"}}},
+				{HeaderOption: &envoycore_sk.HeaderValueOption_Header{Header: &envoycore_sk.HeaderValue{Key: "Knative-Serving-Namespace", Value: "example"}}},
+			},
+		}))
+	})
 })
 
 func durptr(d int) *duration.Duration {
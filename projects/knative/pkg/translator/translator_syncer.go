@@ -91,8 +91,9 @@ func (s *translatorSyncer) Sync(ctx context.Context, snap *v1.TranslatorSnapshot
 			continue
 		}
 
+		// cluster local rules are only served by the internal proxy
 		if ing.IsPublic() {
-			externalIngresses = append(externalIngresses, ing)
+			externalIngresses = append(externalIngresses, &v1alpha1.Ingress{Ingress: *ing.Ingress.PublicRules()})
 		}
 		internalIngresses = append(internalIngresses, ing)
 	}
@@ -193,12 +194,16 @@ func (s *translatorSyncer) markIngressesReady(ctx context.Context, ingresses v1a
 		}
 		ci.Status.InitializeConditions()
 		ci.Status.MarkNetworkConfigured()
-		externalLbStatus := []knativev1alpha1.LoadBalancerIngressStatus{
-			{DomainInternal: s.externalProxyAddress},
-		}
 		internalLbStatus := []knativev1alpha1.LoadBalancerIngressStatus{
 			{DomainInternal: s.internalProxyAddress},
 		}
+		// ingresses without public rules are only reachable through the internal proxy
+		externalLbStatus := internalLbStatus
+		if wrappedCi.IsPublic() {
+			externalLbStatus = []knativev1alpha1.LoadBalancerIngressStatus{
+				{DomainInternal: s.externalProxyAddress},
+			}
+		}
 		ci.Status.MarkLoadBalancerReady(externalLbStatus, internalLbStatus)
 		ci.Status.ObservedGeneration = ci.Generation
 		updatedIngresses = append(updatedIngresses, &ci)
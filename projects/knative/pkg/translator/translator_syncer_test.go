@@ -167,6 +167,65 @@ var _ = Describe("TranslatorSyncer", func() {
 		Expect(proxiesWithIngresses[externalProxyName]).To(HaveLen(1))
 		Expect(proxiesWithIngresses[internalProxyName]).To(HaveLen(2))
 	})
+
+	It("only puts the public rules of ingresses on the external proxy", func() {
+		syncer := NewSyncer(proxyAddressExternal, proxyAddressInternal, namespace, proxyClient, knativeClient, make(chan error), false).(*translatorSyncer)
+
+		proxiesWithIngresses := make(map[string]v1alpha1.IngressList)
+		syncer.translateProxy = func(ctx context.Context, proxyName, proxyNamespace string, ingresses v1alpha1.IngressList) (proxy *v1.Proxy, err error) {
+			proxiesWithIngresses[proxyName] = ingresses
+			return nil, nil
+		}
+
+		mixedIngress := &v1alpha1.Ingress{
+			Ingress: knative.Ingress{
+				ObjectMeta: v12.ObjectMeta{Generation: 1},
+				Spec: knativev1alpha1.IngressSpec{
+					Rules: []knativev1alpha1.IngressRule{
+						{Hosts: []string{"public.example.com"}, Visibility: knativev1alpha1.IngressVisibilityExternalIP},
+						{Hosts: []string{"private.example.svc.cluster.local"}, Visibility: knativev1alpha1.IngressVisibilityClusterLocal},
+					},
+				},
+			},
+		}
+		privateIngress := &v1alpha1.Ingress{
+			Ingress: knative.Ingress{
+				ObjectMeta: v12.ObjectMeta{Generation: 1},
+				Spec: knativev1alpha1.IngressSpec{
+					Rules: []knativev1alpha1.IngressRule{
+						{Hosts: []string{"private.example.svc.cluster.local"}, Visibility: knativev1alpha1.IngressVisibilityClusterLocal},
+					},
+				},
+			},
+		}
+
+		err := syncer.Sync(context.TODO(), &knativev1.TranslatorSnapshot{
+			Ingresses: []*v1alpha1.Ingress{mixedIngress, privateIngress},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(proxiesWithIngresses[externalProxyName]).To(HaveLen(1))
+		Expect(proxiesWithIngresses[externalProxyName][0].Spec.Rules).To(Equal([]knativev1alpha1.IngressRule{mixedIngress.Spec.Rules[0]}))
+		Expect(proxiesWithIngresses[internalProxyName]).To(HaveLen(2))
+		Expect(proxiesWithIngresses[internalProxyName][0].Spec.Rules).To(HaveLen(2))
+	})
+
+	It("reports the internal proxy as the public load balancer of cluster local ingresses", func() {
+		syncer := NewSyncer(proxyAddressExternal, proxyAddressInternal, namespace, proxyClient, knativeClient, make(chan error), false).(*translatorSyncer)
+
+		Expect(syncer.markIngressesReady(ctx, v1alpha1.IngressList{ingress})).To(Succeed())
+		ci, err := knativeClient.Ingresses(ingress.Namespace).Get(ctx, ingress.Name, v12.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ci.Status.PublicLoadBalancer.Ingress).To(Equal([]knativev1alpha1.LoadBalancerIngressStatus{{DomainInternal: proxyAddressExternal}}))
+		Expect(ci.Status.PrivateLoadBalancer.Ingress).To(Equal([]knativev1alpha1.LoadBalancerIngressStatus{{DomainInternal: proxyAddressInternal}}))
+
+		ingress.Spec.Rules[0].Visibility = knativev1alpha1.IngressVisibilityClusterLocal
+		Expect(syncer.markIngressesReady(ctx, v1alpha1.IngressList{ingress})).To(Succeed())
+		ci, err = knativeClient.Ingresses(ingress.Namespace).Get(ctx, ingress.Name, v12.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ci.Status.PublicLoadBalancer.Ingress).To(Equal([]knativev1alpha1.LoadBalancerIngressStatus{{DomainInternal: proxyAddressInternal}}))
+		Expect(ci.Status.PrivateLoadBalancer.Ingress).To(Equal([]knativev1alpha1.LoadBalancerIngressStatus{{DomainInternal: proxyAddressInternal}}))
+	})
 })
 
 func toKube(ci *v1alpha1.Ingress) *knativev1alpha1.Ingress {
@@ -239,6 +239,7 @@ func defaultTestConstructOpts(ctx context.Context, runOptions *RunOptions) trans
 		RouteTables:        f,
 		VirtualHostOptions: f,
 		RouteOptions:       f,
+		TcpRoutes:          f,
 		Proxies:            f,
 		WatchOpts: clients.WatchOpts{
 			Ctx:         ctx,